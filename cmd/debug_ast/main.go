@@ -9,23 +9,25 @@ import (
 	"strings"
 
 	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
 	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
 	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
 )
 
 func main() {
-	lang := flag.String("lang", "", "Language to parse (php, js, twig, json). Auto-detected from extension if not specified.")
+	lang := flag.String("lang", "", "Language to parse (php, js, ts, tsx, twig, json, scss). Auto-detected from extension if not specified.")
 	flag.Parse()
 
 	args := flag.Args()
 	if len(args) < 1 {
-		fmt.Println("Usage: go run cmd/debug_ast/main.go [-lang=php|js|twig|json] <file_path>")
-		fmt.Println("       go run cmd/debug_ast/main.go [-lang=php|js|twig|json] - < input.txt")
+		fmt.Println("Usage: go run cmd/debug_ast/main.go [-lang=php|js|ts|tsx|twig|json|scss] <file_path>")
+		fmt.Println("       go run cmd/debug_ast/main.go [-lang=php|js|ts|tsx|twig|json|scss] - < input.txt")
 		fmt.Println("")
 		fmt.Println("Options:")
-		fmt.Println("  -lang    Language to parse (php, js, twig, json)")
+		fmt.Println("  -lang    Language to parse (php, js, ts, tsx, twig, json, scss)")
 		fmt.Println("           Auto-detected from file extension if not specified")
 		fmt.Println("")
 		fmt.Println("Examples:")
@@ -77,12 +79,18 @@ func main() {
 		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP()))
 	case "js", "javascript":
 		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language()))
+	case "ts", "typescript":
+		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript()))
+	case "tsx":
+		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTSX()))
 	case "twig":
 		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language()))
 	case "json":
 		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language()))
+	case "scss":
+		langErr = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language()))
 	default:
-		fmt.Printf("Error: Unsupported language '%s'. Supported: php, js, twig, json\n", language)
+		fmt.Printf("Error: Unsupported language '%s'. Supported: php, js, ts, tsx, twig, json, scss\n", language)
 		os.Exit(1)
 	}
 
@@ -111,10 +119,16 @@ func detectLanguage(filePath string) string {
 		return "php"
 	case ".js", ".mjs", ".cjs":
 		return "js"
+	case ".ts", ".mts":
+		return "ts"
+	case ".tsx":
+		return "tsx"
 	case ".twig":
 		return "twig"
 	case ".json":
 		return "json"
+	case ".scss":
+		return "scss"
 	default:
 		return ""
 	}
@@ -0,0 +1,160 @@
+package acl
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/indexer"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// corePrivilegesKey is the key of core Administration files with a privilege mapping in the core index
+const corePrivilegesKey = "privileges"
+
+type AclIndexer struct {
+	privilegeIndex *indexer.DataIndexer[Privilege]
+
+	// coreIndex stores the files of the core Administration with a privilege mapping, see admin.IsCoreAdministrationPath
+	coreIndex *indexer.DataIndexer[string]
+}
+
+func NewAclIndexer(configDir string) (*AclIndexer, error) {
+	privilegeIndex, err := indexer.NewDataIndexer[Privilege](filepath.Join(configDir, "acl_privilege.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	coreIndex, err := indexer.NewDataIndexer[string](filepath.Join(configDir, "acl_core.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AclIndexer{
+		privilegeIndex: privilegeIndex,
+		coreIndex:      coreIndex,
+	}, nil
+}
+
+func (i *AclIndexer) ID() string {
+	return "acl.indexer"
+}
+
+func (i *AclIndexer) Index(path string, node *tree_sitter.Node, fileContent []byte) error {
+	var privileges []Privilege
+
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case admin.IsScriptFile(ext):
+		if !strings.Contains(path, "Resources/app/administration") || !strings.Contains(string(fileContent), "addPrivilegeMappingEntry") {
+			return nil
+		}
+		privileges = parseAdminPrivileges(node, fileContent, path)
+	case ext == ".php":
+		if !strings.Contains(string(fileContent), "enrichPrivileges") {
+			return nil
+		}
+		privileges = parsePHPPrivileges(node, fileContent, path)
+	default:
+		return nil
+	}
+
+	if admin.IsCoreAdministrationPath(path) {
+		if err := i.indexCoreFile(path, len(privileges) > 0); err != nil {
+			return err
+		}
+	}
+
+	if len(privileges) == 0 {
+		return nil
+	}
+
+	batchSave := map[string]map[string]Privilege{
+		path: make(map[string]Privilege),
+	}
+	for _, privilege := range privileges {
+		if _, exists := batchSave[path][privilege.Name]; !exists {
+			batchSave[path][privilege.Name] = privilege
+		}
+	}
+
+	if err := i.privilegeIndex.BatchSaveItems(batchSave); err != nil {
+		return fmt.Errorf("saving privileges: %w", err)
+	}
+
+	return nil
+}
+
+// indexCoreFile stores if a file of the core Administration has a privilege mapping,
+// so checking for it doesn't need to load all privileges
+func (i *AclIndexer) indexCoreFile(path string, hasPrivileges bool) error {
+	batchSave := map[string]map[string]string{
+		path: make(map[string]string),
+	}
+	if hasPrivileges {
+		batchSave[path][corePrivilegesKey] = path
+	}
+
+	if err := i.coreIndex.BatchSaveItems(batchSave); err != nil {
+		return fmt.Errorf("saving core privilege file: %w", err)
+	}
+
+	return nil
+}
+
+func (i *AclIndexer) RemovedFiles(paths []string) error {
+	if err := i.privilegeIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return fmt.Errorf("removing privileges: %w", err)
+	}
+
+	if err := i.coreIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return fmt.Errorf("removing core privilege files: %w", err)
+	}
+
+	return nil
+}
+
+func (i *AclIndexer) Close() error {
+	if err := i.privilegeIndex.Close(); err != nil {
+		return err
+	}
+	return i.coreIndex.Close()
+}
+
+func (i *AclIndexer) Clear() error {
+	if err := i.privilegeIndex.Clear(); err != nil {
+		return err
+	}
+	return i.coreIndex.Clear()
+}
+
+// GetPrivilege returns all definitions of a privilege
+func (i *AclIndexer) GetPrivilege(name string) ([]Privilege, error) {
+	return i.privilegeIndex.GetValues(name)
+}
+
+// GetAllPrivileges returns all known privileges
+func (i *AclIndexer) GetAllPrivileges() ([]Privilege, error) {
+	return i.privilegeIndex.GetAllValues()
+}
+
+// HasCorePrivileges checks if the privilege mapping of the core Administration is indexed
+func (i *AclIndexer) HasCorePrivileges() bool {
+	files, err := i.coreIndex.GetValues(corePrivilegesKey)
+	return err == nil && len(files) > 0
+}
+
+// SavePrivilege saves a privilege (primarily for testing)
+func (i *AclIndexer) SavePrivilege(privilege Privilege) error {
+	batchSave := map[string]map[string]Privilege{
+		privilege.FilePath: {privilege.Name: privilege},
+	}
+	if err := i.privilegeIndex.BatchSaveItems(batchSave); err != nil {
+		return err
+	}
+
+	if !admin.IsCoreAdministrationPath(privilege.FilePath) {
+		return nil
+	}
+	return i.indexCoreFile(privilege.FilePath, true)
+}
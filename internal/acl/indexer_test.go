@@ -0,0 +1,166 @@
+package acl
+
+import (
+	"strings"
+	"testing"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+func parse(t *testing.T, language *tree_sitter.Language, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(language))
+	return parser.Parse([]byte(code), nil)
+}
+
+func javascript() *tree_sitter.Language {
+	return tree_sitter.NewLanguage(tree_sitter_javascript.Language())
+}
+
+func twig() *tree_sitter.Language {
+	return tree_sitter.NewLanguage(tree_sitter_twig.Language())
+}
+
+func php() *tree_sitter.Language {
+	return tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())
+}
+
+func TestAclIndexer(t *testing.T) {
+	indexer, err := NewAclIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	jsCode := `
+Shopware.Service('privileges').addPrivilegeMappingEntry({
+    category: 'permissions',
+    parent: 'catalogues',
+    key: 'product',
+    roles: {
+        viewer: {
+            privileges: ['product:read', 'product_media:read'],
+            dependencies: [],
+        },
+        editor: {
+            privileges: ['product:update'],
+            dependencies: ['product.viewer'],
+        },
+    },
+});
+`
+	jsPath := "/project/vendor/shopware/administration/Resources/app/administration/src/module/sw-product/acl/index.js"
+	jsTree := parse(t, javascript(), jsCode)
+	defer jsTree.Close()
+	require.NoError(t, indexer.Index(jsPath, jsTree.RootNode(), []byte(jsCode)))
+
+	phpCode := `<?php
+class MyPlugin extends Plugin
+{
+    public function enrichPrivileges(): array
+    {
+        return [
+            'product.viewer' => ['my_entity:read'],
+        ];
+    }
+}
+`
+	phpPath := "/project/custom/plugins/MyPlugin/src/MyPlugin.php"
+	phpTree := parse(t, php(), phpCode)
+	defer phpTree.Close()
+	require.NoError(t, indexer.Index(phpPath, phpTree.RootNode(), []byte(phpCode)))
+
+	privileges, err := indexer.GetAllPrivileges()
+	require.NoError(t, err)
+
+	byName := make(map[string]Privilege)
+	for _, privilege := range privileges {
+		byName[privilege.Name] = privilege
+	}
+
+	require.Len(t, byName, 6)
+	assert.Equal(t, PrivilegeKindRole, byName["product.viewer"].Kind)
+	assert.Equal(t, "catalogues", byName["product.viewer"].Parent)
+	assert.Equal(t, 7, byName["product.viewer"].Line)
+	assert.Equal(t, PrivilegeKindPrivilege, byName["product_media:read"].Kind)
+	assert.Equal(t, "permissions", byName["product:update"].Category)
+	assert.Equal(t, phpPath, byName["my_entity:read"].FilePath)
+	assert.Equal(t, 7, byName["my_entity:read"].Line)
+
+	assert.True(t, indexer.HasCorePrivileges())
+
+	require.NoError(t, indexer.RemovedFiles([]string{jsPath}))
+	assert.False(t, indexer.HasCorePrivileges())
+}
+
+func TestScriptPrivilegeReferences(t *testing.T) {
+	code := `
+Shopware.Module.register('sw-product', {
+    routes: {
+        index: { component: 'sw-product-list', meta: { privilege: 'product.viewer' } },
+    },
+    computed: {
+        canEdit() {
+            return this.acl.can('product.editor') && this.items.can('notAPrivilege');
+        },
+    },
+});
+`
+	tree := parse(t, javascript(), code)
+	defer tree.Close()
+
+	var names []string
+	for _, reference := range ScriptPrivilegeReferences(tree.RootNode(), []byte(code)) {
+		names = append(names, reference.Name)
+	}
+	assert.Equal(t, []string{"product.viewer", "product.editor"}, names)
+
+	offset := uint(strings.Index(code, "product.editor"))
+	reference := ScriptPrivilegeAt(tree.RootNode().NamedDescendantForByteRange(offset, offset), []byte(code))
+	require.NotNil(t, reference)
+	assert.Equal(t, "product.editor", reference.Name)
+}
+
+func TestPHPPrivilegeReferences(t *testing.T) {
+	code := `<?php
+class ProductController
+{
+    #[Route(path: '/api/_action/product', defaults: ['_acl' => ['product:read', 'product:update']], methods: ['POST'])]
+    public function action() {}
+}
+`
+	tree := parse(t, php(), code)
+	defer tree.Close()
+
+	var names []string
+	for _, reference := range PHPPrivilegeReferences(tree.RootNode(), []byte(code)) {
+		names = append(names, reference.Name)
+	}
+	assert.Equal(t, []string{"product:read", "product:update"}, names)
+
+	offset := uint(strings.Index(code, "POST"))
+	assert.Nil(t, PHPPrivilegeAt(tree.RootNode().NamedDescendantForByteRange(offset, offset), []byte(code)))
+}
+
+func TestTemplatePrivilegeReferences(t *testing.T) {
+	template := `<sw-button v-if="acl.can('product.editor')" :disabled="!acl.can('product.deleter') || isLoading">
+    {{ acl.can("product.creator") ? 'a' : 'b' }}
+</sw-button>`
+
+	tree := parse(t, twig(), template)
+	defer tree.Close()
+
+	references := TemplatePrivilegeReferences(tree.RootNode(), []byte(template))
+	require.Len(t, references, 3)
+	assert.Equal(t, "product.editor", references[0].Name)
+	assert.Equal(t, strings.Index(template, "product.editor"), references[0].Offset)
+	assert.Equal(t, "product.deleter", references[1].Name)
+	assert.Equal(t, "product.creator", references[2].Name)
+
+	assert.True(t, TemplatePrivilegeAt(tree.RootNode(), []byte(template), strings.Index(template, "editor")))
+	assert.False(t, TemplatePrivilegeAt(tree.RootNode(), []byte(template), strings.Index(template, "Loading")))
+}
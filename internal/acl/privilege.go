@@ -0,0 +1,198 @@
+package acl
+
+import (
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// PrivilegeKind describes how a privilege is defined
+type PrivilegeKind string
+
+const (
+	// PrivilegeKindRole is a role of a privilege mapping entry, e.g. "product.viewer"
+	PrivilegeKindRole PrivilegeKind = "role"
+
+	// PrivilegeKindPrivilege is a privilege granted by a role, e.g. "product:read"
+	PrivilegeKindPrivilege PrivilegeKind = "privilege"
+)
+
+// Privilege represents a known ACL privilege
+type Privilege struct {
+	// Name is the privilege key (e.g., "product.viewer" or "product:read")
+	Name string
+
+	// Kind describes how the privilege is defined
+	Kind PrivilegeKind
+
+	// Category is the category of the mapping entry (e.g., "permissions", "additional_permissions")
+	Category string
+
+	// Parent is the parent of the mapping entry in the permission grid (e.g., "catalogues")
+	Parent string
+
+	// FilePath is the absolute path to the defining file
+	FilePath string
+
+	// Line is the line number of the definition (1-based)
+	Line int
+}
+
+// parseAdminPrivileges extracts the roles and privileges of
+// Shopware.Service('privileges').addPrivilegeMappingEntry({ key, roles: { viewer: { privileges: [...] } } }) calls
+func parseAdminPrivileges(root *tree_sitter.Node, content []byte, filePath string) []Privilege {
+	var privileges []Privilege
+
+	for _, call := range treesitterhelper.FindAll(root, treesitterhelper.NodeKind("call_expression"), content) {
+		function := call.ChildByFieldName("function")
+		args := call.ChildByFieldName("arguments")
+		if function == nil || args == nil || function.Kind() != "member_expression" || args.NamedChildCount() == 0 {
+			continue
+		}
+
+		property := function.ChildByFieldName("property")
+		entry := args.NamedChild(0)
+		if property == nil || string(property.Utf8Text(content)) != "addPrivilegeMappingEntry" || entry.Kind() != "object" {
+			continue
+		}
+
+		var key, category, parent string
+		var roles *tree_sitter.Node
+		for i := uint(0); i < entry.NamedChildCount(); i++ {
+			pair := entry.NamedChild(i)
+			value := pair.ChildByFieldName("value")
+			if pair.Kind() != "pair" || value == nil {
+				continue
+			}
+
+			switch jsKey(pair, content) {
+			case "key":
+				key = jsString(value, content)
+			case "category":
+				category = jsString(value, content)
+			case "parent":
+				parent = jsString(value, content)
+			case "roles":
+				if value.Kind() == "object" {
+					roles = value
+				}
+			}
+		}
+
+		if key == "" || roles == nil {
+			continue
+		}
+
+		add := func(name string, kind PrivilegeKind, node *tree_sitter.Node) {
+			privileges = append(privileges, Privilege{
+				Name:     name,
+				Kind:     kind,
+				Category: category,
+				Parent:   parent,
+				FilePath: filePath,
+				Line:     int(node.StartPosition().Row) + 1,
+			})
+		}
+
+		for i := uint(0); i < roles.NamedChildCount(); i++ {
+			role := roles.NamedChild(i)
+			if role.Kind() != "pair" {
+				continue
+			}
+			add(key+"."+jsKey(role, content), PrivilegeKindRole, role)
+
+			definition := role.ChildByFieldName("value")
+			if definition == nil || definition.Kind() != "object" {
+				continue
+			}
+
+			for j := uint(0); j < definition.NamedChildCount(); j++ {
+				pair := definition.NamedChild(j)
+				value := pair.ChildByFieldName("value")
+				if pair.Kind() != "pair" || jsKey(pair, content) != "privileges" || value == nil || value.Kind() != "array" {
+					continue
+				}
+
+				for k := uint(0); k < value.NamedChildCount(); k++ {
+					if name := jsString(value.NamedChild(k), content); name != "" {
+						add(name, PrivilegeKindPrivilege, value.NamedChild(k))
+					}
+				}
+			}
+		}
+	}
+
+	return privileges
+}
+
+// parsePHPPrivileges extracts the privileges a plugin adds to existing roles in enrichPrivileges()
+//
+//	public function enrichPrivileges(): array
+//	{
+//	    return ['product.viewer' => ['my_entity:read']];
+//	}
+func parsePHPPrivileges(root *tree_sitter.Node, content []byte, filePath string) []Privilege {
+	var privileges []Privilege
+
+	for _, method := range treesitterhelper.FindAll(root, treesitterhelper.NodeKind("method_declaration"), content) {
+		name := method.ChildByFieldName("name")
+		body := method.ChildByFieldName("body")
+		if name == nil || body == nil || string(name.Utf8Text(content)) != "enrichPrivileges" {
+			continue
+		}
+
+		for _, element := range treesitterhelper.FindAll(body, treesitterhelper.NodeKind("array_element_initializer"), content) {
+			if element.NamedChildCount() < 2 || element.NamedChild(1).Kind() != "array_creation_expression" {
+				continue
+			}
+
+			values := element.NamedChild(1)
+			for i := uint(0); i < values.NamedChildCount(); i++ {
+				value := values.NamedChild(i)
+				if value.NamedChildCount() != 1 || value.NamedChild(0).Kind() != "string" {
+					continue
+				}
+
+				if privilege := phpString(value.NamedChild(0), content); privilege != "" {
+					privileges = append(privileges, Privilege{
+						Name:     privilege,
+						Kind:     PrivilegeKindPrivilege,
+						FilePath: filePath,
+						Line:     int(value.StartPosition().Row) + 1,
+					})
+				}
+			}
+		}
+	}
+
+	return privileges
+}
+
+// jsKey returns the key of an object pair
+func jsKey(pair *tree_sitter.Node, content []byte) string {
+	key := pair.ChildByFieldName("key")
+	if key == nil {
+		return ""
+	}
+
+	if key.Kind() == "string" {
+		return jsString(key, content)
+	}
+
+	return string(key.Utf8Text(content))
+}
+
+// jsString returns the content of a JavaScript string node, or an empty string for other nodes
+func jsString(node *tree_sitter.Node, content []byte) string {
+	if node == nil || node.Kind() != "string" {
+		return ""
+	}
+
+	return strings.Trim(string(node.Utf8Text(content)), "\"'")
+}
+
+// phpString returns the content of a PHP string node
+func phpString(node *tree_sitter.Node, content []byte) string {
+	return strings.Trim(string(node.Utf8Text(content)), "\"'")
+}
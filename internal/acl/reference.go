@@ -0,0 +1,167 @@
+package acl
+
+import (
+	"github.com/shopware/shopware-lsp/internal/admin"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// PrivilegeReference is a privilege used in a script or PHP file
+type PrivilegeReference struct {
+	// Name is the referenced privilege
+	Name string
+
+	// Node is the string node holding the privilege
+	Node *tree_sitter.Node
+}
+
+// ScriptPrivilegeAt returns the privilege reference at the given node of an admin script, or nil if the node
+// is not the argument of acl.can('...') or the value of a `privilege: '...'` property (route meta, navigation)
+func ScriptPrivilegeAt(node *tree_sitter.Node, content []byte) *PrivilegeReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" || !isScriptPrivilege(stringNode, content) {
+		return nil
+	}
+
+	return &PrivilegeReference{Name: jsString(stringNode, content), Node: stringNode}
+}
+
+// ScriptPrivilegeReferences returns all privileges used in acl.can() calls and `privilege` properties of an admin script
+func ScriptPrivilegeReferences(root *tree_sitter.Node, content []byte) []PrivilegeReference {
+	privilege := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "string" && isScriptPrivilege(node, content)
+	})
+
+	var references []PrivilegeReference
+	for _, node := range treesitterhelper.FindAll(root, privilege, content) {
+		references = append(references, PrivilegeReference{Name: jsString(node, content), Node: node})
+	}
+
+	return references
+}
+
+// isScriptPrivilege checks if a string is the first argument of acl.can() or the value of a `privilege` property
+func isScriptPrivilege(stringNode *tree_sitter.Node, content []byte) bool {
+	parent := stringNode.Parent()
+	if parent == nil {
+		return false
+	}
+
+	switch parent.Kind() {
+	case "pair":
+		value := parent.ChildByFieldName("value")
+		return value != nil && value.Id() == stringNode.Id() && jsKey(parent, content) == "privilege"
+	case "arguments":
+		call := parent.Parent()
+		if call == nil || call.Kind() != "call_expression" || parent.NamedChild(0).Id() != stringNode.Id() {
+			return false
+		}
+
+		function := call.ChildByFieldName("function")
+		if function == nil || function.Kind() != "member_expression" {
+			return false
+		}
+
+		object := function.ChildByFieldName("object")
+		property := function.ChildByFieldName("property")
+		if object == nil || property == nil || string(property.Utf8Text(content)) != "can" {
+			return false
+		}
+
+		objectName := string(object.Utf8Text(content))
+		return objectName == "acl" || objectName == "this.acl" || objectName == "Shopware.Service('acl')"
+	}
+
+	return false
+}
+
+// PHPPrivilegeAt returns the privilege reference at the given node of a PHP file, or nil if the node is not
+// a value of the `_acl` route default: #[Route(defaults: ['_acl' => ['product:read']])]
+func PHPPrivilegeAt(node *tree_sitter.Node, content []byte) *PrivilegeReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" || !isPHPAclValue(stringNode, content) {
+		return nil
+	}
+
+	return &PrivilegeReference{Name: phpString(stringNode, content), Node: stringNode}
+}
+
+// PHPPrivilegeReferences returns all privileges of `_acl` route defaults in a PHP file
+func PHPPrivilegeReferences(root *tree_sitter.Node, content []byte) []PrivilegeReference {
+	aclValue := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "string" && isPHPAclValue(node, content)
+	})
+
+	var references []PrivilegeReference
+	for _, node := range treesitterhelper.FindAll(root, aclValue, content) {
+		references = append(references, PrivilegeReference{Name: phpString(node, content), Node: node})
+	}
+
+	return references
+}
+
+// isPHPAclValue checks if a string is an element of the array assigned to the '_acl' key
+func isPHPAclValue(stringNode *tree_sitter.Node, content []byte) bool {
+	element := stringNode.Parent()
+	if element == nil || element.Kind() != "array_element_initializer" || element.NamedChildCount() != 1 {
+		return false
+	}
+
+	values := element.Parent()
+	if values == nil || values.Kind() != "array_creation_expression" {
+		return false
+	}
+
+	aclElement := values.Parent()
+	if aclElement == nil || aclElement.Kind() != "array_element_initializer" || aclElement.NamedChildCount() < 2 {
+		return false
+	}
+
+	key := aclElement.NamedChild(0)
+	return key.Kind() == "string" && phpString(key, content) == "_acl"
+}
+
+// TemplatePrivilegeReference is a privilege in an acl.can() call of an administration template
+type TemplatePrivilegeReference struct {
+	// Name is the referenced privilege
+	Name string
+
+	// Offset is the byte offset of the privilege in the template
+	Offset int
+}
+
+// TemplatePrivilegeReferences returns all privileges of acl.can() calls and `privilege` properties in the expressions
+// of an administration template
+func TemplatePrivilegeReferences(root *tree_sitter.Node, content []byte) []TemplatePrivilegeReference {
+	var references []TemplatePrivilegeReference
+
+	for _, expression := range admin.ParseTemplateExpressions(root, content) {
+		tree := expression.Parse()
+		for _, reference := range ScriptPrivilegeReferences(tree.RootNode(), tree.Source) {
+			references = append(references, TemplatePrivilegeReference{
+				Name:   reference.Name,
+				Offset: tree.TemplateOffset(reference.Node.StartByte() + 1),
+			})
+		}
+		tree.Close()
+	}
+
+	return references
+}
+
+// TemplatePrivilegeAt checks if the given offset of a template is inside the privilege of an acl.can('...') call, used for completion
+func TemplatePrivilegeAt(root *tree_sitter.Node, content []byte, offset int) bool {
+	for _, reference := range TemplatePrivilegeReferences(root, content) {
+		if reference.Offset <= offset && offset <= reference.Offset+len(reference.Name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package admin
+
+import "strings"
+
+// nativeAttributes are attributes every element accepts, Vue passes them through to the root element of a component
+var nativeAttributes = map[string]bool{
+	"class": true, "style": true, "id": true, "key": true, "ref": true, "slot": true, "is": true,
+	"title": true, "role": true, "tabindex": true, "hidden": true, "lang": true, "dir": true,
+	"name": true, "type": true, "value": true, "placeholder": true, "autocomplete": true, "autofocus": true,
+	"href": true, "target": true, "rel": true, "src": true, "alt": true, "for": true,
+	"draggable": true, "contenteditable": true, "spellcheck": true, "accesskey": true, "translate": true,
+	"inputmode": true, "enterkeyhint": true, "part": true,
+}
+
+// nativeEvents are DOM events which can be listened to on any component, they fall through to the root element
+var nativeEvents = map[string]bool{
+	"click": true, "dblclick": true, "contextmenu": true, "auxclick": true,
+	"mousedown": true, "mouseup": true, "mouseenter": true, "mouseleave": true, "mouseover": true, "mouseout": true, "mousemove": true,
+	"keydown": true, "keyup": true, "keypress": true,
+	"focus": true, "blur": true, "focusin": true, "focusout": true,
+	"input": true, "change": true, "submit": true, "reset": true,
+	"scroll": true, "wheel": true, "resize": true,
+	"drag": true, "dragstart": true, "dragend": true, "dragenter": true, "dragleave": true, "dragover": true, "drop": true,
+	"touchstart": true, "touchend": true, "touchmove": true, "touchcancel": true,
+	"pointerdown": true, "pointerup": true, "pointermove": true, "pointerenter": true, "pointerleave": true, "pointercancel": true,
+	"copy": true, "cut": true, "paste": true,
+}
+
+// IsNativeAttribute checks if an attribute is a global HTML attribute, a data-* or aria-* attribute
+//
+// Examples:
+//
+//	"class"        -> true
+//	"data-id"      -> true
+//	"aria-label"   -> true
+//	"is-loading"   -> false
+func IsNativeAttribute(name string) bool {
+	name = strings.ToLower(name)
+
+	return nativeAttributes[name] || strings.HasPrefix(name, "data-") || strings.HasPrefix(name, "aria-")
+}
+
+// IsNativeEvent checks if an event name is a DOM event, modifiers like .prevent are ignored
+//
+// Examples:
+//
+//	"click"          -> true
+//	"click.prevent"  -> true
+//	"update:value"   -> false
+func IsNativeEvent(name string) bool {
+	name, _, _ = strings.Cut(name, ".")
+
+	return nativeEvents[strings.ToLower(name)]
+}
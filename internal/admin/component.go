@@ -41,11 +41,54 @@ type VueComponent struct {
 	// TemplatePath is the path to the Twig template (from the template import)
 	TemplatePath string
 
+	// Mixins contains the names of the mixins used by the component
+	Mixins []string
+
+	// UnresolvedMixins is set when a mixin of the component is not indexed, so its props and emits are incomplete
+	// This is only populated by GetComponentWithDefinition and not persisted
+	UnresolvedMixins bool `msgpack:"-"`
+
 	// InlineDefinition contains the parsed definition for inline component registrations
 	// This is only populated during indexing and not persisted (used to store in definition index)
 	InlineDefinition *ComponentDefinition `msgpack:"-"`
 }
 
+// ComponentOverride represents a Shopware.Component.override call of an existing component
+type ComponentOverride struct {
+	// Name is the overridden component name
+	Name string
+
+	// FilePath is the absolute path to the file containing the override call
+	FilePath string
+
+	// ImportPath is the path from the import statement, if the override is loaded dynamically
+	ImportPath string
+
+	// DefinitionPath is the resolved absolute path to the override definition file
+	DefinitionPath string
+
+	// Line is the line number of the override call
+	Line int
+
+	// Definition contains the parsed inline override definition
+	Definition *ComponentDefinition
+}
+
+// VueMixin represents a mixin registered with Shopware.Mixin.register
+type VueMixin struct {
+	// Name is the mixin name (e.g., "notification")
+	Name string
+
+	// FilePath is the absolute path to the registration file
+	FilePath string
+
+	// Line is the line number where the mixin is registered
+	Line int
+
+	// Definition contains the parsed mixin definition
+	Definition ComponentDefinition
+}
+
 // VueComponentProp represents a prop definition in a Vue component
 type VueComponentProp struct {
 	// Name is the prop name
@@ -54,16 +97,30 @@ type VueComponentProp struct {
 	// Type is the prop type (e.g., "String", "Boolean", "Object")
 	Type string
 
+	// PropType is the TypeScript type of `type: Object as PropType<Entity<'product'>>` (e.g., "Entity<'product'>")
+	PropType string
+
 	// Required indicates if the prop is required
 	Required bool
 
 	// Default is the default value (as string representation)
 	Default string
 
+	// AllowedValues are the literal values accepted by the validator (e.g. ['primary', 'ghost'].includes(value))
+	AllowedValues []string
+
 	// Line is the line number where the prop is defined (1-based)
 	Line int
 }
 
+// DisplayType returns the TypeScript type when available, the runtime type otherwise
+func (p VueComponentProp) DisplayType() string {
+	if p.PropType != "" {
+		return p.PropType
+	}
+	return p.Type
+}
+
 // VueComponentSlot represents a slot definition in a Vue component template
 type VueComponentSlot struct {
 	// Name is the slot name (e.g., "default", "actions", "header")
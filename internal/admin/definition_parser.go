@@ -18,8 +18,11 @@ func ParseComponentDefinition(root *tree_sitter.Node, content []byte) *Component
 		return def
 	}
 
-	// Get the object being exported
-	objNode := treesitterhelper.GetFirstNodeOfKind(exportDefault, "object")
+	// Get the object being exported, either directly or wrapped in defineComponent({...})
+	var objNode *tree_sitter.Node
+	for i := uint(0); i < exportDefault.ChildCount() && objNode == nil; i++ {
+		objNode = ComponentConfigObject(exportDefault.Child(i), content)
+	}
 	if objNode == nil {
 		return def
 	}
@@ -37,6 +40,9 @@ func ParseComponentDefinition(root *tree_sitter.Node, content []byte) *Component
 			if name == "template" {
 				def.HasTemplate = true
 			}
+		case "method_definition":
+			parseDataMethod(child, content, def)
+			parseSetupMethod(child, content, def)
 		}
 	}
 
@@ -53,10 +59,70 @@ type ComponentDefinition struct {
 	Emits        []string
 	Methods      []string
 	Computed     []string
+	Data         []string
+	Inject       []string
+	Mixins       []string
 	Slots        []VueComponentSlot
 	Blocks       []TwigBlock
 	TemplatePath string
 	HasTemplate  bool
+
+	// MemberLines maps method, computed, data and inject names to their line (1-based)
+	MemberLines map[string]int
+
+	// DynamicMembers is set when members are added in a way that can't be listed, like ...mapState() or setup()
+	DynamicMembers bool
+}
+
+// ComponentConfigObject returns the component config object of an expression.
+// Supported are plain objects and objects wrapped in defineComponent() or Component.wrapComponentConfig()
+//
+// Examples:
+//
+//	{ props: {} }                                     -> { props: {} }
+//	defineComponent({ props: {} })                    -> { props: {} }
+//	Shopware.Component.wrapComponentConfig({ ... })   -> { ... }
+//	{ props: {} } satisfies ComponentConfig           -> { props: {} }
+func ComponentConfigObject(node *tree_sitter.Node, content []byte) *tree_sitter.Node {
+	if node == nil {
+		return nil
+	}
+
+	switch node.Kind() {
+	case "object":
+		return node
+	case "parenthesized_expression", "as_expression", "satisfies_expression":
+		return ComponentConfigObject(node.NamedChild(0), content)
+	case "call_expression":
+		function := node.ChildByFieldName("function")
+		if function == nil {
+			return nil
+		}
+
+		functionName := string(function.Utf8Text(content))
+		if !strings.HasSuffix(functionName, "defineComponent") && !strings.HasSuffix(functionName, "wrapComponentConfig") {
+			return nil
+		}
+
+		args := node.ChildByFieldName("arguments")
+		if args == nil || args.NamedChildCount() == 0 {
+			return nil
+		}
+
+		return ComponentConfigObject(args.NamedChild(0), content)
+	}
+
+	return nil
+}
+
+// unwrapTypeAssertion returns the expression of a TypeScript assertion like `['a', 'b'] as const`,
+// other nodes are returned as they are
+func unwrapTypeAssertion(node *tree_sitter.Node) *tree_sitter.Node {
+	for node != nil && (node.Kind() == "as_expression" || node.Kind() == "satisfies_expression") && node.NamedChildCount() > 0 {
+		node = node.NamedChild(0)
+	}
+
+	return node
 }
 
 // findExportDefault finds the export default statement in the AST
@@ -84,18 +150,18 @@ func parsePair(node *tree_sitter.Node, content []byte, def *ComponentDefinition)
 	}
 	propName := string(propIdent.Utf8Text(content))
 
-	// Get value node (second child after property_identifier and colon)
-	var valueNode *tree_sitter.Node
-	for i := uint(0); i < node.ChildCount(); i++ {
-		child := node.Child(i)
-		kind := child.Kind()
-		if kind == "object" || kind == "array" || kind == "identifier" {
-			valueNode = child
-			break
-		}
+	// data: () => ({ ... }) and data: function () { ... } have a function as value
+	switch propName {
+	case "data":
+		parseDataMethod(node.ChildByFieldName("value"), content, def)
+		return
+	case "setup":
+		def.DynamicMembers = true
+		return
 	}
 
-	if valueNode == nil {
+	valueNode := unwrapTypeAssertion(node.ChildByFieldName("value"))
+	if valueNode == nil || (valueNode.Kind() != "object" && valueNode.Kind() != "array" && valueNode.Kind() != "identifier") {
 		return
 	}
 
@@ -106,8 +172,17 @@ func parsePair(node *tree_sitter.Node, content []byte, def *ComponentDefinition)
 		def.Emits = parseEmits(valueNode, content)
 	case "methods":
 		def.Methods = parseMethods(valueNode, content)
+		def.setMemberLines(valueNode, content)
+		def.DynamicMembers = def.DynamicMembers || hasSpreadElement(valueNode)
 	case "computed":
 		def.Computed = parseMethods(valueNode, content) // Same structure as methods
+		def.setMemberLines(valueNode, content)
+		def.DynamicMembers = def.DynamicMembers || hasSpreadElement(valueNode)
+	case "inject":
+		def.Inject = parseInject(valueNode, content)
+		def.setMemberLines(valueNode, content)
+	case "mixins":
+		def.Mixins = parseMixins(valueNode, content)
 	case "template":
 		def.HasTemplate = true
 	}
@@ -159,17 +234,23 @@ func parsePropDefinition(node *tree_sitter.Node, content []byte) *VueComponentPr
 	// Get prop definition object
 	propObj := treesitterhelper.GetFirstNodeOfKind(node, "object")
 	if propObj == nil {
-		// Prop might be defined with just a type: `title: String`
-		typeIdent := treesitterhelper.GetFirstNodeOfKind(node, "identifier")
-		if typeIdent != nil {
-			prop.Type = string(typeIdent.Utf8Text(content))
-		}
+		// Prop might be defined with just a type: `title: String` or `product: Object as PropType<Entity<'product'>>`
+		prop.Type, prop.PropType = parsePropType(node.ChildByFieldName("value"), content)
 		return prop
 	}
 
 	// Parse prop options
 	for i := uint(0); i < propObj.ChildCount(); i++ {
 		child := propObj.Child(i)
+		if child.Kind() == "method_definition" {
+			// validator(value) { return ['primary', 'ghost'].includes(value); }
+			methodIdent := treesitterhelper.GetFirstNodeOfKind(child, "property_identifier")
+			if methodIdent != nil && string(methodIdent.Utf8Text(content)) == "validator" {
+				prop.AllowedValues = parseValidatorValues(child, content)
+			}
+			continue
+		}
+
 		if child.Kind() == "pair" {
 			optIdent := treesitterhelper.GetFirstNodeOfKind(child, "property_identifier")
 			if optIdent == nil {
@@ -179,14 +260,7 @@ func parsePropDefinition(node *tree_sitter.Node, content []byte) *VueComponentPr
 
 			switch optName {
 			case "type":
-				// Get the type identifier
-				for j := uint(0); j < child.ChildCount(); j++ {
-					c := child.Child(j)
-					if c.Kind() == "identifier" {
-						prop.Type = string(c.Utf8Text(content))
-						break
-					}
-				}
+				prop.Type, prop.PropType = parsePropType(child.ChildByFieldName("value"), content)
 			case "required":
 				// Check if value is true
 				for j := uint(0); j < child.ChildCount(); j++ {
@@ -206,6 +280,9 @@ func parsePropDefinition(node *tree_sitter.Node, content []byte) *VueComponentPr
 						break
 					}
 				}
+			case "validator":
+				// validator: (value) => ['primary', 'ghost'].includes(value)
+				prop.AllowedValues = parseValidatorValues(child.ChildByFieldName("value"), content)
 			}
 		}
 	}
@@ -213,6 +290,119 @@ func parsePropDefinition(node *tree_sitter.Node, content []byte) *VueComponentPr
 	return prop
 }
 
+// parsePropType splits a prop type expression into the runtime type and the TypeScript type
+//
+// Examples:
+//
+//	String                                -> "String", ""
+//	Object as PropType<Entity<'product'>> -> "Object", "Entity<'product'>"
+//	[String, Number]                      -> "", ""
+func parsePropType(node *tree_sitter.Node, content []byte) (string, string) {
+	if node == nil {
+		return "", ""
+	}
+
+	switch node.Kind() {
+	case "identifier":
+		return string(node.Utf8Text(content)), ""
+	case "as_expression":
+		runtimeType, _ := parsePropType(node.NamedChild(0), content)
+
+		typeNode := node.NamedChild(1)
+		if typeNode == nil || typeNode.Kind() != "generic_type" {
+			return runtimeType, ""
+		}
+
+		name := typeNode.ChildByFieldName("name")
+		typeArguments := typeNode.ChildByFieldName("type_arguments")
+		if name == nil || string(name.Utf8Text(content)) != "PropType" || typeArguments == nil || typeArguments.NamedChildCount() == 0 {
+			return runtimeType, ""
+		}
+
+		return runtimeType, string(typeArguments.NamedChild(0).Utf8Text(content))
+	}
+
+	return "", ""
+}
+
+// parseValidatorValues extracts the allowed values of a validator which only returns ['a', 'b'].includes(value)
+// Validators with any other logic return nil, as their accepted values cannot be known statically
+func parseValidatorValues(function *tree_sitter.Node, content []byte) []string {
+	if function == nil {
+		return nil
+	}
+
+	parameter := validatorParameter(function, content)
+	body := function.ChildByFieldName("body")
+	if parameter == "" || body == nil {
+		return nil
+	}
+
+	// A block body has to consist of a single return statement
+	if body.Kind() == "statement_block" {
+		if body.NamedChildCount() != 1 || body.NamedChild(0).Kind() != "return_statement" {
+			return nil
+		}
+		body = body.NamedChild(0).NamedChild(0)
+	}
+
+	for body != nil && body.Kind() == "parenthesized_expression" {
+		body = body.NamedChild(0)
+	}
+	if body == nil || body.Kind() != "call_expression" {
+		return nil
+	}
+
+	callee := body.ChildByFieldName("function")
+	arguments := body.ChildByFieldName("arguments")
+	if callee == nil || callee.Kind() != "member_expression" || arguments == nil || arguments.NamedChildCount() != 1 {
+		return nil
+	}
+
+	object := callee.ChildByFieldName("object")
+	property := callee.ChildByFieldName("property")
+	argument := arguments.NamedChild(0)
+	if object == nil || object.Kind() != "array" || property == nil || property.Utf8Text(content) != "includes" ||
+		argument.Kind() != "identifier" || argument.Utf8Text(content) != parameter {
+		return nil
+	}
+
+	var values []string
+	for i := uint(0); i < object.NamedChildCount(); i++ {
+		element := object.NamedChild(i)
+		if element.Kind() != "string" {
+			return nil
+		}
+		values = append(values, extractStringContent(element, content))
+	}
+
+	return values
+}
+
+// validatorParameter returns the name of the first parameter of a validator function
+func validatorParameter(function *tree_sitter.Node, content []byte) string {
+	// Arrow functions with a single parameter may omit the parentheses
+	if parameter := function.ChildByFieldName("parameter"); parameter != nil {
+		return parameter.Utf8Text(content)
+	}
+
+	parameters := function.ChildByFieldName("parameters")
+	if parameters == nil || parameters.NamedChildCount() == 0 {
+		return ""
+	}
+
+	parameter := parameters.NamedChild(0)
+	// TypeScript wraps parameters with their type annotation
+	if pattern := parameter.ChildByFieldName("pattern"); pattern != nil {
+		parameter = pattern
+	}
+	if parameter.Kind() != "identifier" {
+		return ""
+	}
+
+	return parameter.Utf8Text(content)
+}
+
 // parseEmits parses the emits array
 func parseEmits(node *tree_sitter.Node, content []byte) []string {
 	var emits []string
@@ -263,6 +453,185 @@ func parseMethods(node *tree_sitter.Node, content []byte) []string {
 	return methods
 }
 
+// parseInject parses the inject array or object
+// e.g. inject: ['repositoryFactory', 'acl'] or inject: { repository: 'repositoryFactory' }
+func parseInject(node *tree_sitter.Node, content []byte) []string {
+	if node.Kind() == "array" {
+		return parseEmits(node, content) // Same structure as emits
+	}
+
+	var injects []string
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if name := memberName(node.NamedChild(i), content); name != "" {
+			injects = append(injects, name)
+		}
+	}
+
+	return injects
+}
+
+// parseMixins parses the mixins array
+// e.g. mixins: [Mixin.getByName('notification'), 'listing']
+func parseMixins(node *tree_sitter.Node, content []byte) []string {
+	var mixins []string
+
+	if node.Kind() != "array" {
+		return mixins
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		switch child.Kind() {
+		case "string":
+			mixins = append(mixins, extractStringContent(child, content))
+		case "call_expression":
+			function := child.ChildByFieldName("function")
+			args := child.ChildByFieldName("arguments")
+			if function == nil || args == nil || args.NamedChildCount() == 0 {
+				continue
+			}
+			if !strings.HasSuffix(string(function.Utf8Text(content)), "getByName") {
+				continue
+			}
+			if arg := args.NamedChild(0); arg.Kind() == "string" {
+				mixins = append(mixins, extractStringContent(arg, content))
+			}
+		}
+	}
+
+	return mixins
+}
+
+// parseDataMethod collects the keys of the object returned by data()
+// Supported are data() { return {...} }, data: () => ({...}) and data: function () { return {...} }
+func parseDataMethod(node *tree_sitter.Node, content []byte, def *ComponentDefinition) {
+	if node == nil {
+		return
+	}
+
+	if node.Kind() == "method_definition" {
+		name := node.ChildByFieldName("name")
+		if name == nil || string(name.Utf8Text(content)) != "data" {
+			return
+		}
+	}
+
+	if node.ChildByFieldName("body") == nil {
+		return
+	}
+
+	returned := returnedObject(node)
+	if returned == nil {
+		def.DynamicMembers = true
+		return
+	}
+
+	def.DynamicMembers = def.DynamicMembers || hasSpreadElement(returned)
+
+	def.Data = nil
+	for i := uint(0); i < returned.NamedChildCount(); i++ {
+		if name := memberName(returned.NamedChild(i), content); name != "" {
+			def.Data = append(def.Data, name)
+		}
+	}
+	def.setMemberLines(returned, content)
+}
+
+// returnedObject returns the object literal returned by a method, function or arrow function
+// e.g. data() { return { ... } } or () => ({ ... })
+func returnedObject(function *tree_sitter.Node) *tree_sitter.Node {
+	body := function.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	var returned *tree_sitter.Node
+	if body.Kind() == "statement_block" {
+		for i := uint(0); i < body.NamedChildCount(); i++ {
+			if statement := body.NamedChild(i); statement.Kind() == "return_statement" && statement.NamedChildCount() > 0 {
+				returned = statement.NamedChild(0)
+			}
+		}
+	} else {
+		returned = body
+	}
+
+	for returned != nil && returned.Kind() == "parenthesized_expression" {
+		returned = returned.NamedChild(0)
+	}
+
+	if returned == nil || returned.Kind() != "object" {
+		return nil
+	}
+
+	return returned
+}
+
+// parseSetupMethod marks the definition as dynamic when it uses setup(), its bindings can't be listed
+func parseSetupMethod(node *tree_sitter.Node, content []byte, def *ComponentDefinition) {
+	if name := node.ChildByFieldName("name"); name != nil && string(name.Utf8Text(content)) == "setup" {
+		def.DynamicMembers = true
+	}
+}
+
+// hasSpreadElement checks if an object contains a spread like ...mapState()
+func hasSpreadElement(node *tree_sitter.Node) bool {
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if node.NamedChild(i).Kind() == "spread_element" {
+			return true
+		}
+	}
+	return false
+}
+
+// setMemberLines records the line of every key of an object or every string of an array
+func (def *ComponentDefinition) setMemberLines(node *tree_sitter.Node, content []byte) {
+	if def.MemberLines == nil {
+		def.MemberLines = make(map[string]int)
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		name := memberName(child, content)
+		if name == "" {
+			continue
+		}
+		if _, ok := def.MemberLines[name]; !ok {
+			def.MemberLines[name] = int(child.StartPosition().Row) + 1
+		}
+	}
+}
+
+// memberName returns the key of an object member or object type member, or the content of a string in an array
+func memberName(node *tree_sitter.Node, content []byte) string {
+	switch node.Kind() {
+	case "method_definition":
+		if name := node.ChildByFieldName("name"); name != nil {
+			return string(name.Utf8Text(content))
+		}
+	case "property_signature", "method_signature":
+		if name := node.ChildByFieldName("name"); name != nil {
+			if name.Kind() == "string" {
+				return extractStringContent(name, content)
+			}
+			return string(name.Utf8Text(content))
+		}
+	case "pair":
+		if key := node.ChildByFieldName("key"); key != nil {
+			if key.Kind() == "string" {
+				return extractStringContent(key, content)
+			}
+			return string(key.Utf8Text(content))
+		}
+	case "shorthand_property_identifier":
+		return string(node.Utf8Text(content))
+	case "string":
+		return extractStringContent(node, content)
+	}
+
+	return ""
+}
+
 // extractStringContent extracts the content from a string node
 func extractStringContent(node *tree_sitter.Node, content []byte) string {
 	for i := uint(0); i < node.ChildCount(); i++ {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
 )
 
 func parseJS(t *testing.T, code string) *tree_sitter.Node {
@@ -21,6 +22,18 @@ func parseJS(t *testing.T, code string) *tree_sitter.Node {
 	return tree.RootNode()
 }
 
+func parseTS(t *testing.T, code string) *tree_sitter.Node {
+	parser := tree_sitter.NewParser()
+	t.Cleanup(func() { parser.Close() })
+
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript())))
+
+	tree := parser.Parse([]byte(code), nil)
+	t.Cleanup(func() { tree.Close() })
+
+	return tree.RootNode()
+}
+
 func TestParseComponentDefinition_Props(t *testing.T) {
 	code := `
 export default {
@@ -218,3 +231,112 @@ const component = {
 	assert.Empty(t, def.Props)
 	assert.Empty(t, def.Methods)
 }
+
+func TestParseComponentDefinition_PropValidator(t *testing.T) {
+	code := `
+export default {
+    props: {
+        variant: {
+            type: String,
+            validator(value) {
+                return ['primary', 'ghost', 'danger'].includes(value);
+            },
+        },
+        size: {
+            type: String,
+            validator: (value) => ['small', 'large'].includes(value),
+        },
+        position: {
+            type: String,
+            validator(value) {
+                return value.startsWith('top');
+            },
+        },
+        align: {
+            type: String,
+            validator: (value) => !['left', 'right'].includes(value),
+        },
+        placement: {
+            type: String,
+            validator(value) {
+                return value === '' || ['top', 'bottom'].includes(value);
+            },
+        },
+        width: {
+            type: [String, Number],
+            validator: value => typeof value === 'number' || ['auto', 'full'].includes(value),
+        },
+        color: {
+            type: String,
+            validator: value => ['red', 'blue'].includes(value.toLowerCase()),
+        },
+    },
+};
+`
+	root := parseJS(t, code)
+	def := ParseComponentDefinition(root, []byte(code))
+
+	require.Len(t, def.Props, 7)
+	assert.Equal(t, []string{"primary", "ghost", "danger"}, def.Props[0].AllowedValues)
+	assert.Equal(t, []string{"small", "large"}, def.Props[1].AllowedValues)
+	assert.Nil(t, def.Props[2].AllowedValues)
+	assert.Nil(t, def.Props[3].AllowedValues, "negated validator")
+	assert.Nil(t, def.Props[4].AllowedValues, "validator with ||")
+	assert.Nil(t, def.Props[5].AllowedValues, "arrow validator with ||")
+	assert.Nil(t, def.Props[6].AllowedValues, "validator not checking the parameter")
+}
+
+func TestParseComponentDefinition_TypedProps(t *testing.T) {
+	code := `
+import type { PropType } from 'vue';
+import template from './sw-product-card.html.twig';
+
+export default defineComponent({
+    template,
+
+    props: {
+        product: {
+            type: Object as PropType<Entity<'product'>>,
+            required: true,
+        },
+        variants: Array as PropType<string[]>,
+        label: String,
+    },
+
+    emits: ['select'] as const,
+});
+`
+	root := parseTS(t, code)
+	def := ParseComponentDefinition(root, []byte(code))
+
+	require.Len(t, def.Props, 3)
+
+	assert.Equal(t, "product", def.Props[0].Name)
+	assert.Equal(t, "Object", def.Props[0].Type)
+	assert.Equal(t, "Entity<'product'>", def.Props[0].PropType)
+	assert.Equal(t, "Entity<'product'>", def.Props[0].DisplayType())
+	assert.True(t, def.Props[0].Required)
+
+	assert.Equal(t, "Array", def.Props[1].Type)
+	assert.Equal(t, "string[]", def.Props[1].PropType)
+
+	assert.Equal(t, "String", def.Props[2].DisplayType())
+
+	assert.Equal(t, []string{"select"}, def.Emits)
+	assert.Equal(t, "./sw-product-card.html.twig", def.TemplatePath)
+}
+
+func TestParseComponentDefinition_WrapComponentConfig(t *testing.T) {
+	code := `
+export default Shopware.Component.wrapComponentConfig({
+    props: {
+        title: String,
+    },
+});
+`
+	root := parseTS(t, code)
+	def := ParseComponentDefinition(root, []byte(code))
+
+	require.Len(t, def.Props, 1)
+	assert.Equal(t, "title", def.Props[0].Name)
+}
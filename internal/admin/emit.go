@@ -0,0 +1,266 @@
+package admin
+
+import (
+	"slices"
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// EmitReference is an event emitted with $emit('...') in an admin script
+type EmitReference struct {
+	// Name is the emitted event
+	Name string
+
+	// Node is the string node holding the event name
+	Node *tree_sitter.Node
+}
+
+// EmitReferenceAt returns the emit reference at the given node, or nil if the node is not the event of a $emit call
+func EmitReferenceAt(node *tree_sitter.Node, content []byte) *EmitReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" || !isEmitEvent(stringNode, content) {
+		return nil
+	}
+
+	return &EmitReference{Name: extractStringContent(stringNode, content), Node: stringNode}
+}
+
+// EmitReferences returns all events emitted with $emit('...') in an admin script
+func EmitReferences(root *tree_sitter.Node, content []byte) []EmitReference {
+	event := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "string" && isEmitEvent(node, content)
+	})
+
+	var references []EmitReference
+	for _, node := range treesitterhelper.FindAll(root, event, content) {
+		references = append(references, EmitReference{Name: extractStringContent(node, content), Node: node})
+	}
+
+	return references
+}
+
+// isEmitEvent checks if a string is the first argument of this.$emit() or $emit()
+func isEmitEvent(stringNode *tree_sitter.Node, content []byte) bool {
+	args := stringNode.Parent()
+	if args == nil || args.Kind() != "arguments" || args.NamedChild(0).Id() != stringNode.Id() {
+		return false
+	}
+
+	call := args.Parent()
+	if call == nil || call.Kind() != "call_expression" {
+		return false
+	}
+
+	function := call.ChildByFieldName("function")
+	if function == nil {
+		return false
+	}
+
+	switch function.Kind() {
+	case "identifier":
+		return string(function.Utf8Text(content)) == "$emit"
+	case "member_expression":
+		property := function.ChildByFieldName("property")
+		return property != nil && string(property.Utf8Text(content)) == "$emit"
+	}
+
+	return false
+}
+
+// ResolveComponentEmits returns the events declared in the emits of a component, its overrides, mixins
+// and Component.extend parents, and whether the list is complete. It is incomplete when a parent, mixin
+// or definition is not indexed.
+func (idx *AdminComponentIndexer) ResolveComponentEmits(name string) ([]string, bool, error) {
+	var emits []string
+	complete := true
+	seen := make(map[string]bool)
+	seenMixins := make(map[string]bool)
+
+	addDefinition := func(def *ComponentDefinition) {
+		for _, emit := range def.Emits {
+			if !slices.Contains(emits, emit) {
+				emits = append(emits, emit)
+			}
+		}
+
+		_, mixinEmits, mixinsComplete := idx.mixinInterface(def.Mixins, seenMixins)
+		complete = complete && mixinsComplete
+		for _, emit := range mixinEmits {
+			if !slices.Contains(emits, emit) {
+				emits = append(emits, emit)
+			}
+		}
+	}
+
+	for current := name; current != "" && !seen[current]; {
+		seen[current] = true
+
+		overrides, err := idx.GetComponentOverrides(current)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, override := range overrides {
+			if def := idx.overrideDefinition(override); def != nil {
+				addDefinition(def)
+			} else {
+				complete = false
+			}
+		}
+
+		components, err := idx.GetComponent(current)
+		if err != nil {
+			return nil, false, err
+		}
+
+		parent := ""
+		defined := false
+		for _, comp := range components {
+			if parent == "" {
+				parent = comp.ExtendsComponent
+			}
+
+			if defined {
+				continue
+			}
+
+			if def := idx.definitionOf(comp); def != nil {
+				addDefinition(def)
+				defined = true
+			}
+		}
+
+		if !defined {
+			complete = false
+		}
+
+		current = parent
+	}
+
+	return emits, complete, nil
+}
+
+// GetScriptEmits returns the events declared for the component or mixin of the given script context
+func (idx *AdminComponentIndexer) GetScriptEmits(ctx ScriptContext) ([]string, error) {
+	if ctx.MixinName != "" {
+		_, emits, _ := idx.mixinInterface([]string{ctx.MixinName}, make(map[string]bool))
+		return emits, nil
+	}
+
+	if ctx.ComponentName != "" {
+		emits, _, err := idx.ResolveComponentEmits(ctx.ComponentName)
+		return emits, err
+	}
+
+	return nil, nil
+}
+
+// IsEventDeclared checks an event against declared emits, ignoring kebab/camel case differences like Vue does
+func IsEventDeclared(event string, emits []string) bool {
+	return slices.Contains(emits, event) || slices.Contains(emits, KebabToCamel(event)) || slices.Contains(emits, CamelToKebab(event))
+}
+
+// EmitsInsertion returns where and what to insert to declare an event in the emits of the component config
+// surrounding the node. The event is appended to an existing `emits` array, otherwise an `emits` property is
+// added as the first property of the config.
+func EmitsInsertion(node *tree_sitter.Node, content []byte, event string) (tree_sitter.Point, string, bool) {
+	config := componentConfigAt(node, content)
+	if config == nil {
+		return tree_sitter.Point{}, "", false
+	}
+
+	quoted := "'" + event + "'"
+
+	for i := uint(0); i < config.NamedChildCount(); i++ {
+		pair := config.NamedChild(i)
+		if pair.Kind() != "pair" || memberName(pair, content) != "emits" {
+			continue
+		}
+
+		value := pair.ChildByFieldName("value")
+		if value == nil || value.Kind() != "array" {
+			return tree_sitter.Point{}, "", false
+		}
+
+		if count := value.NamedChildCount(); count > 0 {
+			return value.NamedChild(count - 1).EndPosition(), ", " + quoted, true
+		}
+
+		return value.Child(0).EndPosition(), quoted, true
+	}
+
+	if config.NamedChildCount() == 0 {
+		return config.Child(0).EndPosition(), " emits: [" + quoted + "] ", true
+	}
+
+	first := config.NamedChild(0)
+	lineStart := strings.LastIndexByte(string(content[:first.StartByte()]), '\n') + 1
+	indent := string(content[lineStart:first.StartByte()])
+	if strings.TrimSpace(indent) != "" {
+		indent = " "
+	} else {
+		indent = "\n" + indent
+	}
+
+	return first.StartPosition(), "emits: [" + quoted + "]," + indent, true
+}
+
+// componentConfigAt returns the config object of the component registration, override, mixin or
+// export default surrounding the node
+func componentConfigAt(node *tree_sitter.Node, content []byte) *tree_sitter.Node {
+	for current := node; current != nil; current = current.Parent() {
+		if current.Kind() == "object" && isComponentConfig(current, content) {
+			return current
+		}
+	}
+
+	return nil
+}
+
+// isComponentConfig checks if an object is passed to Component.register/extend/override or Mixin.register,
+// or is exported as default, optionally wrapped in defineComponent() or wrapComponentConfig()
+func isComponentConfig(object *tree_sitter.Node, content []byte) bool {
+	node := object
+
+	if args := node.Parent(); args != nil && args.Kind() == "arguments" {
+		if call := args.Parent(); call != nil && call.Kind() == "call_expression" {
+			if wrapped := ComponentConfigObject(call, content); wrapped != nil && wrapped.Id() == object.Id() {
+				node = call
+			}
+		}
+	}
+
+	parent := node.Parent()
+	if parent == nil {
+		return false
+	}
+
+	switch parent.Kind() {
+	case "export_statement":
+		return true
+	case "arguments":
+		call := parent.Parent()
+		if call == nil || call.Kind() != "call_expression" {
+			return false
+		}
+
+		function := call.ChildByFieldName("function")
+		if function == nil {
+			return false
+		}
+
+		functionName := string(function.Utf8Text(content))
+		for _, suffix := range []string{"Component.register", "Component.extend", "Component.override", "Mixin.register"} {
+			if strings.HasSuffix(functionName, suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
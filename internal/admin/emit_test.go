@@ -0,0 +1,139 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+func parseScript(t *testing.T, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+	return parser.Parse([]byte(code), nil)
+}
+
+func TestEmitReferences(t *testing.T) {
+	code := `
+export default {
+    methods: {
+        onSave() {
+            this.$emit('save', this.item);
+            this.emitter.emit('notAnEmit');
+        },
+        onClose() {
+            $emit('close');
+        },
+    },
+};
+`
+	tree := parseScript(t, code)
+	defer tree.Close()
+
+	var names []string
+	for _, reference := range EmitReferences(tree.RootNode(), []byte(code)) {
+		names = append(names, reference.Name)
+	}
+	assert.Equal(t, []string{"save", "close"}, names)
+
+	offset := uint(strings.Index(code, "save'"))
+	reference := EmitReferenceAt(tree.RootNode().NamedDescendantForByteRange(offset, offset), []byte(code))
+	require.NotNil(t, reference)
+	assert.Equal(t, "save", reference.Name)
+}
+
+func TestResolveComponentEmits(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/component/sw-base-list/index.js": `
+Shopware.Component.register('sw-base-list', {
+    emits: ['select'],
+});
+`,
+		membersAdminRoot + "/app/component/sw-entity-list/index.js": `
+Shopware.Component.extend('sw-entity-list', 'sw-base-list', {
+    mixins: [Shopware.Mixin.getByName('paging')],
+    emits: ['inline-edit-save'],
+});
+`,
+		membersAdminRoot + "/app/mixin/paging.mixin.js": `
+Shopware.Mixin.register('paging', {
+    emits: ['page-change'],
+});
+`,
+		"/project/custom/plugins/MyPlugin/src/Resources/app/administration/src/extension/sw-entity-list/index.js": `
+Shopware.Component.override('sw-entity-list', {
+    emits: ['my-event'],
+});
+`,
+	})
+
+	emits, complete, err := indexer.ResolveComponentEmits("sw-entity-list")
+	require.NoError(t, err)
+	assert.True(t, complete)
+	assert.ElementsMatch(t, []string{"my-event", "inline-edit-save", "page-change", "select"}, emits)
+
+	assert.True(t, IsEventDeclared("pageChange", emits))
+	assert.True(t, IsEventDeclared("inline-edit-save", emits))
+	assert.False(t, IsEventDeclared("delete", emits))
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/component/sw-other-list/index.js": `
+Shopware.Component.register('sw-other-list', {
+    mixins: [Shopware.Mixin.getByName('unknown')],
+});
+`,
+	})
+
+	_, complete, err = indexer.ResolveComponentEmits("sw-other-list")
+	require.NoError(t, err)
+	assert.False(t, complete)
+}
+
+func TestEmitsInsertion(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			name:     "append to existing emits",
+			code:     "export default {\n    emits: ['save'],\n    methods: { onClose() { this.$emit('close'); } },\n};\n",
+			expected: "export default {\n    emits: ['save', 'close'],\n    methods: { onClose() { this.$emit('close'); } },\n};\n",
+		},
+		{
+			name:     "empty emits",
+			code:     "Component.register('sw-foo', {\n    emits: [],\n    methods: { onClose() { this.$emit('close'); } },\n});\n",
+			expected: "Component.register('sw-foo', {\n    emits: ['close'],\n    methods: { onClose() { this.$emit('close'); } },\n});\n",
+		},
+		{
+			name:     "add emits property",
+			code:     "export default Shopware.Component.wrapComponentConfig({\n    methods: { onClose() { this.$emit('close'); } },\n});\n",
+			expected: "export default Shopware.Component.wrapComponentConfig({\n    emits: ['close'],\n    methods: { onClose() { this.$emit('close'); } },\n});\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := parseScript(t, tt.code)
+			defer tree.Close()
+
+			offset := uint(strings.LastIndex(tt.code, "'close'"))
+			node := tree.RootNode().NamedDescendantForByteRange(offset, offset)
+
+			point, text, ok := EmitsInsertion(node, []byte(tt.code), "close")
+			require.True(t, ok)
+
+			lines := strings.Split(tt.code, "\n")
+			lines[point.Row] = lines[point.Row][:point.Column] + text + lines[point.Row][point.Column:]
+			assert.Equal(t, tt.expected, strings.Join(lines, "\n"))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/shopware/shopware-lsp/internal/indexer"
@@ -34,6 +35,34 @@ var (
 		),
 	)
 
+	// Pattern to match Component.override call expressions
+	JSComponentOverridePattern = treesitterhelper.And(
+		treesitterhelper.NodeKind("call_expression"),
+		treesitterhelper.HasChild(
+			treesitterhelper.And(
+				treesitterhelper.NodeKind("member_expression"),
+				treesitterhelper.Or(
+					treesitterhelper.NodeText("Shopware.Component.override"),
+					treesitterhelper.NodeText("Component.override"),
+				),
+			),
+		),
+	)
+
+	// Pattern to match Mixin.register call expressions
+	JSMixinRegisterPattern = treesitterhelper.And(
+		treesitterhelper.NodeKind("call_expression"),
+		treesitterhelper.HasChild(
+			treesitterhelper.And(
+				treesitterhelper.NodeKind("member_expression"),
+				treesitterhelper.Or(
+					treesitterhelper.NodeText("Shopware.Mixin.register"),
+					treesitterhelper.NodeText("Mixin.register"),
+				),
+			),
+		),
+	)
+
 	// Pattern to match export default { ... } statements (Vue component definitions)
 	JSExportDefaultPattern = treesitterhelper.And(
 		treesitterhelper.NodeKind("export_statement"),
@@ -63,9 +92,27 @@ var (
 	)
 )
 
+// Keys of the core index
+const (
+	coreServicesKey = "services"
+	coreRoutesKey   = "routes"
+)
+
 type AdminComponentIndexer struct {
 	componentIndex  *indexer.DataIndexer[VueComponent]
 	definitionIndex *indexer.DataIndexer[ComponentDefinition]
+	overrideIndex   *indexer.DataIndexer[ComponentOverride]
+	mixinIndex      *indexer.DataIndexer[VueMixin]
+	serviceIndex    *indexer.DataIndexer[AdminService]
+	storeIndex      *indexer.DataIndexer[AdminStore]
+	storeDefIndex   *indexer.DataIndexer[StoreDefinition]
+	routeIndex      *indexer.DataIndexer[AdminRoute]
+
+	// coreIndex stores which kinds of symbols files of the core Administration provide, see IsCoreAdministrationPath
+	coreIndex *indexer.DataIndexer[string]
+
+	// meteor provides the components of the Meteor component library, set by NewMeteorComponentIndexer
+	meteor *MeteorComponentIndexer
 }
 
 func NewAdminComponentIndexer(configDir string) (*AdminComponentIndexer, error) {
@@ -79,9 +126,51 @@ func NewAdminComponentIndexer(configDir string) (*AdminComponentIndexer, error)
 		return nil, err
 	}
 
+	overrideIndex, err := indexer.NewDataIndexer[ComponentOverride](path.Join(configDir, "admin_component_override.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	mixinIndex, err := indexer.NewDataIndexer[VueMixin](path.Join(configDir, "admin_mixin.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	serviceIndex, err := indexer.NewDataIndexer[AdminService](path.Join(configDir, "admin_service.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	storeIndex, err := indexer.NewDataIndexer[AdminStore](path.Join(configDir, "admin_store.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	storeDefIndex, err := indexer.NewDataIndexer[StoreDefinition](path.Join(configDir, "admin_store_definition.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	routeIndex, err := indexer.NewDataIndexer[AdminRoute](path.Join(configDir, "admin_route.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	coreIndex, err := indexer.NewDataIndexer[string](path.Join(configDir, "admin_core.db"))
+	if err != nil {
+		return nil, err
+	}
+
 	return &AdminComponentIndexer{
 		componentIndex:  componentIndex,
 		definitionIndex: definitionIndex,
+		overrideIndex:   overrideIndex,
+		mixinIndex:      mixinIndex,
+		serviceIndex:    serviceIndex,
+		storeIndex:      storeIndex,
+		storeDefIndex:   storeDefIndex,
+		routeIndex:      routeIndex,
+		coreIndex:       coreIndex,
 	}, nil
 }
 
@@ -90,8 +179,7 @@ func (idx *AdminComponentIndexer) ID() string {
 }
 
 func (idx *AdminComponentIndexer) Index(filePath string, node *tree_sitter.Node, fileContent []byte) error {
-	ext := filepath.Ext(filePath)
-	if ext != ".js" && ext != ".ts" {
+	if !IsScriptFile(filepath.Ext(filePath)) {
 		return nil
 	}
 
@@ -105,6 +193,40 @@ func (idx *AdminComponentIndexer) Index(filePath string, node *tree_sitter.Node,
 		return err
 	}
 
+	// Try to parse component overrides (Shopware.Component.override or Component.override)
+	if err := idx.indexOverrides(filePath, node, fileContent); err != nil {
+		return err
+	}
+
+	// Try to parse mixin registrations (Shopware.Mixin.register or Mixin.register)
+	if err := idx.indexMixins(filePath, node, fileContent); err != nil {
+		return err
+	}
+
+	// Try to parse service registrations (Service().register, addServiceProvider, ApiService classes, provide)
+	hasServices, err := idx.indexServices(filePath, node, fileContent)
+	if err != nil {
+		return err
+	}
+
+	// Try to parse store registrations (Shopware.Store.register, Shopware.State.registerModule)
+	if err := idx.indexStores(filePath, node, fileContent); err != nil {
+		return err
+	}
+
+	// Try to parse module routes (Shopware.Module.register)
+	hasRoutes, err := idx.indexRoutes(filePath, node, fileContent)
+	if err != nil {
+		return err
+	}
+
+	// Remember if the core Administration provides services and routes
+	if IsCoreAdministrationPath(filePath) {
+		if err := idx.indexCoreFile(filePath, hasServices, hasRoutes); err != nil {
+			return err
+		}
+	}
+
 	// Try to parse wrapped component configs (export default Shopware.Component.wrapComponentConfig({...}))
 	// Returns true if this file was a wrapComponentConfig file
 	handledByWrap, err := idx.indexWrappedComponents(filePath, node, fileContent)
@@ -162,6 +284,120 @@ func (idx *AdminComponentIndexer) indexRegistrations(filePath string, node *tree
 	return nil
 }
 
+// indexOverrides indexes Shopware.Component.override calls
+func (idx *AdminComponentIndexer) indexOverrides(filePath string, node *tree_sitter.Node, fileContent []byte) error {
+	overrides := parseComponentOverrides(node, fileContent, filePath)
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	batchSave := map[string]map[string]ComponentOverride{
+		filePath: make(map[string]ComponentOverride),
+	}
+	for _, override := range overrides {
+		batchSave[filePath][override.Name] = override
+	}
+
+	return idx.overrideIndex.BatchSaveItems(batchSave)
+}
+
+// indexMixins indexes Shopware.Mixin.register calls
+func (idx *AdminComponentIndexer) indexMixins(filePath string, node *tree_sitter.Node, fileContent []byte) error {
+	mixins := parseMixinRegistrations(node, fileContent, filePath)
+	if len(mixins) == 0 {
+		return nil
+	}
+
+	batchSave := map[string]map[string]VueMixin{
+		filePath: make(map[string]VueMixin),
+	}
+	for _, mixin := range mixins {
+		batchSave[filePath][mixin.Name] = mixin
+	}
+
+	return idx.mixinIndex.BatchSaveItems(batchSave)
+}
+
+// indexServices indexes the services registered or provided in a file
+// Returns true if the file registers or provides services
+func (idx *AdminComponentIndexer) indexServices(filePath string, node *tree_sitter.Node, fileContent []byte) (bool, error) {
+	services := parseServiceRegistrations(node, fileContent, filePath)
+	if len(services) == 0 {
+		return false, nil
+	}
+
+	batchSave := map[string]map[string]AdminService{
+		filePath: make(map[string]AdminService),
+	}
+	for _, service := range services {
+		if _, exists := batchSave[filePath][service.Name]; !exists {
+			batchSave[filePath][service.Name] = service
+		}
+	}
+
+	return true, idx.serviceIndex.BatchSaveItems(batchSave)
+}
+
+// indexStores indexes Pinia stores, Vuex modules and files exporting a Vuex module definition
+func (idx *AdminComponentIndexer) indexStores(filePath string, node *tree_sitter.Node, fileContent []byte) error {
+	if def := parseStoreModuleFile(node, fileContent, filePath); def != nil {
+		batchSave := map[string]map[string]StoreDefinition{
+			filePath: {normalizeDefinitionPath(filePath): *def},
+		}
+		if err := idx.storeDefIndex.BatchSaveItems(batchSave); err != nil {
+			return err
+		}
+	}
+
+	stores := parseStoreRegistrations(node, fileContent, filePath)
+	if len(stores) == 0 {
+		return nil
+	}
+
+	batchSave := map[string]map[string]AdminStore{
+		filePath: make(map[string]AdminStore),
+	}
+	for _, store := range stores {
+		batchSave[filePath][store.ID] = store
+	}
+
+	return idx.storeIndex.BatchSaveItems(batchSave)
+}
+
+// indexRoutes indexes the routes of Shopware.Module.register calls
+// Returns true if the file registers routes
+func (idx *AdminComponentIndexer) indexRoutes(filePath string, node *tree_sitter.Node, fileContent []byte) (bool, error) {
+	routes := parseModuleRoutes(node, fileContent, filePath)
+	if len(routes) == 0 {
+		return false, nil
+	}
+
+	batchSave := map[string]map[string]AdminRoute{
+		filePath: make(map[string]AdminRoute),
+	}
+	for _, route := range routes {
+		batchSave[filePath][route.Name] = route
+	}
+
+	return true, idx.routeIndex.BatchSaveItems(batchSave)
+}
+
+// indexCoreFile stores which kinds of symbols a file of the core Administration provides,
+// so checking for them doesn't need to load all symbols
+func (idx *AdminComponentIndexer) indexCoreFile(filePath string, hasServices, hasRoutes bool) error {
+	batchSave := map[string]map[string]string{
+		filePath: make(map[string]string),
+	}
+	if hasServices {
+		batchSave[filePath][coreServicesKey] = filePath
+	}
+	if hasRoutes {
+		batchSave[filePath][coreRoutesKey] = filePath
+	}
+
+	return idx.coreIndex.BatchSaveItems(batchSave)
+}
+
 // indexWrappedComponents indexes Shopware.Component.wrapComponentConfig() calls
 // These are used for wrapping Meteor component library components
 // Returns true if the file was handled (contains wrapComponentConfig), false otherwise
@@ -341,6 +577,27 @@ func (idx *AdminComponentIndexer) RemovedFiles(paths []string) error {
 	if err := idx.componentIndex.BatchDeleteByFilePaths(paths); err != nil {
 		return err
 	}
+	if err := idx.overrideIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.mixinIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.serviceIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.storeIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.storeDefIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.routeIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	if err := idx.coreIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
 	return idx.definitionIndex.BatchDeleteByFilePaths(paths)
 }
 
@@ -348,6 +605,27 @@ func (idx *AdminComponentIndexer) Close() error {
 	if err := idx.componentIndex.Close(); err != nil {
 		return err
 	}
+	if err := idx.overrideIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.mixinIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.serviceIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.storeIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.storeDefIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.routeIndex.Close(); err != nil {
+		return err
+	}
+	if err := idx.coreIndex.Close(); err != nil {
+		return err
+	}
 	return idx.definitionIndex.Close()
 }
 
@@ -355,6 +633,27 @@ func (idx *AdminComponentIndexer) Clear() error {
 	if err := idx.componentIndex.Clear(); err != nil {
 		return err
 	}
+	if err := idx.overrideIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.mixinIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.serviceIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.storeIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.storeDefIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.routeIndex.Clear(); err != nil {
+		return err
+	}
+	if err := idx.coreIndex.Clear(); err != nil {
+		return err
+	}
 	return idx.definitionIndex.Clear()
 }
 
@@ -402,14 +701,36 @@ func (idx *AdminComponentIndexer) GetComponentByTemplatePath(templatePath string
 	return nil, nil
 }
 
-// GetAllComponentNames returns all registered component names
+// GetAllComponentNames returns all registered component names, including the Meteor components
 func (idx *AdminComponentIndexer) GetAllComponentNames() ([]string, error) {
-	return idx.componentIndex.GetAllKeys()
+	names, err := idx.componentIndex.GetAllKeys()
+	if err != nil || idx.meteor == nil {
+		return names, err
+	}
+
+	meteorNames, err := idx.meteor.GetAllComponentNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range meteorNames {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
 }
 
-// GetComponent returns components by name (may have multiple if extended)
+// GetComponent returns components by name (may have multiple if extended).
+// Meteor components are returned when the name is not registered in the Administration.
 func (idx *AdminComponentIndexer) GetComponent(name string) ([]VueComponent, error) {
-	return idx.componentIndex.GetValues(name)
+	components, err := idx.componentIndex.GetValues(name)
+	if err != nil || len(components) > 0 || idx.meteor == nil {
+		return components, err
+	}
+
+	return idx.meteor.GetComponent(name)
 }
 
 // GetComponentDefinition returns the component definition for a given definition path
@@ -446,30 +767,21 @@ func (idx *AdminComponentIndexer) GetComponentWithDefinition(name string) ([]Vue
 		return nil, err
 	}
 
+	var meteorComponents []VueComponent
+	if idx.meteor != nil {
+		if meteorComponents, err = idx.meteor.GetComponent(name); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(components) == 0 {
-		return components, nil
+		return meteorComponents, nil
 	}
 
 	// Populate definitions for all components
 	for i := range components {
-		// First try to get definition by path (for dynamic imports)
-		if components[i].DefinitionPath != "" {
-			def, err := idx.GetComponentDefinition(components[i].DefinitionPath)
-			if err == nil && def != nil {
-				components[i].Props = def.Props
-				components[i].Emits = def.Emits
-				components[i].Methods = def.Methods
-				components[i].Computed = def.Computed
-				components[i].Slots = def.Slots
-				components[i].Blocks = def.Blocks
-				components[i].TemplatePath = def.TemplatePath
-				continue
-			}
-		}
-
-		// Then try by component name (for inline definitions)
-		def, err := idx.GetComponentDefinitionByName(components[i].Name)
-		if err == nil && def != nil {
+		def := idx.definitionOf(components[i])
+		if def != nil {
 			components[i].Props = def.Props
 			components[i].Emits = def.Emits
 			components[i].Methods = def.Methods
@@ -477,12 +789,184 @@ func (idx *AdminComponentIndexer) GetComponentWithDefinition(name string) ([]Vue
 			components[i].Slots = def.Slots
 			components[i].Blocks = def.Blocks
 			components[i].TemplatePath = def.TemplatePath
+			components[i].Mixins = def.Mixins
+
+			// Props and emits of mixins are part of the component interface, own ones take precedence
+			mixinProps, mixinEmits, complete := idx.mixinInterface(def.Mixins, make(map[string]bool))
+			components[i].UnresolvedMixins = !complete
+			for _, prop := range mixinProps {
+				if !slices.ContainsFunc(components[i].Props, func(p VueComponentProp) bool { return p.Name == prop.Name }) {
+					components[i].Props = append(components[i].Props, prop)
+				}
+			}
+			for _, emit := range mixinEmits {
+				if !slices.Contains(components[i].Emits, emit) {
+					components[i].Emits = append(components[i].Emits, emit)
+				}
+			}
 		}
 	}
 
 	// Deduplicate: merge multiple registrations into one
 	// Prefer the component with more complete data
-	return deduplicateComponents(components), nil
+	components = deduplicateComponents(components)
+
+	// A registration wrapping a Meteor component of the same name inherits its interface
+	for _, meteor := range meteorComponents {
+		for i := range components {
+			components[i] = mergeMeteorInterface(components[i], meteor)
+		}
+	}
+
+	return components, nil
+}
+
+// definitionOf returns the definition of a registered component
+func (idx *AdminComponentIndexer) definitionOf(comp VueComponent) *ComponentDefinition {
+	// First try to get definition by path (for dynamic imports)
+	if comp.DefinitionPath != "" {
+		def, err := idx.GetComponentDefinition(comp.DefinitionPath)
+		if err == nil && def != nil {
+			return def
+		}
+	}
+
+	// Then try by component name (for inline definitions)
+	def, err := idx.GetComponentDefinitionByName(comp.Name)
+	if err == nil && def != nil {
+		return def
+	}
+
+	return nil
+}
+
+// GetComponentOverrides returns all Component.override calls of a component
+func (idx *AdminComponentIndexer) GetComponentOverrides(name string) ([]ComponentOverride, error) {
+	return idx.overrideIndex.GetValues(name)
+}
+
+// GetMixin returns the mixin registrations with the given name
+func (idx *AdminComponentIndexer) GetMixin(name string) ([]VueMixin, error) {
+	return idx.mixinIndex.GetValues(name)
+}
+
+// GetAllMixins returns all registered mixins
+func (idx *AdminComponentIndexer) GetAllMixins() ([]VueMixin, error) {
+	return idx.mixinIndex.GetAllValues()
+}
+
+// SaveMixin saves a mixin (primarily for testing)
+func (idx *AdminComponentIndexer) SaveMixin(mixin VueMixin) error {
+	batchSave := map[string]map[string]VueMixin{
+		mixin.FilePath: {mixin.Name: mixin},
+	}
+	return idx.mixinIndex.BatchSaveItems(batchSave)
+}
+
+// GetService returns all registrations of a service by name
+func (idx *AdminComponentIndexer) GetService(name string) ([]AdminService, error) {
+	return idx.serviceIndex.GetValues(name)
+}
+
+// GetAllServices returns all registered services
+func (idx *AdminComponentIndexer) GetAllServices() ([]AdminService, error) {
+	return idx.serviceIndex.GetAllValues()
+}
+
+// HasCoreServices checks if the services of the core Administration are indexed
+func (idx *AdminComponentIndexer) HasCoreServices() bool {
+	files, err := idx.coreIndex.GetValues(coreServicesKey)
+	return err == nil && len(files) > 0
+}
+
+// SaveService saves a service registration (primarily for testing)
+func (idx *AdminComponentIndexer) SaveService(service AdminService) error {
+	batchSave := map[string]map[string]AdminService{
+		service.FilePath: {service.Name: service},
+	}
+	if err := idx.serviceIndex.BatchSaveItems(batchSave); err != nil {
+		return err
+	}
+
+	if !IsCoreAdministrationPath(service.FilePath) {
+		return nil
+	}
+	return idx.indexCoreFile(service.FilePath, true, false)
+}
+
+// GetStore returns all stores registered with the given id or module name
+func (idx *AdminComponentIndexer) GetStore(id string) ([]AdminStore, error) {
+	return idx.storeIndex.GetValues(id)
+}
+
+// GetAllStores returns all registered Pinia stores and Vuex modules
+func (idx *AdminComponentIndexer) GetAllStores() ([]AdminStore, error) {
+	return idx.storeIndex.GetAllValues()
+}
+
+// GetStoreDefinition returns the inline definition of a store or the definition of the imported module file
+func (idx *AdminComponentIndexer) GetStoreDefinition(store AdminStore) *StoreDefinition {
+	if store.Definition != nil {
+		return store.Definition
+	}
+
+	if store.DefinitionPath == "" {
+		return nil
+	}
+
+	defs, err := idx.storeDefIndex.GetValues(normalizeDefinitionPath(store.DefinitionPath))
+	if err != nil || len(defs) == 0 {
+		return nil
+	}
+
+	return &defs[0]
+}
+
+// SaveStore saves a store registration (primarily for testing)
+func (idx *AdminComponentIndexer) SaveStore(store AdminStore) error {
+	batchSave := map[string]map[string]AdminStore{
+		store.FilePath: {store.ID: store},
+	}
+	return idx.storeIndex.BatchSaveItems(batchSave)
+}
+
+// GetRoute returns all routes with the given name
+func (idx *AdminComponentIndexer) GetRoute(name string) ([]AdminRoute, error) {
+	return idx.routeIndex.GetValues(name)
+}
+
+// GetAllRoutes returns all module routes
+func (idx *AdminComponentIndexer) GetAllRoutes() ([]AdminRoute, error) {
+	return idx.routeIndex.GetAllValues()
+}
+
+// HasCoreRoutes checks if the routes of the core Administration are indexed
+func (idx *AdminComponentIndexer) HasCoreRoutes() bool {
+	files, err := idx.coreIndex.GetValues(coreRoutesKey)
+	return err == nil && len(files) > 0
+}
+
+// SaveRoute saves a module route (primarily for testing)
+func (idx *AdminComponentIndexer) SaveRoute(route AdminRoute) error {
+	batchSave := map[string]map[string]AdminRoute{
+		route.FilePath: {route.Name: route},
+	}
+	if err := idx.routeIndex.BatchSaveItems(batchSave); err != nil {
+		return err
+	}
+
+	if !IsCoreAdministrationPath(route.FilePath) {
+		return nil
+	}
+	return idx.indexCoreFile(route.FilePath, false, true)
+}
+
+// SaveComponentOverride saves a component override (primarily for testing)
+func (idx *AdminComponentIndexer) SaveComponentOverride(override ComponentOverride) error {
+	batchSave := map[string]map[string]ComponentOverride{
+		override.FilePath: {override.Name: override},
+	}
+	return idx.overrideIndex.BatchSaveItems(batchSave)
 }
 
 // deduplicateComponents merges multiple component entries with the same name
@@ -568,6 +1052,10 @@ func mergeComponents(fallback, preferred VueComponent) VueComponent {
 	if result.TemplatePath == "" && fallback.TemplatePath != "" {
 		result.TemplatePath = fallback.TemplatePath
 	}
+	if len(result.Mixins) == 0 && len(fallback.Mixins) > 0 {
+		result.Mixins = fallback.Mixins
+	}
+	result.UnresolvedMixins = result.UnresolvedMixins || fallback.UnresolvedMixins
 
 	return result
 }
@@ -651,6 +1139,11 @@ func parseRegisterArgs(argsNode *tree_sitter.Node, content []byte, filePath stri
 	// Second argument: either an object (inline definition) or arrow function (dynamic import)
 	secondArg := args[1]
 
+	// defineComponent({ ... }) and wrapComponentConfig({ ... }) are treated like the plain object
+	if configObject := ComponentConfigObject(secondArg, content); configObject != nil {
+		secondArg = configObject
+	}
+
 	switch secondArg.Kind() {
 	case "object":
 		// Inline definition: Component.register('name', { ... })
@@ -693,6 +1186,11 @@ func parseExtendArgs(argsNode *tree_sitter.Node, content []byte, filePath string
 	// Third argument: either an object (inline definition) or arrow function (dynamic import)
 	thirdArg := args[2]
 
+	// defineComponent({ ... }) and wrapComponentConfig({ ... }) are treated like the plain object
+	if configObject := ComponentConfigObject(thirdArg, content); configObject != nil {
+		thirdArg = configObject
+	}
+
 	switch thirdArg.Kind() {
 	case "object":
 		// Inline definition: Component.extend('name', 'parent', { ... })
@@ -710,6 +1208,74 @@ func parseExtendArgs(argsNode *tree_sitter.Node, content []byte, filePath string
 	}
 }
 
+// parseComponentOverrides extracts Shopware.Component.override calls
+func parseComponentOverrides(root *tree_sitter.Node, content []byte, filePath string) []ComponentOverride {
+	var overrides []ComponentOverride
+
+	for _, node := range treesitterhelper.FindAll(root, JSComponentOverridePattern, content) {
+		argsNode := treesitterhelper.GetFirstNodeOfKind(node, "arguments")
+		if argsNode == nil {
+			continue
+		}
+
+		args := getArguments(argsNode)
+		if len(args) < 2 || args[0].Kind() != "string" {
+			continue
+		}
+
+		override := ComponentOverride{
+			Name:     extractStringContent(args[0], content),
+			FilePath: filePath,
+			Line:     int(node.StartPosition().Row) + 1,
+		}
+
+		if configObject := ComponentConfigObject(args[1], content); configObject != nil {
+			override.Definition = parseInlineDefinition(configObject, content, filePath)
+			override.DefinitionPath = filePath
+		} else if args[1].Kind() == "arrow_function" {
+			override.ImportPath = extractImportPath(args[1], content)
+			if override.ImportPath != "" {
+				override.DefinitionPath = resolveImportPath(filePath, override.ImportPath)
+			}
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// parseMixinRegistrations extracts Shopware.Mixin.register calls
+func parseMixinRegistrations(root *tree_sitter.Node, content []byte, filePath string) []VueMixin {
+	var mixins []VueMixin
+
+	for _, node := range treesitterhelper.FindAll(root, JSMixinRegisterPattern, content) {
+		argsNode := treesitterhelper.GetFirstNodeOfKind(node, "arguments")
+		if argsNode == nil {
+			continue
+		}
+
+		args := getArguments(argsNode)
+		if len(args) < 2 || args[0].Kind() != "string" {
+			continue
+		}
+
+		configObject := ComponentConfigObject(args[1], content)
+		if configObject == nil {
+			continue
+		}
+
+		mixins = append(mixins, VueMixin{
+			Name:       extractStringContent(args[0], content),
+			FilePath:   filePath,
+			Line:       int(node.StartPosition().Row) + 1,
+			Definition: *parseInlineDefinition(configObject, content, filePath),
+		})
+	}
+
+	return mixins
+}
+
 // getArguments returns the direct argument nodes from an arguments node
 func getArguments(argsNode *tree_sitter.Node) []*tree_sitter.Node {
 	var args []*tree_sitter.Node
@@ -772,19 +1338,9 @@ func parseInlineDefinition(objNode *tree_sitter.Node, content []byte, filePath s
 			}
 		case "method_definition":
 			// Handle method shorthand like `data() { ... }`
-			propIdent := treesitterhelper.GetFirstNodeOfKind(child, "property_identifier")
-			if propIdent != nil {
-				methodName := string(propIdent.Utf8Text(content))
-				// data, created, mounted etc. are lifecycle methods, not regular methods
-				// We could add them to a separate list if needed
-				switch methodName {
-				case "data", "created", "mounted", "updated", "destroyed", "beforeCreate",
-					"beforeMount", "beforeUpdate", "beforeDestroy", "setup":
-					// Lifecycle hooks - ignore for now
-				default:
-					// Could be a method defined at top level (unusual but valid)
-				}
-			}
+			// created, mounted etc. are lifecycle hooks and not accessible members
+			parseDataMethod(child, content, def)
+			parseSetupMethod(child, content, def)
 		}
 	}
 
@@ -800,6 +1356,16 @@ func parseDefinitionPair(node *tree_sitter.Node, content []byte, def *ComponentD
 	}
 	propName := string(propIdent.Utf8Text(content))
 
+	// data: () => ({ ... }) and data: function () { ... } have a function as value
+	switch propName {
+	case "data":
+		parseDataMethod(node.ChildByFieldName("value"), content, def)
+		return
+	case "setup":
+		def.DynamicMembers = true
+		return
+	}
+
 	// Get value node
 	var valueNode *tree_sitter.Node
 	for i := uint(0); i < node.ChildCount(); i++ {
@@ -822,8 +1388,17 @@ func parseDefinitionPair(node *tree_sitter.Node, content []byte, def *ComponentD
 		def.Emits = parseEmits(valueNode, content)
 	case "methods":
 		def.Methods = parseMethods(valueNode, content)
+		def.setMemberLines(valueNode, content)
+		def.DynamicMembers = def.DynamicMembers || hasSpreadElement(valueNode)
 	case "computed":
 		def.Computed = parseMethods(valueNode, content)
+		def.setMemberLines(valueNode, content)
+		def.DynamicMembers = def.DynamicMembers || hasSpreadElement(valueNode)
+	case "inject":
+		def.Inject = parseInject(valueNode, content)
+		def.setMemberLines(valueNode, content)
+	case "mixins":
+		def.Mixins = parseMixins(valueNode, content)
 	case "template":
 		def.HasTemplate = true
 	}
@@ -860,10 +1435,10 @@ func resolveImportPath(registrationFile, importPath string) string {
 }
 
 // resolveJSFile tries to find the actual JS/TS file for an import path
-// It checks for: path.js, path.ts, path/index.js, path/index.ts
+// It checks for: path.js, path.ts, path.tsx, path.mts and the same names as path/index.*
 func resolveJSFile(basePath string) string {
 	// If already has extension, return as-is
-	if strings.HasSuffix(basePath, ".js") || strings.HasSuffix(basePath, ".ts") {
+	if IsScriptFile(filepath.Ext(basePath)) {
 		return basePath
 	}
 
@@ -871,8 +1446,12 @@ func resolveJSFile(basePath string) string {
 	candidates := []string{
 		basePath + ".js",
 		basePath + ".ts",
+		basePath + ".tsx",
+		basePath + ".mts",
 		filepath.Join(basePath, "index.js"),
 		filepath.Join(basePath, "index.ts"),
+		filepath.Join(basePath, "index.tsx"),
+		filepath.Join(basePath, "index.mts"),
 	}
 
 	for _, candidate := range candidates {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
 )
 
 func TestParseComponentRegister(t *testing.T) {
@@ -613,3 +614,32 @@ func TestMergeComponents(t *testing.T) {
 	assert.Len(t, result.Emits, 1)
 	assert.Equal(t, "fallbackEmit", result.Emits[0])
 }
+
+func TestParseInlineDefineComponent(t *testing.T) {
+	code := `
+Shopware.Component.register('sw-typed-card', defineComponent({
+    props: {
+        product: {
+            type: Object as PropType<Entity<'product'>>,
+            required: true,
+        },
+    },
+}));
+`
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	filePath := "/project/src/Resources/app/administration/src/component/sw-typed-card/index.ts"
+	components := parseComponentRegistrations(tree.RootNode(), []byte(code), filePath)
+
+	require.Len(t, components, 1)
+	require.NotNil(t, components[0].InlineDefinition)
+	require.Len(t, components[0].InlineDefinition.Props, 1)
+	assert.Equal(t, "Entity<'product'>", components[0].InlineDefinition.Props[0].PropType)
+	assert.True(t, components[0].InlineDefinition.Props[0].Required)
+}
@@ -0,0 +1,369 @@
+package admin
+
+import (
+	"path/filepath"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// ComponentMemberKind is the kind of member accessible through `this` in a component
+type ComponentMemberKind string
+
+const (
+	MemberProp     ComponentMemberKind = "prop"
+	MemberData     ComponentMemberKind = "data"
+	MemberMethod   ComponentMemberKind = "method"
+	MemberComputed ComponentMemberKind = "computed"
+	MemberInject   ComponentMemberKind = "inject"
+)
+
+// ComponentMember represents a member accessible through `this` in a component
+type ComponentMember struct {
+	// Name is the member name
+	Name string
+
+	// Kind is the member kind (prop, data, method, computed, inject)
+	Kind ComponentMemberKind
+
+	// Origin describes where the member comes from (e.g., "sw-product-detail", "notification (mixin)")
+	Origin string
+
+	// FilePath is the absolute path to the file defining the member
+	FilePath string
+
+	// Line is the line number of the member definition (1-based, 0 if unknown)
+	Line int
+}
+
+// GetComponentMembers returns all members accessible through `this` in a component.
+// This includes the members of Component.override calls, mixins and all Component.extend parents.
+// Members defined closer to the component shadow inherited members with the same name.
+func (idx *AdminComponentIndexer) GetComponentMembers(name string) ([]ComponentMember, error) {
+	members, _, err := idx.ResolveComponentMembers(name)
+	return members, err
+}
+
+// ResolveComponentMembers returns the members of a component like GetComponentMembers and whether the
+// list is complete. It is incomplete when a parent, mixin or definition is not indexed or members are
+// added dynamically (e.g. ...mapState() or setup()).
+func (idx *AdminComponentIndexer) ResolveComponentMembers(name string) ([]ComponentMember, bool, error) {
+	collector := newMemberCollector()
+
+	for current := name; current != "" && !collector.components[current]; {
+		collector.components[current] = true
+
+		overrides, err := idx.GetComponentOverrides(current)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var mixins []string
+
+		for _, override := range overrides {
+			def := idx.overrideDefinition(override)
+			if def == nil {
+				collector.complete = false
+				continue
+			}
+			collector.addDefinition(def, current+" (override)")
+			mixins = append(mixins, def.Mixins...)
+		}
+
+		components, err := idx.GetComponent(current)
+		if err != nil {
+			return nil, false, err
+		}
+
+		parent := ""
+		defined := false
+		for _, comp := range components {
+			if parent == "" {
+				parent = comp.ExtendsComponent
+			}
+
+			if defined {
+				continue
+			}
+
+			if def := idx.definitionOf(comp); def != nil {
+				collector.addDefinition(def, current)
+				mixins = append(mixins, def.Mixins...)
+				defined = true
+			}
+		}
+
+		if !defined {
+			collector.complete = false
+		}
+
+		for _, mixin := range mixins {
+			if err := idx.collectMixinMembers(mixin, collector); err != nil {
+				return nil, false, err
+			}
+		}
+
+		current = parent
+	}
+
+	return collector.members, collector.complete, nil
+}
+
+// GetMixinMembers returns all members a mixin provides, including the members of its own mixins
+func (idx *AdminComponentIndexer) GetMixinMembers(name string) ([]ComponentMember, error) {
+	collector := newMemberCollector()
+
+	if err := idx.collectMixinMembers(name, collector); err != nil {
+		return nil, err
+	}
+
+	return collector.members, nil
+}
+
+func (idx *AdminComponentIndexer) collectMixinMembers(name string, collector *memberCollector) error {
+	if collector.mixins[name] {
+		return nil
+	}
+	collector.mixins[name] = true
+
+	mixins, err := idx.GetMixin(name)
+	if err != nil || len(mixins) == 0 {
+		collector.complete = false
+		return err
+	}
+
+	def := mixins[0].Definition
+	collector.addDefinition(&def, name+" (mixin)")
+
+	for _, nested := range def.Mixins {
+		if err := idx.collectMixinMembers(nested, collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// overrideDefinition returns the inline definition of an override or the definition of the imported file
+func (idx *AdminComponentIndexer) overrideDefinition(override ComponentOverride) *ComponentDefinition {
+	if override.Definition != nil {
+		return override.Definition
+	}
+
+	if override.DefinitionPath == "" {
+		return nil
+	}
+
+	def, err := idx.GetComponentDefinition(override.DefinitionPath)
+	if err != nil {
+		return nil
+	}
+
+	return def
+}
+
+// memberCollector collects members while walking components and mixins, the first member of a name wins
+type memberCollector struct {
+	members    []ComponentMember
+	names      map[string]bool
+	components map[string]bool
+	mixins     map[string]bool
+	complete   bool
+}
+
+func newMemberCollector() *memberCollector {
+	return &memberCollector{
+		complete:   true,
+		names:      make(map[string]bool),
+		components: make(map[string]bool),
+		mixins:     make(map[string]bool),
+	}
+}
+
+func (c *memberCollector) add(member ComponentMember) {
+	if member.Name == "" || c.names[member.Name] {
+		return
+	}
+	c.names[member.Name] = true
+	c.members = append(c.members, member)
+}
+
+func (c *memberCollector) addDefinition(def *ComponentDefinition, origin string) {
+	if def.DynamicMembers {
+		c.complete = false
+	}
+
+	for _, prop := range def.Props {
+		c.add(ComponentMember{Name: prop.Name, Kind: MemberProp, Origin: origin, FilePath: def.FilePath, Line: prop.Line})
+	}
+
+	named := []struct {
+		kind  ComponentMemberKind
+		names []string
+	}{
+		{MemberData, def.Data},
+		{MemberComputed, def.Computed},
+		{MemberMethod, def.Methods},
+		{MemberInject, def.Inject},
+	}
+
+	for _, group := range named {
+		for _, name := range group.names {
+			c.add(ComponentMember{Name: name, Kind: group.kind, Origin: origin, FilePath: def.FilePath, Line: def.MemberLines[name]})
+		}
+	}
+}
+
+// ScriptContext describes the component or mixin a position in an admin script belongs to
+type ScriptContext struct {
+	// ComponentName is set when the position belongs to a component (register, extend, override or definition file)
+	ComponentName string
+
+	// MixinName is set when the position belongs to a Mixin.register call
+	MixinName string
+}
+
+// ScriptScope is the part of an admin script belonging to a component or mixin
+type ScriptScope struct {
+	ScriptContext
+
+	// Node is the Component.register/extend/override or Mixin.register call, nil when the whole file is the definition
+	Node *tree_sitter.Node
+}
+
+// GetScriptScopes returns the components and mixins of an admin script. Inline Component.register/extend/override
+// and Mixin.register calls come first, followed by the component the file is the definition of.
+func (idx *AdminComponentIndexer) GetScriptScopes(filePath string, root *tree_sitter.Node, content []byte) []ScriptScope {
+	var scopes []ScriptScope
+
+	for _, call := range treesitterhelper.FindAll(root, JSComponentCallPattern, content) {
+		if comp := parseComponentCall(call, content, filePath); comp != nil {
+			scopes = append(scopes, ScriptScope{ScriptContext: ScriptContext{ComponentName: comp.Name}, Node: call})
+		}
+	}
+
+	for _, call := range treesitterhelper.FindAll(root, JSComponentOverridePattern, content) {
+		if name := firstStringArgument(call, content); name != "" {
+			scopes = append(scopes, ScriptScope{ScriptContext: ScriptContext{ComponentName: name}, Node: call})
+		}
+	}
+
+	for _, call := range treesitterhelper.FindAll(root, JSMixinRegisterPattern, content) {
+		if name := firstStringArgument(call, content); name != "" {
+			scopes = append(scopes, ScriptScope{ScriptContext: ScriptContext{MixinName: name}, Node: call})
+		}
+	}
+
+	if findExportDefault(root) == nil {
+		return scopes
+	}
+
+	normalizedPath := normalizeDefinitionPath(filepath.Clean(filePath))
+
+	components, err := idx.GetAllComponents()
+	if err == nil {
+		for _, comp := range components {
+			if comp.DefinitionPath != "" && normalizeDefinitionPath(comp.DefinitionPath) == normalizedPath {
+				return append(scopes, ScriptScope{ScriptContext: ScriptContext{ComponentName: comp.Name}})
+			}
+		}
+	}
+
+	overrides, err := idx.overrideIndex.GetAllValues()
+	if err == nil {
+		for _, override := range overrides {
+			if override.DefinitionPath != "" && normalizeDefinitionPath(override.DefinitionPath) == normalizedPath {
+				return append(scopes, ScriptScope{ScriptContext: ScriptContext{ComponentName: override.Name}})
+			}
+		}
+	}
+
+	return scopes
+}
+
+// ScriptContextAt returns the context of the first scope containing the given byte offset
+func ScriptContextAt(scopes []ScriptScope, offset uint) ScriptContext {
+	for _, scope := range scopes {
+		if scope.Node == nil || containsOffset(scope.Node, offset) {
+			return scope.ScriptContext
+		}
+	}
+
+	return ScriptContext{}
+}
+
+// GetScriptContext returns the component or mixin the given byte offset of an admin script belongs to.
+// Inline Component.register/extend/override and Mixin.register calls are checked first, after that
+// the file is matched against the definition path of registered components and overrides.
+func (idx *AdminComponentIndexer) GetScriptContext(filePath string, root *tree_sitter.Node, content []byte, offset uint) ScriptContext {
+	return ScriptContextAt(idx.GetScriptScopes(filePath, root, content), offset)
+}
+
+// GetScriptMembers returns the members accessible through `this` in the given script context
+func (idx *AdminComponentIndexer) GetScriptMembers(ctx ScriptContext) ([]ComponentMember, error) {
+	if ctx.MixinName != "" {
+		return idx.GetMixinMembers(ctx.MixinName)
+	}
+
+	if ctx.ComponentName != "" {
+		return idx.GetComponentMembers(ctx.ComponentName)
+	}
+
+	return nil, nil
+}
+
+// MemberObjectAt returns the object of the member access typed at the offset, like this for this.is<caret>.
+// An access without property like this.<caret> is often an ERROR node, then the object is in front of the dot.
+func MemberObjectAt(root *tree_sitter.Node, offset uint) *tree_sitter.Node {
+	if root == nil || offset == 0 {
+		return nil
+	}
+
+	node := root.DescendantForByteRange(offset-1, offset)
+	if node == nil {
+		return nil
+	}
+
+	if node.Kind() == "property_identifier" || node.Kind() == "identifier" {
+		if parent := node.Parent(); parent != nil && parent.Kind() == "member_expression" {
+			return parent.ChildByFieldName("object")
+		}
+		node = node.PrevSibling()
+	}
+
+	if node == nil || node.Kind() != "." || node.Parent() == nil {
+		return nil
+	}
+
+	parent := node.Parent()
+	switch parent.Kind() {
+	case "member_expression":
+		return parent.ChildByFieldName("object")
+	case "ERROR":
+		if object := node.PrevNamedSibling(); object != nil {
+			return object
+		}
+		return parent.PrevNamedSibling()
+	}
+
+	return nil
+}
+
+func containsOffset(node *tree_sitter.Node, offset uint) bool {
+	return node.StartByte() <= offset && offset <= node.EndByte()
+}
+
+// firstStringArgument returns the content of the first argument of a call if it is a string
+func firstStringArgument(call *tree_sitter.Node, content []byte) string {
+	argsNode := call.ChildByFieldName("arguments")
+	if argsNode == nil {
+		return ""
+	}
+
+	args := getArguments(argsNode)
+	if len(args) == 0 || args[0].Kind() != "string" {
+		return ""
+	}
+
+	return extractStringContent(args[0], content)
+}
@@ -0,0 +1,206 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+const membersAdminRoot = "/project/src/Administration/Resources/app/administration/src"
+
+func indexJSFiles(t *testing.T, indexer *AdminComponentIndexer, files map[string]string) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	for filePath, code := range files {
+		tree := parser.Parse([]byte(code), nil)
+		require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+		tree.Close()
+	}
+}
+
+func membersByName(members []ComponentMember) map[string]ComponentMember {
+	result := make(map[string]ComponentMember, len(members))
+	for _, member := range members {
+		result[member.Name] = member
+	}
+	return result
+}
+
+func TestGetComponentMembers(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/mixin/notification.mixin.js": `
+Shopware.Mixin.register('notification', {
+    methods: {
+        createNotificationSuccess(config) {},
+    },
+});
+`,
+		membersAdminRoot + "/app/component/sw-base/index.js": `
+Shopware.Component.register('sw-base', {
+    inject: ['repositoryFactory'],
+
+    props: {
+        isLoading: Boolean,
+    },
+
+    data() {
+        return {
+            items: [],
+            total: 0,
+        };
+    },
+
+    methods: {
+        loadItems() {},
+    },
+});
+`,
+		membersAdminRoot + "/app/component/sw-child/index.js": `
+Shopware.Component.extend('sw-child', 'sw-base', {
+    mixins: [Mixin.getByName('notification')],
+
+    computed: {
+        itemRepository() {},
+    },
+
+    methods: {
+        loadItems() {},
+    },
+});
+`,
+		"/project/custom/plugins/MyPlugin/src/Resources/app/administration/src/sw-child/index.js": `
+Component.override('sw-child', {
+    inject: { myService: 'myService' },
+
+    methods: {
+        onSave() {},
+    },
+});
+`,
+	})
+
+	members, err := indexer.GetComponentMembers("sw-child")
+	require.NoError(t, err)
+
+	byName := membersByName(members)
+	require.Len(t, byName, 9)
+
+	assert.Equal(t, MemberMethod, byName["onSave"].Kind)
+	assert.Equal(t, "sw-child (override)", byName["onSave"].Origin)
+	assert.Equal(t, MemberInject, byName["myService"].Kind)
+
+	assert.Equal(t, "sw-child", byName["loadItems"].Origin, "closest definition wins")
+	assert.Equal(t, MemberComputed, byName["itemRepository"].Kind)
+
+	assert.Equal(t, "notification (mixin)", byName["createNotificationSuccess"].Origin)
+	assert.Equal(t, 4, byName["createNotificationSuccess"].Line)
+
+	assert.Equal(t, "sw-base", byName["repositoryFactory"].Origin)
+	assert.Equal(t, MemberProp, byName["isLoading"].Kind)
+	assert.Equal(t, MemberData, byName["items"].Kind)
+	assert.Equal(t, MemberData, byName["total"].Kind)
+	assert.Equal(t, 12, byName["total"].Line)
+	assert.True(t, strings.HasSuffix(byName["total"].FilePath, "sw-base/index.js"))
+}
+
+func TestGetScriptContext(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	definitionPath := membersAdminRoot + "/module/sw-product/page/sw-product-list/index.js"
+	definitionCode := `
+export default {
+    methods: {
+        onSearch() {
+            this.
+        },
+    },
+};
+`
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/module/sw-product/index.js": `
+Shopware.Component.register('sw-product-list', () => import('./page/sw-product-list'));
+`,
+		definitionPath: definitionCode,
+	})
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tree := parser.Parse([]byte(definitionCode), nil)
+	defer tree.Close()
+
+	offset := uint(strings.Index(definitionCode, "this.") + len("this."))
+	scriptContext := indexer.GetScriptContext(definitionPath, tree.RootNode(), []byte(definitionCode), offset)
+	assert.Equal(t, ScriptContext{ComponentName: "sw-product-list"}, scriptContext)
+
+	mixinCode := `
+Mixin.register('listing', {
+    data() {
+        return { page: 1 };
+    },
+    methods: {
+        getList() {
+            this.
+        },
+    },
+});
+`
+	mixinTree := parser.Parse([]byte(mixinCode), nil)
+	defer mixinTree.Close()
+
+	offset = uint(strings.Index(mixinCode, "this.") + len("this."))
+	scriptContext = indexer.GetScriptContext(membersAdminRoot+"/app/mixin/listing.mixin.js", mixinTree.RootNode(), []byte(mixinCode), offset)
+	assert.Equal(t, ScriptContext{MixinName: "listing"}, scriptContext)
+}
+
+func TestMemberObjectAt(t *testing.T) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{code: "function f() {\n    this.$\n}\n", expected: "this"},
+		{code: "function f() {\n    this.is$\n    save();\n}\n", expected: "this"},
+		{code: "function f() {\n    this.$\n    save();\n}\n", expected: "this"},
+		{code: "Shopware.Store.get('session').$\n", expected: "Shopware.Store.get('session')"},
+		{code: "Shopware.Store.get('session').cur$;\n", expected: "Shopware.Store.get('session')"},
+		{code: "const value = this.product.na$;\n", expected: "this.product"},
+		{code: "function f() {\n    this$\n}\n"},
+		{code: "save($);\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			offset := strings.Index(test.code, "$")
+			code := test.code[:offset] + test.code[offset+1:]
+
+			tree := parser.Parse([]byte(code), nil)
+			defer tree.Close()
+
+			object := MemberObjectAt(tree.RootNode(), uint(offset))
+			if test.expected == "" {
+				assert.Nil(t, object)
+				return
+			}
+
+			require.NotNil(t, object)
+			assert.Equal(t, test.expected, object.Utf8Text([]byte(code)))
+		})
+	}
+}
@@ -0,0 +1,639 @@
+package admin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/indexer"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
+)
+
+// MeteorLibraryPackage is the npm package of the Meteor component library providing the mt-* components
+const MeteorLibraryPackage = "@shopware-ag/meteor-component-library"
+
+// meteorComponentPrefix is the prefix of all components of the Meteor component library
+const meteorComponentPrefix = "mt-"
+
+// MeteorComponentIndexer indexes the components shipped by the Meteor component library.
+// node_modules is skipped by the file scanner, so the library is read directly when the package.json of an
+// Administration app is indexed, and read again by Refresh when the package.json of the library itself changed.
+type MeteorComponentIndexer struct {
+	componentIndex *indexer.DataIndexer[VueComponent]
+	libraryIndex   *indexer.DataIndexer[meteorLibrary]
+}
+
+// meteorLibrary is the installed library the components of an Administration app were read from
+type meteorLibrary struct {
+	AppFile     string
+	PackageFile string
+	Hash        string
+}
+
+// NewMeteorComponentIndexer creates the Meteor indexer and makes its components available through the admin component indexer
+func NewMeteorComponentIndexer(configDir string, components *AdminComponentIndexer) (*MeteorComponentIndexer, error) {
+	componentIndex, err := indexer.NewDataIndexer[VueComponent](path.Join(configDir, "admin_meteor_component.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	libraryIndex, err := indexer.NewDataIndexer[meteorLibrary](path.Join(configDir, "admin_meteor_library.db"))
+	if err != nil {
+		_ = componentIndex.Close()
+		return nil, err
+	}
+
+	idx := &MeteorComponentIndexer{componentIndex: componentIndex, libraryIndex: libraryIndex}
+	if components != nil {
+		components.meteor = idx
+	}
+
+	return idx, nil
+}
+
+func (idx *MeteorComponentIndexer) ID() string {
+	return "admin.meteor.indexer"
+}
+
+// Index reads the Meteor component library installed next to an Administration package.json or package-lock.json
+func (idx *MeteorComponentIndexer) Index(filePath string, node *tree_sitter.Node, fileContent []byte) error {
+	base := filepath.Base(filePath)
+	if base != "package.json" && base != "package-lock.json" {
+		return nil
+	}
+
+	if !strings.HasSuffix(filepath.ToSlash(filepath.Dir(filePath)), "Resources/app/administration") {
+		return nil
+	}
+
+	return idx.indexLibrary(filePath)
+}
+
+// indexLibrary reads the library installed next to the app file. The components are stored under the app file,
+// so removing it also removes the components.
+func (idx *MeteorComponentIndexer) indexLibrary(appFile string) error {
+	library := installedMeteorLibrary(appFile)
+
+	batchSave := map[string]map[string]VueComponent{appFile: {}}
+	for _, comp := range ParseMeteorLibrary(filepath.Dir(library.PackageFile)) {
+		batchSave[appFile][comp.Name] = comp
+	}
+
+	if err := idx.componentIndex.BatchSaveItems(batchSave); err != nil {
+		return err
+	}
+
+	return idx.libraryIndex.BatchSaveItems(map[string]map[string]meteorLibrary{
+		appFile: {library.PackageFile: library},
+	})
+}
+
+// Refresh reads the libraries again whose package.json changed since they were indexed, like after an npm install
+func (idx *MeteorComponentIndexer) Refresh() error {
+	libraries, err := idx.libraryIndex.GetAllValues()
+	if err != nil {
+		return err
+	}
+
+	for _, library := range libraries {
+		if installedMeteorLibrary(library.AppFile) == library {
+			continue
+		}
+
+		if err := idx.indexLibrary(library.AppFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// installedMeteorLibrary returns the library installed next to the app file, with an empty hash when it is not installed
+func installedMeteorLibrary(appFile string) meteorLibrary {
+	library := meteorLibrary{
+		AppFile:     appFile,
+		PackageFile: filepath.Join(filepath.Dir(appFile), "node_modules", MeteorLibraryPackage, "package.json"),
+	}
+
+	if content, err := os.ReadFile(library.PackageFile); err == nil {
+		library.Hash = fmt.Sprintf("%x", sha256.Sum256(content))
+	}
+
+	return library
+}
+
+func (idx *MeteorComponentIndexer) RemovedFiles(paths []string) error {
+	if err := idx.componentIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	return idx.libraryIndex.BatchDeleteByFilePaths(paths)
+}
+
+func (idx *MeteorComponentIndexer) Close() error {
+	if err := idx.componentIndex.Close(); err != nil {
+		return err
+	}
+	return idx.libraryIndex.Close()
+}
+
+func (idx *MeteorComponentIndexer) Clear() error {
+	if err := idx.componentIndex.Clear(); err != nil {
+		return err
+	}
+	return idx.libraryIndex.Clear()
+}
+
+// GetComponent returns the Meteor components with the given name
+func (idx *MeteorComponentIndexer) GetComponent(name string) ([]VueComponent, error) {
+	if !strings.HasPrefix(name, meteorComponentPrefix) {
+		return nil, nil
+	}
+
+	return idx.componentIndex.GetValues(name)
+}
+
+// GetAllComponentNames returns the names of all Meteor components
+func (idx *MeteorComponentIndexer) GetAllComponentNames() ([]string, error) {
+	return idx.componentIndex.GetAllKeys()
+}
+
+// ParseMeteorLibrary returns the components of an installed Meteor component library. The web-types
+// metadata referenced by the package.json is preferred, otherwise the mt-*.vue.d.ts declarations are read.
+func ParseMeteorLibrary(packageDir string) []VueComponent {
+	packageJSON, err := os.ReadFile(filepath.Join(packageDir, "package.json"))
+	if err != nil {
+		return nil
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript()))
+
+	var manifest struct {
+		WebTypes string `json:"web-types"`
+	}
+	if err := json.Unmarshal(packageJSON, &manifest); err == nil && manifest.WebTypes != "" {
+		webTypesPath := filepath.Join(packageDir, manifest.WebTypes)
+		if content, err := os.ReadFile(webTypesPath); err == nil {
+			if components := parseMeteorWebTypes(parser, webTypesPath, content); len(components) > 0 {
+				return components
+			}
+		}
+	}
+
+	root := filepath.Join(packageDir, "dist")
+	if _, err := os.Stat(root); err != nil {
+		root = packageDir
+	}
+
+	var components []VueComponent
+	seen := make(map[string]bool)
+
+	_ = filepath.WalkDir(root, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if d.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		name, ok := strings.CutSuffix(d.Name(), ".vue.d.ts")
+		if !ok || !strings.HasPrefix(name, meteorComponentPrefix) || seen[name] {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil
+		}
+
+		if comp, ok := parseMeteorDeclaration(parser, name, filePath, content); ok {
+			seen[name] = true
+			components = append(components, comp)
+		}
+
+		return nil
+	})
+
+	return components
+}
+
+// webTypesComponent is a component of the web-types metadata format
+type webTypesComponent struct {
+	Name       string           `json:"name"`
+	Props      []webTypesMember `json:"props"`
+	Attributes []webTypesMember `json:"attributes"`
+	Events     []webTypesMember `json:"events"`
+	Slots      []webTypesMember `json:"slots"`
+	JS         struct {
+		Events []webTypesMember `json:"events"`
+	} `json:"js"`
+}
+
+// webTypesMember is a prop, attribute, event or slot of the web-types metadata format
+type webTypesMember struct {
+	Name     string          `json:"name"`
+	Type     json.RawMessage `json:"type"`
+	Required bool            `json:"required"`
+	Default  json.RawMessage `json:"default"`
+	Value    struct {
+		Type json.RawMessage `json:"type"`
+	} `json:"value"`
+}
+
+// parseMeteorWebTypes parses the components of a web-types file
+func parseMeteorWebTypes(parser *tree_sitter.Parser, filePath string, content []byte) []VueComponent {
+	var webTypes struct {
+		Contributions struct {
+			HTML struct {
+				VueComponents []webTypesComponent `json:"vue-components"`
+				Tags          []webTypesComponent `json:"tags"`
+				Elements      []webTypesComponent `json:"elements"`
+			} `json:"html"`
+		} `json:"contributions"`
+	}
+	if err := json.Unmarshal(content, &webTypes); err != nil {
+		return nil
+	}
+
+	html := webTypes.Contributions.HTML
+	var components []VueComponent
+
+	for _, entry := range append(append(html.VueComponents, html.Tags...), html.Elements...) {
+		name := entry.Name
+		if !strings.Contains(name, "-") {
+			name = strings.TrimPrefix(CamelToKebab(name), "-")
+		}
+		if !strings.HasPrefix(name, meteorComponentPrefix) {
+			continue
+		}
+
+		comp := VueComponent{Name: name, FilePath: filePath, Line: 1}
+
+		for _, member := range append(entry.Props, entry.Attributes...) {
+			typ := webTypesType(member.Type)
+			if typ == "" {
+				typ = webTypesType(member.Value.Type)
+			}
+
+			prop := propFromTypeSource(parser, member.Name, typ)
+			prop.Required = member.Required
+			if err := json.Unmarshal(member.Default, &prop.Default); err != nil {
+				prop.Default = string(member.Default)
+			}
+			comp.Props = append(comp.Props, prop)
+		}
+
+		for _, event := range append(entry.Events, entry.JS.Events...) {
+			comp.Emits = append(comp.Emits, event.Name)
+		}
+
+		for _, slot := range entry.Slots {
+			comp.Slots = append(comp.Slots, VueComponentSlot{Name: slot.Name})
+		}
+
+		components = append(components, comp)
+	}
+
+	return components
+}
+
+// webTypesType returns the TypeScript type of a web-types type, which is either a string or a list of strings
+func webTypesType(raw json.RawMessage) string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single
+	}
+
+	var union []string
+	if err := json.Unmarshal(raw, &union); err == nil {
+		return strings.Join(union, " | ")
+	}
+
+	return ""
+}
+
+// parseMeteorDeclaration parses the DefineComponent<Props, ..., Emits> declaration vue-tsc generates for a component
+func parseMeteorDeclaration(parser *tree_sitter.Parser, name, filePath string, content []byte) (VueComponent, bool) {
+	comp := VueComponent{Name: name, FilePath: filePath, Line: 1}
+
+	content = withoutImportQualifiers(parser, content)
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	args := typeArguments(root, "DefineComponent", content)
+	if len(args) == 0 {
+		return comp, false
+	}
+
+	for _, member := range typeMembers(args[0]) {
+		comp.Props = append(comp.Props, propFromMember(memberName(member, content), memberType(member), content))
+	}
+
+	// The emits are the 8th type argument, either an array of event names or an object keyed by event
+	if len(args) > 7 {
+		if args[7].Kind() == "object_type" {
+			for _, member := range typeMembers(args[7]) {
+				comp.Emits = append(comp.Emits, memberName(member, content))
+			}
+		} else {
+			comp.Emits = stringLiterals(args[7], content)
+		}
+	}
+
+	for _, slot := range meteorSlots(root, content) {
+		comp.Slots = append(comp.Slots, VueComponentSlot{Name: slot})
+	}
+
+	return comp, true
+}
+
+// meteorSlots returns the slots of __VLS_WithTemplateSlots<Component, Slots> or of __VLS_template()
+func meteorSlots(root *tree_sitter.Node, content []byte) []string {
+	var slots *tree_sitter.Node
+
+	if args := typeArguments(root, "__VLS_WithTemplateSlots", content); len(args) > 1 && args[1].Kind() == "object_type" {
+		slots = args[1]
+	}
+
+	// Newer vue-tsc versions reference the slots returned by __VLS_template() instead of inlining them
+	template := treesitterhelper.FindFirst(root, treesitterhelper.And(
+		treesitterhelper.NodeKind("function_signature"),
+		treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+			name := node.ChildByFieldName("name")
+			return name != nil && name.Utf8Text(content) == "__VLS_template"
+		}),
+	), content)
+	if slots == nil && template != nil {
+		if returnType := template.ChildByFieldName("return_type"); returnType != nil {
+			for _, member := range typeMembers(returnType.NamedChild(0)) {
+				if memberName(member, content) == "slots" {
+					slots = memberType(member)
+				}
+			}
+		}
+	}
+
+	var names []string
+	for _, member := range typeMembers(slots) {
+		names = append(names, memberName(member, content))
+	}
+
+	return names
+}
+
+// propFromMember converts a prop of the props object type, either `name: StringConstructor` or
+// `name: { type: PropType<...>; required: true; ... }`
+func propFromMember(name string, typ *tree_sitter.Node, content []byte) VueComponentProp {
+	if typ == nil || typ.Kind() != "object_type" {
+		return propFromConstructor(name, typ, content)
+	}
+
+	prop := VueComponentProp{Name: name}
+	for _, option := range typeMembers(typ) {
+		switch memberName(option, content) {
+		case "type":
+			typed := propFromConstructor(name, memberType(option), content)
+			prop.Type, prop.PropType, prop.AllowedValues = typed.Type, typed.PropType, typed.AllowedValues
+		case "required":
+			value := memberType(option)
+			prop.Required = value != nil && value.Utf8Text(content) == "true"
+		}
+	}
+
+	return prop
+}
+
+// propFromConstructor converts a runtime prop type like StringConstructor or PropType<'a' | 'b'>
+func propFromConstructor(name string, typ *tree_sitter.Node, content []byte) VueComponentProp {
+	if typ == nil {
+		return VueComponentProp{Name: name}
+	}
+
+	if typeName(typ, content) == "PropType" {
+		if args := typ.ChildByFieldName("type_arguments"); args != nil && args.NamedChildCount() > 0 {
+			return propFromDeclaration(name, args.NamedChild(0), content)
+		}
+	}
+
+	constructors := map[string]string{
+		"StringConstructor":   "String",
+		"BooleanConstructor":  "Boolean",
+		"NumberConstructor":   "Number",
+		"ArrayConstructor":    "Array",
+		"ObjectConstructor":   "Object",
+		"FunctionConstructor": "Function",
+	}
+
+	return VueComponentProp{Name: name, Type: constructors[typ.Utf8Text(content)]}
+}
+
+// propFromTypeSource converts a prop whose TypeScript type is given as source, like the types of the web-types metadata
+func propFromTypeSource(parser *tree_sitter.Parser, name, typ string) VueComponentProp {
+	if strings.TrimSpace(typ) == "" {
+		return VueComponentProp{Name: name}
+	}
+
+	content := []byte("type T = " + typ)
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	alias := tree.RootNode().NamedChild(0)
+	if alias == nil || alias.Kind() != "type_alias_declaration" || alias.ChildByFieldName("value") == nil {
+		return VueComponentProp{Name: name, PropType: strings.TrimSpace(typ)}
+	}
+
+	return propFromDeclaration(name, alias.ChildByFieldName("value"), content)
+}
+
+// propFromDeclaration converts a TypeScript prop type. A union of string literals becomes a String prop
+// whose allowed values are the literals.
+func propFromDeclaration(name string, typ *tree_sitter.Node, content []byte) VueComponentProp {
+	prop := VueComponentProp{Name: name, PropType: typ.Utf8Text(content)}
+
+	switch typ.Kind() {
+	case "predefined_type":
+		switch prop.PropType {
+		case "string":
+			prop.Type, prop.PropType = "String", ""
+		case "boolean":
+			prop.Type, prop.PropType = "Boolean", ""
+		case "number":
+			prop.Type, prop.PropType = "Number", ""
+		}
+		return prop
+	case "array_type":
+		prop.Type = "Array"
+		return prop
+	case "generic_type":
+		if typeName(typ, content) == "Array" {
+			prop.Type = "Array"
+		}
+		return prop
+	}
+
+	if literals := unionLiterals(typ, content); len(literals) > 0 {
+		prop.Type = "String"
+		prop.AllowedValues = literals
+	}
+
+	return prop
+}
+
+// unionLiterals returns the string literals of a union of string literal types, or nil when the union has
+// other members
+func unionLiterals(typ *tree_sitter.Node, content []byte) []string {
+	switch typ.Kind() {
+	case "union_type":
+		var literals []string
+		for i := uint(0); i < typ.NamedChildCount(); i++ {
+			values := unionLiterals(typ.NamedChild(i), content)
+			if values == nil {
+				return nil
+			}
+			literals = append(literals, values...)
+		}
+		return literals
+	case "literal_type":
+		if value := typ.NamedChild(0); value != nil && value.Kind() == "string" {
+			return []string{extractStringContent(value, content)}
+		}
+	}
+
+	return nil
+}
+
+// stringLiterals returns the contents of all string literals in a type
+func stringLiterals(typ *tree_sitter.Node, content []byte) []string {
+	var literals []string
+	for _, node := range treesitterhelper.FindAll(typ, treesitterhelper.NodeKind("string"), content) {
+		literals = append(literals, extractStringContent(node, content))
+	}
+	return literals
+}
+
+// typeArguments returns the type arguments of the first generic type with the given name
+func typeArguments(root *tree_sitter.Node, name string, content []byte) []*tree_sitter.Node {
+	generic := treesitterhelper.FindFirst(root, treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return typeName(node, content) == name
+	}), content)
+	if generic == nil {
+		return nil
+	}
+
+	args := generic.ChildByFieldName("type_arguments")
+	if args == nil {
+		return nil
+	}
+
+	var types []*tree_sitter.Node
+	for i := uint(0); i < args.NamedChildCount(); i++ {
+		if arg := args.NamedChild(i); arg.Kind() != "comment" {
+			types = append(types, arg)
+		}
+	}
+
+	return types
+}
+
+// typeName returns the name of a generic type
+func typeName(node *tree_sitter.Node, content []byte) string {
+	if node.Kind() != "generic_type" {
+		return ""
+	}
+
+	if name := node.ChildByFieldName("name"); name != nil {
+		return name.Utf8Text(content)
+	}
+
+	return ""
+}
+
+// typeMembers returns the properties and methods of an object type
+func typeMembers(node *tree_sitter.Node) []*tree_sitter.Node {
+	if node == nil || node.Kind() != "object_type" {
+		return nil
+	}
+
+	var members []*tree_sitter.Node
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if member := node.NamedChild(i); member.Kind() == "property_signature" || member.Kind() == "method_signature" {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
+// memberType returns the type of a property signature, methods have none
+func memberType(member *tree_sitter.Node) *tree_sitter.Node {
+	annotation := member.ChildByFieldName("type")
+	if annotation == nil {
+		return nil
+	}
+
+	return annotation.NamedChild(0)
+}
+
+// withoutImportQualifiers blanks the import("vue"). qualifiers vue-tsc writes in front of Vue types, which the
+// TypeScript grammar does not accept in type positions
+func withoutImportQualifiers(parser *tree_sitter.Parser, content []byte) []byte {
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	source := bytes.Clone(content)
+	for _, node := range treesitterhelper.FindAll(tree.RootNode(), treesitterhelper.NodeKind("import"), content) {
+		call := node.Parent()
+		if call == nil || call.Kind() != "call_expression" {
+			continue
+		}
+
+		end := call.EndByte()
+		if end >= uint(len(source)) || source[end] != '.' {
+			continue
+		}
+
+		for i := call.StartByte(); i <= end; i++ {
+			source[i] = ' '
+		}
+	}
+
+	return source
+}
+
+// mergeMeteorInterface adds the props, emits and slots of a Meteor component that a component of the same
+// name does not declare itself
+func mergeMeteorInterface(comp, meteor VueComponent) VueComponent {
+	for _, prop := range meteor.Props {
+		if !slices.ContainsFunc(comp.Props, func(p VueComponentProp) bool { return p.Name == prop.Name }) {
+			comp.Props = append(comp.Props, prop)
+		}
+	}
+
+	for _, emit := range meteor.Emits {
+		if !slices.Contains(comp.Emits, emit) {
+			comp.Emits = append(comp.Emits, emit)
+		}
+	}
+
+	for _, slot := range meteor.Slots {
+		if !slices.ContainsFunc(comp.Slots, func(s VueComponentSlot) bool { return s.Name == slot.Name }) {
+			comp.Slots = append(comp.Slots, slot)
+		}
+	}
+
+	return comp
+}
@@ -0,0 +1,196 @@
+package admin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
+)
+
+const meteorButtonDeclaration = `import { type PropType } from "vue";
+declare function __VLS_template(): {
+    slots: {
+        iconFront?(_: {}): any;
+        default?(_: {}): any;
+    };
+    refs: {};
+    attrs: Partial<{}>;
+};
+declare const __VLS_component: import("vue").DefineComponent<{
+    variant: {
+        type: PropType<"primary" | "secondary" | "critical">;
+        required: false;
+        default: string;
+        validator(value: string): boolean;
+    };
+    label: {
+        type: StringConstructor;
+        required: true;
+    };
+    disabled: BooleanConstructor;
+    items: {
+        type: PropType<string[]>;
+    };
+}, {
+    onClick: (event: MouseEvent) => void;
+}, unknown, {}, {}, import("vue").ComponentOptionsMixin, import("vue").ComponentOptionsMixin, {
+    click: (event: MouseEvent) => void;
+    "update:modelValue": (value: string) => void;
+}, string, import("vue").PublicProps>;
+declare const _default: __VLS_WithTemplateSlots<typeof __VLS_component, ReturnType<typeof __VLS_template>["slots"]>;
+export default _default;
+`
+
+func writeMeteorLibrary(t *testing.T, appDir string, files map[string]string) {
+	packageDir := filepath.Join(appDir, "node_modules", MeteorLibraryPackage)
+	for name, content := range files {
+		filePath := filepath.Join(packageDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o644))
+	}
+}
+
+func newTypeScriptParser(t *testing.T) *tree_sitter.Parser {
+	parser := tree_sitter.NewParser()
+	t.Cleanup(func() { parser.Close() })
+
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript())))
+
+	return parser
+}
+
+func TestParseMeteorDeclaration(t *testing.T) {
+	comp, ok := parseMeteorDeclaration(newTypeScriptParser(t), "mt-button", "/lib/mt-button.vue.d.ts", []byte(meteorButtonDeclaration))
+	require.True(t, ok)
+
+	assert.Equal(t, "mt-button", comp.Name)
+	assert.Equal(t, []VueComponentProp{
+		{Name: "variant", Type: "String", PropType: `"primary" | "secondary" | "critical"`, AllowedValues: []string{"primary", "secondary", "critical"}},
+		{Name: "label", Type: "String", Required: true},
+		{Name: "disabled", Type: "Boolean"},
+		{Name: "items", Type: "Array", PropType: "string[]"},
+	}, comp.Props)
+	assert.Equal(t, []string{"click", "update:modelValue"}, comp.Emits)
+	assert.Equal(t, []VueComponentSlot{{Name: "iconFront"}, {Name: "default"}}, comp.Slots)
+}
+
+func TestParseMeteorDeclaration_EmitsArray(t *testing.T) {
+	declaration := `declare const _default: import("vue").DefineComponent<{}, {}, unknown, {}, {}, import("vue").ComponentOptionsMixin, import("vue").ComponentOptionsMixin, ("change" | "close")[], "change" | "close">;`
+
+	comp, ok := parseMeteorDeclaration(newTypeScriptParser(t), "mt-modal", "/lib/mt-modal.vue.d.ts", []byte(declaration))
+	require.True(t, ok)
+	assert.Empty(t, comp.Props)
+	assert.Equal(t, []string{"change", "close"}, comp.Emits)
+}
+
+func TestParseMeteorLibrary_WebTypes(t *testing.T) {
+	appDir := t.TempDir()
+	writeMeteorLibrary(t, appDir, map[string]string{
+		"package.json": `{"name": "@shopware-ag/meteor-component-library", "web-types": "./web-types.json"}`,
+		"web-types.json": `{
+  "contributions": {
+    "html": {
+      "vue-components": [
+        {
+          "name": "MtSwitch",
+          "props": [
+            {"name": "checked", "type": "boolean", "required": true},
+            {"name": "size", "type": ["'small'", "'default'"], "default": "default"}
+          ],
+          "js": {"events": [{"name": "change"}]},
+          "slots": [{"name": "label"}]
+        },
+        {"name": "SomethingElse"}
+      ]
+    }
+  }
+}`,
+	})
+
+	components := ParseMeteorLibrary(filepath.Join(appDir, "node_modules", MeteorLibraryPackage))
+	require.Len(t, components, 1)
+
+	comp := components[0]
+	assert.Equal(t, "mt-switch", comp.Name)
+	assert.Equal(t, []VueComponentProp{
+		{Name: "checked", Type: "Boolean", Required: true},
+		{Name: "size", Type: "String", PropType: "'small' | 'default'", Default: "default", AllowedValues: []string{"small", "default"}},
+	}, comp.Props)
+	assert.Equal(t, []string{"change"}, comp.Emits)
+	assert.Equal(t, []VueComponentSlot{{Name: "label"}}, comp.Slots)
+}
+
+func TestMeteorComponentIndexer(t *testing.T) {
+	components, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = components.Close() }()
+
+	meteor, err := NewMeteorComponentIndexer(t.TempDir(), components)
+	require.NoError(t, err)
+	defer func() { _ = meteor.Close() }()
+
+	appDir := filepath.Join(t.TempDir(), "src/Administration/Resources/app/administration")
+	writeMeteorLibrary(t, appDir, map[string]string{
+		"package.json":                              `{"name": "@shopware-ag/meteor-component-library"}`,
+		"dist/esm/components/mt-button.vue.d.ts":    meteorButtonDeclaration,
+		"dist/esm/components/mt-button.spec.d.ts":   `export {};`,
+		"dist/esm/node_modules/mt-ignored.vue.d.ts": meteorButtonDeclaration,
+	})
+
+	packageJSON := filepath.Join(appDir, "package.json")
+	require.NoError(t, meteor.Index(packageJSON, nil, []byte(`{}`)))
+	require.NoError(t, meteor.Index(filepath.Join(appDir, "src/package.json"), nil, []byte(`{}`)))
+
+	names, err := components.GetAllComponentNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mt-button"}, names)
+
+	found, err := components.GetComponentWithDefinition("mt-button")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Len(t, found[0].Props, 4)
+
+	// An Administration registration of the same name keeps its own props and inherits the rest
+	indexJSFiles(t, components, map[string]string{
+		membersAdminRoot + "/app/component/mt-button/index.js": `
+Shopware.Component.register('mt-button', {
+    props: {
+        label: { type: String, required: false },
+    },
+    emits: ['hover'],
+});
+`,
+	})
+
+	found, err = components.GetComponentWithDefinition("mt-button")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "label", found[0].Props[0].Name)
+	assert.False(t, found[0].Props[0].Required)
+	assert.Len(t, found[0].Props, 4)
+	assert.Equal(t, []string{"hover", "click", "update:modelValue"}, found[0].Emits)
+
+	// Installing another version of the library is picked up by the refresh after indexing, without the app package.json changing
+	writeMeteorLibrary(t, appDir, map[string]string{
+		"package.json":                         `{"name": "@shopware-ag/meteor-component-library", "version": "2.0.0"}`,
+		"dist/esm/components/mt-card.vue.d.ts": meteorButtonDeclaration,
+	})
+
+	names, err = components.GetAllComponentNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mt-button"}, names)
+
+	require.NoError(t, meteor.Refresh())
+	names, err = components.GetAllComponentNames()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"mt-button", "mt-card"}, names)
+
+	require.NoError(t, meteor.RemovedFiles([]string{packageJSON}))
+	registered, err := meteor.GetComponent("mt-button")
+	require.NoError(t, err)
+	assert.Empty(t, registered)
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// MixinReference is a mixin name used in an admin script
+type MixinReference struct {
+	// Name is the referenced mixin
+	Name string
+
+	// Node is the string node holding the mixin name
+	Node *tree_sitter.Node
+}
+
+// MixinReferenceAt returns the mixin reference at the given node, or nil if the node is not a mixin name.
+// Supported are the first argument of Mixin.getByName('...') and plain strings in a `mixins` array:
+//
+//	mixins: [Mixin.getByName('notification'), 'listing']
+func MixinReferenceAt(node *tree_sitter.Node, content []byte) *MixinReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" {
+		return nil
+	}
+
+	parent := stringNode.Parent()
+	if parent == nil {
+		return nil
+	}
+
+	switch parent.Kind() {
+	case "arguments":
+		call := parent.Parent()
+		if call == nil || call.Kind() != "call_expression" || parent.NamedChild(0).Id() != stringNode.Id() {
+			return nil
+		}
+		function := call.ChildByFieldName("function")
+		if function == nil || !strings.HasSuffix(string(function.Utf8Text(content)), "Mixin.getByName") {
+			return nil
+		}
+	case "array":
+		pair := parent.Parent()
+		if pair == nil || pair.Kind() != "pair" || memberName(pair, content) != "mixins" {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	return &MixinReference{Name: extractStringContent(stringNode, content), Node: stringNode}
+}
+
+// mixinInterface returns the props and emits the given mixins add to a component, including those of nested
+// mixins, and whether all mixins are indexed
+func (idx *AdminComponentIndexer) mixinInterface(names []string, seen map[string]bool) ([]VueComponentProp, []string, bool) {
+	var props []VueComponentProp
+	var emits []string
+	complete := true
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		mixins, err := idx.GetMixin(name)
+		if err != nil || len(mixins) == 0 {
+			complete = false
+			continue
+		}
+
+		def := mixins[0].Definition
+		props = append(props, def.Props...)
+		emits = append(emits, def.Emits...)
+
+		nestedProps, nestedEmits, nestedComplete := idx.mixinInterface(def.Mixins, seen)
+		props = append(props, nestedProps...)
+		emits = append(emits, nestedEmits...)
+		complete = complete && nestedComplete
+	}
+
+	return props, emits, complete
+}
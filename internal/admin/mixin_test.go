@@ -0,0 +1,100 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+func TestMixinReferenceAt(t *testing.T) {
+	code := `
+Shopware.Component.register('sw-product-list', {
+    mixins: [Mixin.getByName('notification'), 'listing'],
+    methods: {
+        onSave() {
+            this.createNotification('notAMixin');
+        },
+    },
+});
+`
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	referenceAt := func(text string) *MixinReference {
+		offset := uint(strings.Index(code, text)) + 1
+		return MixinReferenceAt(root.NamedDescendantForByteRange(offset, offset), []byte(code))
+	}
+
+	reference := referenceAt("notification")
+	require.NotNil(t, reference)
+	assert.Equal(t, "notification", reference.Name)
+
+	reference = referenceAt("listing")
+	require.NotNil(t, reference)
+	assert.Equal(t, "listing", reference.Name)
+
+	assert.Nil(t, referenceAt("notAMixin"))
+	assert.Nil(t, referenceAt("sw-product-list"))
+}
+
+func TestGetComponentWithDefinitionMixinInterface(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/mixin/listing.mixin.js": `
+Shopware.Mixin.register('listing', {
+    mixins: [Shopware.Mixin.getByName('paging')],
+    props: {
+        entityName: { type: String, required: true },
+        label: { type: String, required: false },
+    },
+    emits: ['page-change'],
+});
+`,
+		membersAdminRoot + "/app/mixin/paging.mixin.js": `
+Shopware.Mixin.register('paging', {
+    props: {
+        limit: { type: Number, required: true },
+    },
+});
+`,
+		membersAdminRoot + "/app/component/sw-entity-list/index.js": `
+Shopware.Component.register('sw-entity-list', {
+    mixins: [Shopware.Mixin.getByName('listing')],
+    props: {
+        label: { type: String, required: true },
+    },
+    emits: ['select'],
+});
+`,
+	})
+
+	components, err := indexer.GetComponentWithDefinition("sw-entity-list")
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+
+	comp := components[0]
+	assert.Equal(t, []string{"listing"}, comp.Mixins)
+	assert.Equal(t, []string{"select", "page-change"}, comp.Emits)
+
+	props := make(map[string]VueComponentProp)
+	for _, prop := range comp.Props {
+		props[prop.Name] = prop
+	}
+	require.Len(t, props, 3)
+	assert.True(t, props["label"].Required, "own props take precedence over mixin props")
+	assert.True(t, props["entityName"].Required)
+	assert.Equal(t, "Number", props["limit"].Type)
+}
@@ -123,6 +123,15 @@ var TwigSlotShorthandPattern = treesitterhelper.And(
 // Helper Functions
 // =============================================================================
 
+// IsScriptFile checks if a file extension belongs to a JavaScript or TypeScript source
+func IsScriptFile(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".js", ".ts", ".tsx", ".mts":
+		return true
+	}
+	return false
+}
+
 // IsComponentTag checks if a tag name represents a Vue component (contains hyphen)
 // Standard HTML elements don't contain hyphens, Vue components do
 func IsComponentTag(tagName string) bool {
@@ -0,0 +1,332 @@
+package admin
+
+import (
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+var (
+	// templateRouteNamePattern matches the route name of a location object like { name: 'sw.product.detail' }
+	templateRouteNamePattern = treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		pair := node.Parent()
+		if node.Kind() != "string" || pair == nil || pair.Kind() != "pair" {
+			return false
+		}
+
+		value := pair.ChildByFieldName("value")
+		return value != nil && value.Id() == node.Id() && memberName(pair, content) == "name"
+	})
+
+	// routeLinkAttributes are the template attributes taking a route location
+	routeLinkAttributes = map[string]bool{
+		":to": true, "v-bind:to": true, ":router-link": true, "v-bind:router-link": true, ":routerLink": true,
+	}
+
+	// JSModuleRegisterPattern matches Shopware.Module.register and Module.register calls
+	JSModuleRegisterPattern = treesitterhelper.And(
+		treesitterhelper.NodeKind("call_expression"),
+		treesitterhelper.HasChild(
+			treesitterhelper.And(
+				treesitterhelper.NodeKind("member_expression"),
+				treesitterhelper.Or(
+					treesitterhelper.NodeText("Shopware.Module.register"),
+					treesitterhelper.NodeText("Module.register"),
+				),
+			),
+		),
+	)
+)
+
+// AdminRoute represents a route of an administration module
+type AdminRoute struct {
+	// Name is the route name (e.g., "sw.product.detail")
+	Name string
+
+	// Module is the id of the module registering the route (e.g., "sw-product")
+	Module string
+
+	// Component is the name of the component rendered by the route
+	Component string
+
+	// Path is the route path
+	Path string
+
+	// FilePath is the absolute path to the file registering the route
+	FilePath string
+
+	// Line is the line number of the route definition (1-based)
+	Line int
+
+	// Middleware is set for routes added in a routeMiddleware
+	Middleware bool
+}
+
+// parseModuleRoutes extracts the routes of Module.register calls, including child routes
+// and routes pushed in a routeMiddleware
+func parseModuleRoutes(root *tree_sitter.Node, content []byte, filePath string) []AdminRoute {
+	var routes []AdminRoute
+
+	for _, call := range treesitterhelper.FindAll(root, JSModuleRegisterPattern, content) {
+		argsNode := call.ChildByFieldName("arguments")
+		if argsNode == nil {
+			continue
+		}
+
+		args := getArguments(argsNode)
+		if len(args) < 2 || args[0].Kind() != "string" || args[1].Kind() != "object" {
+			continue
+		}
+
+		moduleID := extractStringContent(args[0], content)
+		prefix := strings.ReplaceAll(moduleID, "-", ".")
+
+		var routesObject, middleware *tree_sitter.Node
+		for i := uint(0); i < args[1].NamedChildCount(); i++ {
+			child := args[1].NamedChild(i)
+			switch memberName(child, content) {
+			case "routePrefixName":
+				if value := child.ChildByFieldName("value"); value != nil && value.Kind() == "string" {
+					prefix = extractStringContent(value, content)
+				}
+			case "routes":
+				if value := child.ChildByFieldName("value"); value != nil && value.Kind() == "object" {
+					routesObject = value
+				}
+			case "routeMiddleware":
+				middleware = child
+			}
+		}
+
+		if routesObject != nil {
+			routes = append(routes, parseRouteObjects(routesObject, prefix, moduleID, content, filePath)...)
+		}
+
+		if middleware != nil {
+			routes = append(routes, parseMiddlewareRoutes(middleware, moduleID, content, filePath)...)
+		}
+	}
+
+	return routes
+}
+
+// parseRouteObjects parses the routes of a `routes` or `children` object, names are prefixed with the parent name
+func parseRouteObjects(object *tree_sitter.Node, prefix, moduleID string, content []byte, filePath string) []AdminRoute {
+	var routes []AdminRoute
+
+	for i := uint(0); i < object.NamedChildCount(); i++ {
+		pair := object.NamedChild(i)
+		value := pair.ChildByFieldName("value")
+		if pair.Kind() != "pair" || value == nil || value.Kind() != "object" {
+			continue
+		}
+
+		route := AdminRoute{
+			Name:     prefix + "." + memberName(pair, content),
+			Module:   moduleID,
+			FilePath: filePath,
+			Line:     int(pair.StartPosition().Row) + 1,
+		}
+
+		var children *tree_sitter.Node
+		for j := uint(0); j < value.NamedChildCount(); j++ {
+			property := value.NamedChild(j)
+			propertyValue := property.ChildByFieldName("value")
+			if propertyValue == nil {
+				continue
+			}
+
+			switch memberName(property, content) {
+			case "component":
+				if propertyValue.Kind() == "string" {
+					route.Component = extractStringContent(propertyValue, content)
+				}
+			case "path":
+				if propertyValue.Kind() == "string" {
+					route.Path = extractStringContent(propertyValue, content)
+				}
+			case "children":
+				if propertyValue.Kind() == "object" {
+					children = propertyValue
+				}
+			}
+		}
+
+		routes = append(routes, route)
+
+		if children != nil {
+			routes = append(routes, parseRouteObjects(children, route.Name, moduleID, content, filePath)...)
+		}
+	}
+
+	return routes
+}
+
+// parseMiddlewareRoutes parses routes pushed in a routeMiddleware, e.g. currentRoute.children.push({ name: '...' })
+func parseMiddlewareRoutes(middleware *tree_sitter.Node, moduleID string, content []byte, filePath string) []AdminRoute {
+	var routes []AdminRoute
+
+	for _, call := range treesitterhelper.FindAll(middleware, treesitterhelper.NodeKind("call_expression"), content) {
+		argsNode := call.ChildByFieldName("arguments")
+		if callName(call, content) != "push" || argsNode == nil {
+			continue
+		}
+
+		args := getArguments(argsNode)
+		if len(args) == 0 || args[0].Kind() != "object" {
+			continue
+		}
+
+		route := AdminRoute{
+			Module:     moduleID,
+			FilePath:   filePath,
+			Line:       int(args[0].StartPosition().Row) + 1,
+			Middleware: true,
+		}
+
+		for i := uint(0); i < args[0].NamedChildCount(); i++ {
+			property := args[0].NamedChild(i)
+			value := property.ChildByFieldName("value")
+			if value == nil || value.Kind() != "string" {
+				continue
+			}
+
+			switch memberName(property, content) {
+			case "name":
+				route.Name = extractStringContent(value, content)
+			case "component":
+				route.Component = extractStringContent(value, content)
+			case "path":
+				route.Path = extractStringContent(value, content)
+			}
+		}
+
+		if route.Name != "" {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes
+}
+
+// RouteReference is a route name used in a script
+type RouteReference struct {
+	// Name is the referenced route name
+	Name string
+
+	// Node is the string node holding the name
+	Node *tree_sitter.Node
+}
+
+// RouteReferenceAt returns the route reference at the given node, or nil if the node is not a route name in
+// $router.push({ name: '...' }), $router.replace({ name: '...' }) or a module navigation entry path
+func RouteReferenceAt(node *tree_sitter.Node, content []byte) *RouteReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" || !isRouteName(stringNode, content) {
+		return nil
+	}
+
+	return &RouteReference{Name: extractStringContent(stringNode, content), Node: stringNode}
+}
+
+// ScriptRouteReferences returns all route names used in $router.push/replace calls and navigation entries
+func ScriptRouteReferences(root *tree_sitter.Node, content []byte) []RouteReference {
+	routeName := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "string" && isRouteName(node, content)
+	})
+
+	var references []RouteReference
+	for _, node := range treesitterhelper.FindAll(root, routeName, content) {
+		references = append(references, RouteReference{Name: extractStringContent(node, content), Node: node})
+	}
+
+	return references
+}
+
+// isRouteName checks if a string is the name of a router location or the path of a navigation entry
+func isRouteName(stringNode *tree_sitter.Node, content []byte) bool {
+	pair := stringNode.Parent()
+	if pair == nil || pair.Kind() != "pair" {
+		return false
+	}
+
+	value := pair.ChildByFieldName("value")
+	object := pair.Parent()
+	if value == nil || value.Id() != stringNode.Id() || object == nil || object.Parent() == nil {
+		return false
+	}
+
+	switch memberName(pair, content) {
+	case "name":
+		argsNode := object.Parent()
+		if argsNode.Kind() != "arguments" || argsNode.Parent() == nil {
+			return false
+		}
+
+		call := argsNode.Parent()
+		name := callName(call, content)
+		if name != "push" && name != "replace" {
+			return false
+		}
+
+		function := call.ChildByFieldName("function")
+		routerObject := function.ChildByFieldName("object")
+		return routerObject != nil && strings.HasSuffix(strings.ToLower(string(routerObject.Utf8Text(content))), "router")
+	case "path":
+		array := object.Parent()
+		if array.Kind() != "array" || array.Parent() == nil {
+			return false
+		}
+
+		navigation := array.Parent()
+		return navigation.Kind() == "pair" && memberName(navigation, content) == "navigation"
+	}
+
+	return false
+}
+
+// TemplateRouteReference is a route name in a template route location like :to="{ name: '...' }"
+type TemplateRouteReference struct {
+	// Name is the referenced route name
+	Name string
+
+	// Offset is the byte offset of the name in the template
+	Offset int
+}
+
+// TemplateRouteReferences returns all route names of :to and :router-link locations in an administration template
+func TemplateRouteReferences(root *tree_sitter.Node, content []byte) []TemplateRouteReference {
+	var references []TemplateRouteReference
+
+	for _, expression := range ParseTemplateExpressions(root, content) {
+		if !routeLinkAttributes[expression.Attribute] {
+			continue
+		}
+
+		tree := expression.Parse()
+		for _, node := range treesitterhelper.FindAll(tree.RootNode(), templateRouteNamePattern, tree.Source) {
+			references = append(references, TemplateRouteReference{
+				Name:   extractStringContent(node, tree.Source),
+				Offset: tree.TemplateOffset(node.StartByte() + 1),
+			})
+		}
+		tree.Close()
+	}
+
+	return references
+}
+
+// TemplateRouteNameAt returns the route name before the given offset of a template, used for completion
+func TemplateRouteNameAt(root *tree_sitter.Node, content []byte, offset int) (string, bool) {
+	for _, reference := range TemplateRouteReferences(root, content) {
+		if reference.Offset <= offset && offset <= reference.Offset+len(reference.Name) {
+			return reference.Name[:offset-reference.Offset], true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+func TestIndexRoutes(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/module/sw-product/index.js": `
+Shopware.Module.register('sw-product', {
+    routes: {
+        index: {
+            component: 'sw-product-list',
+            path: 'index',
+        },
+        detail: {
+            component: 'sw-product-detail',
+            path: 'detail/:id',
+            children: {
+                base: {
+                    component: 'sw-product-detail-base',
+                    path: 'base',
+                },
+            },
+        },
+    },
+
+    navigation: [{
+        path: 'sw.product.index',
+    }],
+});
+`,
+		"/project/custom/plugins/MyPlugin/src/Resources/app/administration/src/module/my-reviews/index.js": `
+Module.register('my-reviews', {
+    routeMiddleware(next, currentRoute) {
+        if (currentRoute.name === 'sw.product.detail') {
+            currentRoute.children.push({
+                name: 'sw.product.detail.reviews',
+                path: '/sw/product/detail/:id/reviews',
+                component: 'my-product-reviews',
+            });
+        }
+        next(currentRoute);
+    },
+});
+`,
+	})
+
+	routes, err := indexer.GetAllRoutes()
+	require.NoError(t, err)
+	require.Len(t, routes, 4)
+
+	byName := make(map[string]AdminRoute)
+	for _, route := range routes {
+		byName[route.Name] = route
+	}
+
+	assert.Equal(t, "sw-product-list", byName["sw.product.index"].Component)
+	assert.Equal(t, 4, byName["sw.product.index"].Line)
+	assert.Equal(t, "detail/:id", byName["sw.product.detail"].Path)
+	assert.Equal(t, "sw-product-detail-base", byName["sw.product.detail.base"].Component)
+	assert.Equal(t, "sw-product", byName["sw.product.detail.base"].Module)
+	assert.True(t, byName["sw.product.detail.reviews"].Middleware)
+	assert.Equal(t, "my-product-reviews", byName["sw.product.detail.reviews"].Component)
+
+	assert.True(t, indexer.HasCoreRoutes())
+}
+
+func TestRouteReferenceAt(t *testing.T) {
+	code := `
+Shopware.Module.register('my-module', {
+    navigation: [{ id: 'my-module', path: 'my.module.index' }],
+    methods: {
+        onOpen() {
+            this.$router.push({ name: 'sw.product.detail', params: { id: 1 } });
+            this.items.push({ name: 'notARoute' });
+        },
+    },
+});
+`
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	referenceAt := func(text string) *RouteReference {
+		offset := uint(strings.Index(code, text)) + 1
+		return RouteReferenceAt(root.NamedDescendantForByteRange(offset, offset), []byte(code))
+	}
+
+	reference := referenceAt("sw.product.detail")
+	require.NotNil(t, reference)
+	assert.Equal(t, "sw.product.detail", reference.Name)
+
+	reference = referenceAt("my.module.index")
+	require.NotNil(t, reference)
+	assert.Equal(t, "my.module.index", reference.Name)
+
+	assert.Nil(t, referenceAt("notARoute"))
+	assert.Nil(t, referenceAt("my-module'"))
+
+	var names []string
+	for _, reference := range ScriptRouteReferences(root, []byte(code)) {
+		names = append(names, reference.Name)
+	}
+	assert.Equal(t, []string{"my.module.index", "sw.product.detail"}, names)
+}
+
+func TestTemplateRouteReferences(t *testing.T) {
+	template := `<router-link :to="{ name: 'sw.product.detail', params: { id: product.id } }">
+    <sw-button :router-link="{ name: 'sw.product.index' }" :title="{ name: 'noRoute' }"></sw-button>
+</router-link>`
+
+	references := TemplateRouteReferences(parseTwig(t, template), []byte(template))
+	require.Len(t, references, 2)
+	assert.Equal(t, "sw.product.detail", references[0].Name)
+	assert.Equal(t, strings.Index(template, "sw.product.detail"), references[0].Offset)
+	assert.Equal(t, "sw.product.index", references[1].Name)
+
+	name, ok := TemplateRouteNameAt(parseTwig(t, template), []byte(template), strings.Index(template, "duct.detail"))
+	assert.True(t, ok)
+	assert.Equal(t, "sw.pro", name)
+
+	_, ok = TemplateRouteNameAt(parseTwig(t, template), []byte(template), strings.Index(template, "oute'"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,270 @@
+package admin
+
+import (
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// AdminServiceSource describes how an admin service is made available
+type AdminServiceSource string
+
+const (
+	// ServiceSourceRegister is Shopware.Service().register('name', ...)
+	ServiceSourceRegister AdminServiceSource = "Service().register"
+
+	// ServiceSourceProvider is Shopware.Application.addServiceProvider('name', ...)
+	ServiceSourceProvider AdminServiceSource = "Application.addServiceProvider"
+
+	// ServiceSourceApiService is a class extending ApiService with this.name = 'name'
+	ServiceSourceApiService AdminServiceSource = "ApiService"
+
+	// ServiceSourceProvide is a component providing the value with provide()
+	ServiceSourceProvide AdminServiceSource = "provide"
+)
+
+// AdminService represents a service available through Shopware.Service() and inject
+type AdminService struct {
+	// Name is the service name (e.g., "repositoryFactory")
+	Name string
+
+	// Source describes how the service is registered
+	Source AdminServiceSource
+
+	// FilePath is the absolute path to the registering file
+	FilePath string
+
+	// Line is the line number of the registration (1-based)
+	Line int
+}
+
+// parseServiceRegistrations extracts service registrations from an admin script
+func parseServiceRegistrations(root *tree_sitter.Node, content []byte, filePath string) []AdminService {
+	var services []AdminService
+
+	add := func(nameNode *tree_sitter.Node, source AdminServiceSource) {
+		name := extractStringContent(nameNode, content)
+		if name == "" {
+			return
+		}
+		services = append(services, AdminService{
+			Name:     name,
+			Source:   source,
+			FilePath: filePath,
+			Line:     int(nameNode.StartPosition().Row) + 1,
+		})
+	}
+
+	registrationKinds := treesitterhelper.AnyNodeKind("call_expression", "class_declaration", "class", "method_definition", "pair")
+
+	for _, node := range treesitterhelper.FindAll(root, registrationKinds, content) {
+		switch node.Kind() {
+		case "call_expression":
+			function := node.ChildByFieldName("function")
+			if function == nil {
+				continue
+			}
+
+			var source AdminServiceSource
+			switch {
+			case isServiceRegisterCall(function, content):
+				source = ServiceSourceRegister
+			case isAddServiceProviderCall(function, content):
+				source = ServiceSourceProvider
+			default:
+				continue
+			}
+
+			if nameNode := firstArgumentString(node); nameNode != nil {
+				add(nameNode, source)
+			}
+		case "class_declaration", "class":
+			heritage := treesitterhelper.GetFirstNodeOfKind(node, "class_heritage")
+			if heritage == nil || !strings.Contains(string(heritage.Utf8Text(content)), "ApiService") {
+				continue
+			}
+
+			for _, assignment := range treesitterhelper.FindAll(node, treesitterhelper.NodeKind("assignment_expression"), content) {
+				left := assignment.ChildByFieldName("left")
+				right := assignment.ChildByFieldName("right")
+				if left != nil && right != nil && right.Kind() == "string" && string(left.Utf8Text(content)) == "this.name" {
+					add(right, ServiceSourceApiService)
+				}
+			}
+		case "method_definition", "pair":
+			if memberName(node, content) != "provide" {
+				continue
+			}
+
+			provided := node
+			if node.Kind() == "pair" {
+				provided = node.ChildByFieldName("value")
+			}
+			if provided == nil {
+				continue
+			}
+
+			object := provided
+			if provided.Kind() != "object" {
+				object = returnedObject(provided)
+			}
+			if object == nil {
+				continue
+			}
+
+			for i := uint(0); i < object.NamedChildCount(); i++ {
+				child := object.NamedChild(i)
+				if memberName(child, content) == "" {
+					continue
+				}
+				services = append(services, AdminService{
+					Name:     memberName(child, content),
+					Source:   ServiceSourceProvide,
+					FilePath: filePath,
+					Line:     int(child.StartPosition().Row) + 1,
+				})
+			}
+		}
+	}
+
+	return services
+}
+
+// ServiceReference is a service name used in Shopware.Service('<name>') or an inject array/object
+type ServiceReference struct {
+	// Name is the referenced service name
+	Name string
+
+	// Node is the string node holding the name
+	Node *tree_sitter.Node
+
+	// Inject is true for inject entries, false for Shopware.Service() calls
+	Inject bool
+}
+
+// ServiceReferenceAt returns the service reference at the given node, or nil if the node
+// is not inside Shopware.Service('<name>') or an inject array/object
+func ServiceReferenceAt(node *tree_sitter.Node, content []byte) *ServiceReference {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" {
+		return nil
+	}
+
+	parent := stringNode.Parent()
+	if parent == nil {
+		return nil
+	}
+
+	switch parent.Kind() {
+	case "arguments":
+		call := parent.Parent()
+		if call == nil || call.Kind() != "call_expression" || !isServiceGetCall(call, content) {
+			return nil
+		}
+		if first := firstArgumentString(call); first == nil || first.Id() != stringNode.Id() {
+			return nil
+		}
+		return &ServiceReference{Name: extractStringContent(stringNode, content), Node: stringNode}
+	case "array", "pair":
+		if isInjectEntry(stringNode, content) {
+			return &ServiceReference{Name: extractStringContent(stringNode, content), Node: stringNode, Inject: true}
+		}
+	}
+
+	return nil
+}
+
+// InjectedServices returns all services injected with inject: [...] or inject: {...}
+func InjectedServices(root *tree_sitter.Node, content []byte) []ServiceReference {
+	injectEntry := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "string" && isInjectEntry(node, content)
+	})
+
+	var references []ServiceReference
+	for _, node := range treesitterhelper.FindAll(root, injectEntry, content) {
+		references = append(references, ServiceReference{Name: extractStringContent(node, content), Node: node, Inject: true})
+	}
+
+	return references
+}
+
+// isInjectEntry checks if a string is an entry of inject: ['name'] or a value of inject: { local: 'name' }
+func isInjectEntry(stringNode *tree_sitter.Node, content []byte) bool {
+	parent := stringNode.Parent()
+	if parent == nil {
+		return false
+	}
+
+	var injectPair *tree_sitter.Node
+	switch parent.Kind() {
+	case "array":
+		injectPair = parent.Parent()
+	case "pair":
+		value := parent.ChildByFieldName("value")
+		if value == nil || value.Id() != stringNode.Id() || parent.Parent() == nil {
+			return false
+		}
+		injectPair = parent.Parent().Parent()
+	}
+
+	return injectPair != nil && injectPair.Kind() == "pair" && memberName(injectPair, content) == "inject"
+}
+
+// isServiceGetCall checks for Shopware.Service('name') and Service('name')
+func isServiceGetCall(call *tree_sitter.Node, content []byte) bool {
+	function := call.ChildByFieldName("function")
+	if function == nil {
+		return false
+	}
+
+	name := string(function.Utf8Text(content))
+	return name == "Shopware.Service" || name == "Service"
+}
+
+// isServiceRegisterCall checks for Shopware.Service().register and Service().register
+func isServiceRegisterCall(function *tree_sitter.Node, content []byte) bool {
+	if function.Kind() != "member_expression" {
+		return false
+	}
+
+	property := function.ChildByFieldName("property")
+	object := function.ChildByFieldName("object")
+	if property == nil || object == nil || string(property.Utf8Text(content)) != "register" || object.Kind() != "call_expression" {
+		return false
+	}
+
+	args := object.ChildByFieldName("arguments")
+	return isServiceGetCall(object, content) && args != nil && args.NamedChildCount() == 0
+}
+
+// isAddServiceProviderCall checks for Shopware.Application.addServiceProvider and Application.addServiceProvider
+func isAddServiceProviderCall(function *tree_sitter.Node, content []byte) bool {
+	name := string(function.Utf8Text(content))
+	return name == "Shopware.Application.addServiceProvider" || name == "Application.addServiceProvider"
+}
+
+// firstArgumentString returns the first argument of a call if it is a string
+func firstArgumentString(call *tree_sitter.Node) *tree_sitter.Node {
+	args := call.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+
+	if arg := args.NamedChild(0); arg.Kind() == "string" {
+		return arg
+	}
+
+	return nil
+}
+
+// IsCoreAdministrationPath checks if a file belongs to the Shopware Administration bundle.
+// Diagnostics for unknown services, routes and privileges are only reported once the core Administration
+// is indexed, as without it nearly every name used by a plugin would be unknown.
+func IsCoreAdministrationPath(filePath string) bool {
+	return strings.Contains(filePath, "src/Administration/Resources/app/administration/") ||
+		strings.Contains(filePath, "vendor/shopware/administration/Resources/app/administration/")
+}
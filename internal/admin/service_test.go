@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+func TestIndexServices(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	pluginRoot := "/project/custom/plugins/MyPlugin/src/Resources/app/administration/src"
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/init-pre/api-services.init.js": `
+Shopware.Application.addServiceProvider('repositoryFactory', () => {
+    return new RepositoryFactory();
+});
+`,
+		membersAdminRoot + "/core/service/api/system-config.api.service.js": `
+class SystemConfigApiService extends ApiService {
+    constructor(httpClient, loginService, apiEndpoint = 'system-config') {
+        super(httpClient, loginService, apiEndpoint);
+        this.name = 'systemConfigApiService';
+    }
+}
+`,
+		pluginRoot + "/main.js": `
+Shopware.Service().register('myService', () => new MyService());
+`,
+		pluginRoot + "/component/my-parent/index.js": `
+Shopware.Component.register('my-parent', {
+    provide() {
+        return {
+            parentValue: this.value,
+        };
+    },
+});
+`,
+	})
+
+	services, err := indexer.GetAllServices()
+	require.NoError(t, err)
+	require.Len(t, services, 4)
+
+	byName := make(map[string]AdminService)
+	for _, service := range services {
+		byName[service.Name] = service
+	}
+
+	assert.Equal(t, ServiceSourceProvider, byName["repositoryFactory"].Source)
+	assert.Equal(t, 2, byName["repositoryFactory"].Line)
+	assert.Equal(t, ServiceSourceApiService, byName["systemConfigApiService"].Source)
+	assert.Equal(t, 5, byName["systemConfigApiService"].Line)
+	assert.Equal(t, ServiceSourceRegister, byName["myService"].Source)
+	assert.Equal(t, ServiceSourceProvide, byName["parentValue"].Source)
+
+	assert.True(t, indexer.HasCoreServices())
+
+	require.NoError(t, indexer.RemovedFiles([]string{pluginRoot + "/main.js"}))
+	service, err := indexer.GetService("myService")
+	require.NoError(t, err)
+	assert.Empty(t, service)
+
+	require.NoError(t, indexer.RemovedFiles([]string{
+		membersAdminRoot + "/app/init-pre/api-services.init.js",
+		membersAdminRoot + "/core/service/api/system-config.api.service.js",
+	}))
+	assert.False(t, indexer.HasCoreServices())
+}
+
+func TestServiceReferenceAt(t *testing.T) {
+	code := `
+const factory = Shopware.Service('repositoryFactory');
+Service().register('notAReference', () => {});
+
+Shopware.Component.register('sw-example', {
+    inject: ['acl', 'systemConfigApiService'],
+});
+
+Component.override('sw-other', {
+    inject: { config: 'systemConfigApiService' },
+    methods: { foo() { return ['notInjected']; } },
+});
+`
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	referenceAt := func(text string) *ServiceReference {
+		offset := uint(strings.Index(code, text)) + 1
+		node := root.NamedDescendantForByteRange(offset, offset)
+		return ServiceReferenceAt(node, []byte(code))
+	}
+
+	reference := referenceAt("repositoryFactory")
+	require.NotNil(t, reference)
+	assert.Equal(t, "repositoryFactory", reference.Name)
+	assert.False(t, reference.Inject)
+
+	reference = referenceAt("acl")
+	require.NotNil(t, reference)
+	assert.Equal(t, "acl", reference.Name)
+	assert.True(t, reference.Inject)
+
+	reference = referenceAt("systemConfigApiService' }")
+	require.NotNil(t, reference)
+	assert.Equal(t, "systemConfigApiService", reference.Name)
+
+	assert.Nil(t, referenceAt("notAReference"))
+	assert.Nil(t, referenceAt("notInjected"))
+	assert.Nil(t, referenceAt("sw-example"))
+
+	var injected []string
+	for _, reference := range InjectedServices(root, []byte(code)) {
+		injected = append(injected, reference.Name)
+	}
+	assert.Equal(t, []string{"acl", "systemConfigApiService", "systemConfigApiService"}, injected)
+}
@@ -0,0 +1,488 @@
+package admin
+
+import (
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// AdminStoreKind is the state management library of a store
+type AdminStoreKind string
+
+const (
+	// StoreKindPinia is a store registered with Shopware.Store.register({ id: ... })
+	StoreKindPinia AdminStoreKind = "pinia"
+
+	// StoreKindVuex is a module registered with Shopware.State.registerModule('name', ...)
+	StoreKindVuex AdminStoreKind = "vuex"
+)
+
+// StoreMemberKind is the kind of a store member
+type StoreMemberKind string
+
+const (
+	StoreMemberState    StoreMemberKind = "state"
+	StoreMemberGetter   StoreMemberKind = "getter"
+	StoreMemberMutation StoreMemberKind = "mutation"
+	StoreMemberAction   StoreMemberKind = "action"
+)
+
+// AdminStore represents a Pinia store or Vuex module of the administration
+type AdminStore struct {
+	// ID is the Pinia store id or the Vuex module name (e.g., "swProductDetail")
+	ID string
+
+	// Kind is the state management library of the store
+	Kind AdminStoreKind
+
+	// FilePath is the absolute path to the registration file
+	FilePath string
+
+	// Line is the line number of the registration (1-based)
+	Line int
+
+	// DefinitionPath is the resolved absolute path of an imported module definition
+	DefinitionPath string
+
+	// Definition contains the parsed inline store definition
+	Definition *StoreDefinition
+}
+
+// StoreDefinition holds the members of a store definition object
+type StoreDefinition struct {
+	FilePath string
+	Members  []StoreMember
+}
+
+// StoreMember is a state property, getter, mutation or action of a store
+type StoreMember struct {
+	// Name is the member name
+	Name string
+
+	// Kind is the member kind
+	Kind StoreMemberKind
+
+	// Line is the line number of the member definition (1-based)
+	Line int
+}
+
+// MembersOfKind returns all members of the given kinds
+func (def *StoreDefinition) MembersOfKind(kinds ...StoreMemberKind) []StoreMember {
+	var members []StoreMember
+	for _, member := range def.Members {
+		for _, kind := range kinds {
+			if member.Kind == kind {
+				members = append(members, member)
+			}
+		}
+	}
+	return members
+}
+
+// storeMemberKeys maps the keys of a store definition object to the member kind they define
+var storeMemberKeys = map[string]StoreMemberKind{
+	"state":     StoreMemberState,
+	"getters":   StoreMemberGetter,
+	"mutations": StoreMemberMutation,
+	"actions":   StoreMemberAction,
+}
+
+// parseStoreRegistrations extracts Shopware.Store.register and Shopware.State.registerModule calls
+func parseStoreRegistrations(root *tree_sitter.Node, content []byte, filePath string) []AdminStore {
+	var stores []AdminStore
+
+	for _, call := range treesitterhelper.FindAll(root, treesitterhelper.NodeKind("call_expression"), content) {
+		function := call.ChildByFieldName("function")
+		argsNode := call.ChildByFieldName("arguments")
+		if function == nil || argsNode == nil {
+			continue
+		}
+
+		args := getArguments(argsNode)
+		line := int(call.StartPosition().Row) + 1
+
+		switch string(function.Utf8Text(content)) {
+		case "Shopware.Store.register", "Store.register":
+			if len(args) == 0 || args[0].Kind() != "object" {
+				continue
+			}
+
+			def := parseStoreDefinition(args[0], content, filePath)
+			id := ""
+			for i := uint(0); i < args[0].NamedChildCount(); i++ {
+				if pair := args[0].NamedChild(i); pair.Kind() == "pair" && memberName(pair, content) == "id" {
+					if value := pair.ChildByFieldName("value"); value != nil && value.Kind() == "string" {
+						id = extractStringContent(value, content)
+					}
+				}
+			}
+			if id == "" {
+				continue
+			}
+
+			stores = append(stores, AdminStore{ID: id, Kind: StoreKindPinia, FilePath: filePath, Line: line, Definition: def})
+		case "Shopware.State.registerModule", "State.registerModule":
+			if len(args) < 2 || args[0].Kind() != "string" {
+				continue
+			}
+
+			store := AdminStore{
+				ID:       extractStringContent(args[0], content),
+				Kind:     StoreKindVuex,
+				FilePath: filePath,
+				Line:     line,
+			}
+
+			switch args[1].Kind() {
+			case "object":
+				store.Definition = parseStoreDefinition(args[1], content, filePath)
+			case "identifier":
+				importPath := findImportSource(root, string(args[1].Utf8Text(content)), content)
+				store.DefinitionPath = resolveImportPath(filePath, importPath)
+			}
+
+			stores = append(stores, store)
+		}
+	}
+
+	return stores
+}
+
+// parseStoreModuleFile parses a file exporting a Vuex module (export default { namespaced: true, state, ... }).
+// Returns nil when the exported object doesn't look like a store module.
+func parseStoreModuleFile(root *tree_sitter.Node, content []byte, filePath string) *StoreDefinition {
+	exportDefault := findExportDefault(root)
+	if exportDefault == nil {
+		return nil
+	}
+
+	object := treesitterhelper.GetFirstNodeOfKind(exportDefault, "object")
+	if object == nil {
+		return nil
+	}
+
+	isModule := false
+	for i := uint(0); i < object.NamedChildCount(); i++ {
+		name := memberName(object.NamedChild(i), content)
+		if name == "namespaced" || name == "state" || name == "mutations" {
+			isModule = true
+		}
+	}
+	if !isModule {
+		return nil
+	}
+
+	return parseStoreDefinition(object, content, filePath)
+}
+
+// parseStoreDefinition collects the state, getters, mutations and actions of a store definition object
+func parseStoreDefinition(object *tree_sitter.Node, content []byte, filePath string) *StoreDefinition {
+	def := &StoreDefinition{FilePath: filePath}
+
+	for i := uint(0); i < object.NamedChildCount(); i++ {
+		child := object.NamedChild(i)
+		kind, ok := storeMemberKeys[memberName(child, content)]
+		if !ok {
+			continue
+		}
+
+		var members *tree_sitter.Node
+		switch child.Kind() {
+		case "method_definition":
+			members = returnedObject(child)
+		case "pair":
+			value := child.ChildByFieldName("value")
+			if value == nil {
+				continue
+			}
+			if value.Kind() == "object" {
+				members = value
+			} else {
+				members = returnedObject(value)
+			}
+		}
+		if members == nil {
+			continue
+		}
+
+		for j := uint(0); j < members.NamedChildCount(); j++ {
+			member := members.NamedChild(j)
+			if name := memberName(member, content); name != "" {
+				def.Members = append(def.Members, StoreMember{
+					Name: name,
+					Kind: kind,
+					Line: int(member.StartPosition().Row) + 1,
+				})
+			}
+		}
+	}
+
+	return def
+}
+
+// findImportSource returns the source of the default import with the given local name
+func findImportSource(root *tree_sitter.Node, localName string, content []byte) string {
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		statement := root.NamedChild(i)
+		if statement.Kind() != "import_statement" {
+			continue
+		}
+
+		clause := treesitterhelper.GetFirstNodeOfKind(statement, "import_clause")
+		source := statement.ChildByFieldName("source")
+		if clause == nil || source == nil {
+			continue
+		}
+
+		identifier := treesitterhelper.GetFirstNodeOfKind(clause, "identifier")
+		if identifier != nil && string(identifier.Utf8Text(content)) == localName {
+			return extractStringContent(source, content)
+		}
+	}
+
+	return ""
+}
+
+// StoreReferenceKind describes what a string in a store call refers to
+type StoreReferenceKind string
+
+const (
+	// StoreReferenceID is a Pinia store id in Shopware.Store.get('<id>')
+	StoreReferenceID StoreReferenceKind = "id"
+
+	// StoreReferenceModule is a Vuex module name in Shopware.State.get('<name>') or mapState('<name>', ...)
+	StoreReferenceModule StoreReferenceKind = "module"
+
+	// StoreReferenceMember is a member mapped with mapState('module', ['<member>']) or accessed
+	// with Shopware.Store.get('id').<member>
+	StoreReferenceMember StoreReferenceKind = "member"
+
+	// StoreReferencePath is a namespaced path in commit('<module>/<mutation>') or dispatch('<module>/<action>')
+	StoreReferencePath StoreReferenceKind = "path"
+)
+
+// StoreReference is a reference to a store, store member or namespaced mutation/action
+type StoreReference struct {
+	// Kind describes what is referenced
+	Kind StoreReferenceKind
+
+	// Node is the string or property identifier holding the reference
+	Node *tree_sitter.Node
+
+	// Name is the referenced store id, module name, member name or namespaced path
+	Name string
+
+	// Store is the store id or module name owning a referenced member
+	Store string
+
+	// StoreKind is the kind of store the reference points to
+	StoreKind AdminStoreKind
+
+	// MemberKinds are the member kinds a member or path reference can point to
+	MemberKinds []StoreMemberKind
+}
+
+// vuexMapHelpers maps the Vuex map helpers to the member kind they map
+var vuexMapHelpers = map[string]StoreMemberKind{
+	"mapState":     StoreMemberState,
+	"mapGetters":   StoreMemberGetter,
+	"mapMutations": StoreMemberMutation,
+	"mapActions":   StoreMemberAction,
+}
+
+// StoreReferenceAt returns the store reference at the given node, or nil if the node doesn't reference a store
+func StoreReferenceAt(node *tree_sitter.Node, content []byte) *StoreReference {
+	if node == nil {
+		return nil
+	}
+
+	// Shopware.Store.get('id').member
+	if node.Kind() == "property_identifier" {
+		return storeMemberAccess(node, content)
+	}
+
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" || stringNode.Parent() == nil {
+		return nil
+	}
+
+	name := extractStringContent(stringNode, content)
+	parent := stringNode.Parent()
+
+	// mapState('module', ['member']) or mapState('module', { local: 'member' })
+	var container *tree_sitter.Node
+	switch parent.Kind() {
+	case "array":
+		container = parent
+	case "pair":
+		if value := parent.ChildByFieldName("value"); value != nil && value.Id() == stringNode.Id() {
+			container = parent.Parent()
+		}
+	}
+	if container != nil {
+		argsNode := container.Parent()
+		if argsNode == nil || argsNode.Kind() != "arguments" || argsNode.Parent() == nil {
+			return nil
+		}
+
+		args := getArguments(argsNode)
+		kind, ok := vuexMapHelpers[callName(argsNode.Parent(), content)]
+		if !ok || len(args) < 2 || args[0].Kind() != "string" || args[1].Id() != container.Id() {
+			return nil
+		}
+
+		return &StoreReference{
+			Kind:        StoreReferenceMember,
+			Node:        stringNode,
+			Name:        name,
+			Store:       extractStringContent(args[0], content),
+			StoreKind:   StoreKindVuex,
+			MemberKinds: []StoreMemberKind{kind},
+		}
+	}
+
+	if parent.Kind() != "arguments" || parent.Parent() == nil {
+		return nil
+	}
+
+	call := parent.Parent()
+	if first := firstArgumentString(call); first == nil || first.Id() != stringNode.Id() {
+		return nil
+	}
+
+	function := call.ChildByFieldName("function")
+	if function == nil {
+		return nil
+	}
+
+	switch functionName := string(function.Utf8Text(content)); {
+	case functionName == "Shopware.Store.get" || functionName == "Store.get":
+		return &StoreReference{Kind: StoreReferenceID, Node: stringNode, Name: name, StoreKind: StoreKindPinia}
+	case functionName == "Shopware.State.get" || functionName == "State.get":
+		return &StoreReference{Kind: StoreReferenceModule, Node: stringNode, Name: name, StoreKind: StoreKindVuex}
+	}
+
+	callee := callName(call, content)
+	if _, ok := vuexMapHelpers[callee]; ok {
+		return &StoreReference{Kind: StoreReferenceModule, Node: stringNode, Name: name, StoreKind: StoreKindVuex}
+	}
+
+	var kinds []StoreMemberKind
+	switch callee {
+	case "commit":
+		kinds = []StoreMemberKind{StoreMemberMutation}
+	case "dispatch":
+		kinds = []StoreMemberKind{StoreMemberAction}
+	default:
+		return nil
+	}
+
+	reference := &StoreReference{Kind: StoreReferencePath, Node: stringNode, Name: name, StoreKind: StoreKindVuex, MemberKinds: kinds}
+	if slash := strings.LastIndex(name, "/"); slash != -1 {
+		reference.Store = name[:slash]
+	}
+
+	return reference
+}
+
+// storeMemberAccess returns the reference of a member accessed on Shopware.Store.get('id') or Shopware.State.get('name')
+func storeMemberAccess(property *tree_sitter.Node, content []byte) *StoreReference {
+	member := property.Parent()
+	if member == nil || member.Kind() != "member_expression" {
+		return nil
+	}
+
+	reference := storeOfObject(member.ChildByFieldName("object"), content)
+	if reference == nil {
+		return nil
+	}
+
+	reference.Node = property
+	reference.Name = string(property.Utf8Text(content))
+
+	return reference
+}
+
+// StoreMemberAccessAt returns the store of the member access typed at the offset, like
+// Shopware.Store.get('session').<caret>, or nil if the accessed object is no store
+func StoreMemberAccessAt(root *tree_sitter.Node, content []byte, offset uint) *StoreReference {
+	return storeOfObject(MemberObjectAt(root, offset), content)
+}
+
+// storeOfObject returns a member reference without name for Shopware.Store.get('id') or Shopware.State.get('name')
+func storeOfObject(object *tree_sitter.Node, content []byte) *StoreReference {
+	if object == nil || object.Kind() != "call_expression" {
+		return nil
+	}
+
+	first := firstArgumentString(object)
+	if first == nil {
+		return nil
+	}
+
+	storeReference := StoreReferenceAt(first, content)
+	if storeReference == nil || (storeReference.Kind != StoreReferenceID && storeReference.Kind != StoreReferenceModule) {
+		return nil
+	}
+
+	reference := &StoreReference{
+		Kind:      StoreReferenceMember,
+		Store:     storeReference.Name,
+		StoreKind: storeReference.StoreKind,
+	}
+
+	if storeReference.StoreKind == StoreKindPinia {
+		reference.MemberKinds = []StoreMemberKind{StoreMemberState, StoreMemberGetter, StoreMemberAction}
+	} else {
+		reference.MemberKinds = []StoreMemberKind{StoreMemberState}
+	}
+
+	return reference
+}
+
+// callName returns the name of the called function, the property for member calls (e.g. this.$store.commit -> commit)
+func callName(call *tree_sitter.Node, content []byte) string {
+	if call.Kind() != "call_expression" {
+		return ""
+	}
+
+	function := call.ChildByFieldName("function")
+	if function == nil {
+		return ""
+	}
+
+	switch function.Kind() {
+	case "identifier":
+		return string(function.Utf8Text(content))
+	case "member_expression":
+		if property := function.ChildByFieldName("property"); property != nil {
+			return string(property.Utf8Text(content))
+		}
+	}
+
+	return ""
+}
+
+// GetStoreMembers returns the members of the given kinds of a Pinia store or Vuex module and the file defining them
+func (idx *AdminComponentIndexer) GetStoreMembers(id string, kind AdminStoreKind, memberKinds ...StoreMemberKind) ([]StoreMember, string) {
+	stores, err := idx.GetStore(id)
+	if err != nil {
+		return nil, ""
+	}
+
+	for _, store := range stores {
+		if store.Kind != kind {
+			continue
+		}
+
+		if def := idx.GetStoreDefinition(store); def != nil {
+			return def.MembersOfKind(memberKinds...), def.FilePath
+		}
+	}
+
+	return nil, ""
+}
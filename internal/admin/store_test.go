@@ -0,0 +1,175 @@
+package admin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+func storeMemberNames(members []StoreMember) []string {
+	var names []string
+	for _, member := range members {
+		names = append(names, member.Name)
+	}
+	return names
+}
+
+func TestIndexStores(t *testing.T) {
+	indexer, err := NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	moduleRoot := filepath.Join(t.TempDir(), "src", "Resources", "app", "administration", "src", "module", "sw-product")
+	require.NoError(t, os.MkdirAll(filepath.Join(moduleRoot, "page"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleRoot, "page", "state.js"), nil, 0o644))
+
+	indexJSFiles(t, indexer, map[string]string{
+		membersAdminRoot + "/app/store/session.store.js": `
+const sessionStore = Shopware.Store.register({
+    id: 'session',
+
+    state: () => ({
+        currentUser: null,
+        languageId: '',
+    }),
+
+    getters: {
+        isAdmin(state) {},
+    },
+
+    actions: {
+        setCurrentUser(user) {},
+    },
+});
+`,
+		filepath.Join(moduleRoot, "index.js"): `
+import productState from './page/state';
+
+Shopware.State.registerModule('swProductDetail', productState);
+`,
+		filepath.Join(moduleRoot, "page", "state.js"): `
+export default {
+    namespaced: true,
+
+    state() {
+        return {
+            product: {},
+            loading: false,
+        };
+    },
+
+    mutations: {
+        setProduct(state, product) {},
+    },
+};
+`,
+	})
+
+	stores, err := indexer.GetStore("session")
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+	assert.Equal(t, StoreKindPinia, stores[0].Kind)
+	assert.Equal(t, 2, stores[0].Line)
+
+	def := indexer.GetStoreDefinition(stores[0])
+	require.NotNil(t, def)
+	assert.Equal(t, []string{"currentUser", "languageId"}, storeMemberNames(def.MembersOfKind(StoreMemberState)))
+	assert.Equal(t, []string{"isAdmin", "setCurrentUser"}, storeMemberNames(def.MembersOfKind(StoreMemberGetter, StoreMemberAction)))
+	assert.Equal(t, 6, def.MembersOfKind(StoreMemberState)[0].Line)
+
+	stores, err = indexer.GetStore("swProductDetail")
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+	assert.Equal(t, StoreKindVuex, stores[0].Kind)
+	assert.Equal(t, filepath.Join(moduleRoot, "page", "state.js"), stores[0].DefinitionPath)
+
+	def = indexer.GetStoreDefinition(stores[0])
+	require.NotNil(t, def)
+	assert.Equal(t, []string{"product", "loading"}, storeMemberNames(def.MembersOfKind(StoreMemberState)))
+	assert.Equal(t, []string{"setProduct"}, storeMemberNames(def.MembersOfKind(StoreMemberMutation)))
+}
+
+func TestStoreReferenceAt(t *testing.T) {
+	code := `
+const session = Shopware.Store.get('session');
+const user = Shopware.Store.get('session').currentUser;
+const product = Shopware.State.get('swProductDetail').product;
+Shopware.State.commit('swProductDetail/setProduct', {});
+
+export default {
+    computed: {
+        ...mapState('swProductDetail', ['loading']),
+        ...mapGetters('swProductDetail', { hasProduct: 'isLoaded' }),
+    },
+    methods: {
+        save() {
+            this.$store.dispatch('swProductDetail/save');
+            this.$emit('notAStore');
+        },
+    },
+};
+`
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+	root := tree.RootNode()
+
+	referenceAt := func(text string, skip int) *StoreReference {
+		offset := uint(strings.Index(code, text) + skip)
+		node := root.NamedDescendantForByteRange(offset, offset)
+		return StoreReferenceAt(node, []byte(code))
+	}
+
+	reference := referenceAt("'session'", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreReferenceID, reference.Kind)
+	assert.Equal(t, "session", reference.Name)
+
+	reference = referenceAt("currentUser", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreReferenceMember, reference.Kind)
+	assert.Equal(t, "session", reference.Store)
+	assert.Equal(t, []StoreMemberKind{StoreMemberState, StoreMemberGetter, StoreMemberAction}, reference.MemberKinds)
+
+	reference = referenceAt(").product", 2)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreKindVuex, reference.StoreKind)
+	assert.Equal(t, []StoreMemberKind{StoreMemberState}, reference.MemberKinds)
+
+	reference = referenceAt("swProductDetail/setProduct", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreReferencePath, reference.Kind)
+	assert.Equal(t, "swProductDetail", reference.Store)
+	assert.Equal(t, []StoreMemberKind{StoreMemberMutation}, reference.MemberKinds)
+
+	reference = referenceAt("swProductDetail', ['", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreReferenceModule, reference.Kind)
+
+	reference = referenceAt("loading'", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, StoreReferenceMember, reference.Kind)
+	assert.Equal(t, "swProductDetail", reference.Store)
+	assert.Equal(t, []StoreMemberKind{StoreMemberState}, reference.MemberKinds)
+
+	reference = referenceAt("isLoaded", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, []StoreMemberKind{StoreMemberGetter}, reference.MemberKinds)
+
+	reference = referenceAt("swProductDetail/save", 1)
+	require.NotNil(t, reference)
+	assert.Equal(t, []StoreMemberKind{StoreMemberAction}, reference.MemberKinds)
+
+	assert.Nil(t, referenceAt("notAStore", 1))
+	assert.Nil(t, referenceAt("hasProduct", 1))
+}
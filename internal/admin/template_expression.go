@@ -0,0 +1,423 @@
+package admin
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"strings"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+)
+
+var (
+	// vForRegex splits a v-for value into the alias part and the iterated expression
+	// e.g. "(item, index) in items" -> "(item, index)", "items"
+	vForRegex = regexp.MustCompile(`^(\s*.*?\s+)(?:in|of)\s+`)
+
+	// voidElements can not have children and never need a closing tag
+	voidElements = map[string]bool{
+		"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+		"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+	}
+
+	// expressionGlobals are globals Vue allows in template expressions, besides everything starting with $
+	expressionGlobals = map[string]bool{
+		"Math": true, "Number": true, "String": true, "Boolean": true, "Object": true, "Array": true,
+		"JSON": true, "Date": true, "RegExp": true, "Symbol": true, "Promise": true, "Intl": true,
+		"parseInt": true, "parseFloat": true, "isNaN": true, "isFinite": true, "encodeURIComponent": true,
+		"decodeURIComponent": true, "console": true, "window": true, "document": true, "Shopware": true,
+		"arguments": true, "NaN": true, "Infinity": true,
+	}
+
+	// expressionReferencePattern matches the identifiers of an expression which are looked up on the component
+	expressionReferencePattern = treesitterhelper.AnyNodeKind("identifier", "shorthand_property_identifier")
+
+	// expressionFunctionPattern matches the functions of an expression declaring local parameters
+	expressionFunctionPattern = treesitterhelper.AnyNodeKind("arrow_function", "function_expression")
+)
+
+// TemplateExpression is a Vue expression in an administration template
+type TemplateExpression struct {
+	// Text is the expression source
+	Text string
+
+	// Offset is the byte offset of the expression in the template
+	Offset int
+
+	// Attribute is the directive holding the expression (e.g., "v-if", ":label", "@click"), empty for {{ }}
+	Attribute string
+
+	// Scope contains the v-for and slot scope variables visible in the expression
+	Scope []string
+}
+
+// ExpressionReference is an identifier in a template expression that is resolved on the component
+type ExpressionReference struct {
+	// Name is the identifier
+	Name string
+
+	// Offset is the byte offset of the identifier in the expression text
+	Offset int
+}
+
+// ExpressionTree is a template expression parsed with the JavaScript grammar
+type ExpressionTree struct {
+	tree *tree_sitter.Tree
+
+	// Source is the parsed code, an object literal is wrapped in parentheses to not be read as a block
+	Source []byte
+
+	// offset is the template offset of the start of Source
+	offset int
+}
+
+// Parse parses the expression with the JavaScript grammar, the tree has to be closed by the caller
+func (e TemplateExpression) Parse() *ExpressionTree {
+	source := []byte(e.Text)
+	offset := e.Offset
+
+	if strings.HasPrefix(strings.TrimSpace(e.Text), "{") {
+		source = []byte("(" + e.Text + ")")
+		offset--
+	}
+
+	return &ExpressionTree{tree: parseJavaScript(source), Source: source, offset: offset}
+}
+
+// RootNode returns the program node of the expression
+func (t *ExpressionTree) RootNode() *tree_sitter.Node {
+	return t.tree.RootNode()
+}
+
+// TemplateOffset converts a byte offset of Source to the byte offset in the template
+func (t *ExpressionTree) TemplateOffset(offset uint) int {
+	return t.offset + int(offset)
+}
+
+// Close releases the parsed tree
+func (t *ExpressionTree) Close() {
+	t.tree.Close()
+}
+
+// ParseTemplateExpressions returns all Vue expressions of an administration template
+func ParseTemplateExpressions(root *tree_sitter.Node, content []byte) []TemplateExpression {
+	root, release := withSlotShorthands(root, content)
+	defer release()
+
+	collector := &expressionCollector{content: content}
+	collector.visit(root)
+
+	return collector.expressions
+}
+
+// TemplateExpressionAt returns the expression containing the given offset of a template, cut at the offset.
+// It is used for completion.
+func TemplateExpressionAt(root *tree_sitter.Node, content []byte, offset int) (TemplateExpression, bool) {
+	for _, expression := range ParseTemplateExpressions(root, content) {
+		if expression.Offset <= offset && offset <= expression.Offset+len(expression.Text) {
+			expression.Text = expression.Text[:offset-expression.Offset]
+			return expression, true
+		}
+	}
+
+	return TemplateExpression{}, false
+}
+
+// IsDirectiveAttribute checks if an attribute value is a JavaScript expression
+func IsDirectiveAttribute(name string) bool {
+	if name == "v-slot" || strings.HasPrefix(name, "v-slot:") {
+		return false
+	}
+
+	return strings.HasPrefix(name, ":") || strings.HasPrefix(name, "@") || strings.HasPrefix(name, "v-")
+}
+
+// ExpressionReferences returns the identifiers of an expression that are looked up on the component.
+// Member accesses, object keys, string contents, keywords, globals, $-prefixed properties and
+// function parameters are skipped.
+func ExpressionReferences(expression string) []ExpressionReference {
+	tree := TemplateExpression{Text: expression}.Parse()
+	defer tree.Close()
+
+	locals := make(map[string]bool)
+	for _, function := range treesitterhelper.FindAll(tree.RootNode(), expressionFunctionPattern, tree.Source) {
+		parameters := function.ChildByFieldName("parameters")
+		if parameters == nil {
+			parameters = function.ChildByFieldName("parameter")
+		}
+
+		for _, name := range patternNames(parameters, tree.Source) {
+			locals[name] = true
+		}
+	}
+
+	var references []ExpressionReference
+	for _, node := range treesitterhelper.FindAll(tree.RootNode(), expressionReferencePattern, tree.Source) {
+		name := node.Utf8Text(tree.Source)
+		if strings.HasPrefix(name, "$") || expressionGlobals[name] || locals[name] {
+			continue
+		}
+
+		references = append(references, ExpressionReference{Name: name, Offset: tree.TemplateOffset(node.StartByte())})
+	}
+
+	return references
+}
+
+// templateElement is an open element while walking a template
+type templateElement struct {
+	name      string
+	variables []string
+}
+
+// templateAttribute is an attribute of a start tag
+type templateAttribute struct {
+	name     string
+	value    string
+	offset   int
+	hasValue bool
+}
+
+// expressionCollector walks a template tree in document order and keeps the open elements
+// to know the v-for and slot scope variables of every expression
+type expressionCollector struct {
+	content     []byte
+	stack       []templateElement
+	expressions []TemplateExpression
+}
+
+func (c *expressionCollector) visit(node *tree_sitter.Node) {
+	var end uint
+
+	switch node.Kind() {
+	case "html_start_tag":
+		c.startTag(node)
+		return
+	case "html_end_tag":
+		name := GetTagNameFromEndTag(node, c.content)
+		for i := len(c.stack) - 1; i >= 0; i-- {
+			if c.stack[i].name == name {
+				c.stack = c.stack[:i]
+				break
+			}
+		}
+		return
+	case "output":
+		end = node.EndByte()
+		if strings.HasSuffix(node.Utf8Text(c.content), "}}") {
+			end -= 2
+		}
+		c.interpolation(node.StartByte()+2, end)
+		return
+	case "ERROR":
+		// JavaScript syntax Twig doesn't know, like a?.b or ===, turns the {{ of an interpolation into
+		// an error and the rest of it into text
+		if strings.HasPrefix(node.Utf8Text(c.content), "{{") {
+			start := node.StartByte() + 2
+			end = uint(len(c.content))
+			if index := bytes.Index(c.content[start:], []byte("}}")); index != -1 {
+				end = start + uint(index)
+			}
+			c.interpolation(start, end)
+		}
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if child := node.NamedChild(i); child.StartByte() >= end {
+			c.visit(child)
+		}
+	}
+}
+
+// interpolation adds the {{ }} expression between the given offsets
+func (c *expressionCollector) interpolation(start, end uint) {
+	if end < start {
+		end = start
+	}
+
+	c.expressions = append(c.expressions, TemplateExpression{
+		Text:   string(c.content[start:end]),
+		Offset: int(start),
+		Scope:  c.scope(),
+	})
+}
+
+// startTag adds the directive expressions of a start tag and opens its element
+func (c *expressionCollector) startTag(node *tree_sitter.Node) {
+	var attributes []templateAttribute
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		nameNode := child.ChildByFieldName("name")
+		if child.Kind() != "html_attribute" || nameNode == nil {
+			continue
+		}
+
+		attribute := templateAttribute{name: nameNode.Utf8Text(c.content)}
+		if valueNode := child.ChildByFieldName("value"); valueNode != nil {
+			value := valueNode.Utf8Text(c.content)
+			attribute.hasValue = true
+			attribute.offset = int(valueNode.StartByte())
+			if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+				value = value[1 : len(value)-1]
+				attribute.offset++
+			}
+			attribute.value = value
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	element := templateElement{name: GetTagNameFromStartTag(node, c.content)}
+	for _, attribute := range attributes {
+		element.variables = append(element.variables, attributeVariables(attribute)...)
+	}
+
+	tagScope := append(c.scope(), element.variables...)
+	for _, attribute := range attributes {
+		if IsDirectiveAttribute(attribute.name) && attribute.hasValue {
+			c.expressions = append(c.expressions, directiveExpression(attribute, tagScope))
+		}
+	}
+
+	if strings.HasSuffix(node.Utf8Text(c.content), "/>") || voidElements[strings.ToLower(element.name)] {
+		return
+	}
+
+	c.stack = append(c.stack, element)
+}
+
+// scope returns the variables of all open elements
+func (c *expressionCollector) scope() []string {
+	var variables []string
+	for _, element := range c.stack {
+		variables = append(variables, element.variables...)
+	}
+
+	return variables
+}
+
+// attributeVariables returns the v-for and slot scope variables declared by an attribute
+func attributeVariables(attribute templateAttribute) []string {
+	switch name := attribute.name; {
+	case name == "v-for":
+		if match := vForRegex.FindStringSubmatch(attribute.value); match != nil {
+			return patternVariables(match[1])
+		}
+	case strings.HasPrefix(name, "#"), name == "v-slot", strings.HasPrefix(name, "v-slot:"), name == "slot-scope":
+		return patternVariables(attribute.value)
+	}
+
+	return nil
+}
+
+// directiveExpression creates the expression of a directive, for v-for only the iterated part is an expression
+func directiveExpression(attribute templateAttribute, scope []string) TemplateExpression {
+	expression := TemplateExpression{Text: attribute.value, Offset: attribute.offset, Attribute: attribute.name, Scope: slices.Clone(scope)}
+
+	if attribute.name == "v-for" {
+		if match := vForRegex.FindStringSubmatch(attribute.value); match != nil {
+			expression.Text = attribute.value[len(match[0]):]
+			expression.Offset += len(match[0])
+		}
+	}
+
+	return expression
+}
+
+// patternVariables returns the variable names of a parameter or destructuring pattern
+// e.g. "(item, index)" -> item, index; "{ row, column: col, page = 1 }" -> row, col, page
+func patternVariables(pattern string) []string {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return nil
+	}
+	if !strings.HasPrefix(pattern, "(") {
+		pattern = "(" + pattern + ")"
+	}
+
+	source := []byte(pattern + " => 0")
+	tree := parseJavaScript(source)
+	defer tree.Close()
+
+	function := treesitterhelper.FindFirst(tree.RootNode(), treesitterhelper.NodeKind("arrow_function"), source)
+	if function == nil {
+		return nil
+	}
+
+	return patternNames(function.ChildByFieldName("parameters"), source)
+}
+
+// patternNames returns the names bound by a parameter list or destructuring pattern, default values are skipped
+func patternNames(node *tree_sitter.Node, content []byte) []string {
+	if node == nil {
+		return nil
+	}
+
+	switch node.Kind() {
+	case "identifier", "shorthand_property_identifier_pattern":
+		return []string{node.Utf8Text(content)}
+	case "assignment_pattern", "object_assignment_pattern":
+		return patternNames(node.ChildByFieldName("left"), content)
+	case "pair_pattern":
+		return patternNames(node.ChildByFieldName("value"), content)
+	}
+
+	var names []string
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		names = append(names, patternNames(node.NamedChild(i), content)...)
+	}
+
+	return names
+}
+
+// withSlotShorthands works around the Twig grammar reading a slot shorthand like #default="{ row }" as an
+// inline comment up to the end of the line. The shorthands are turned into bound attributes of the same
+// length and the template is parsed again, so all offsets stay the same and the names are read from content.
+func withSlotShorthands(root *tree_sitter.Node, content []byte) (*tree_sitter.Node, func()) {
+	shorthands := slotShorthandComments(root, content)
+	if len(shorthands) == 0 {
+		return root, func() {}
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language()))
+
+	patched := slices.Clone(content)
+	var tree *tree_sitter.Tree
+
+	for len(shorthands) > 0 {
+		for _, comment := range shorthands {
+			patched[comment.StartByte()] = ':'
+		}
+
+		if tree != nil {
+			tree.Close()
+		}
+		tree = parser.Parse(patched, nil)
+		shorthands = slotShorthandComments(tree.RootNode(), patched)
+	}
+
+	return tree.RootNode(), tree.Close
+}
+
+// slotShorthandComments returns the inline comments of start tags which are slot shorthands
+func slotShorthandComments(root *tree_sitter.Node, content []byte) []*tree_sitter.Node {
+	return treesitterhelper.FindAll(root, treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		return node.Kind() == "inline_comment" && node.Parent() != nil && node.Parent().Kind() == "html_start_tag" &&
+			strings.HasPrefix(node.Utf8Text(content), "#")
+	}), content)
+}
+
+// parseJavaScript parses code with the JavaScript grammar, the tree has to be closed by the caller
+func parseJavaScript(source []byte) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language()))
+
+	return parser.Parse(source, nil)
+}
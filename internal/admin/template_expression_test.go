@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseTwig(t *testing.T, code string) *tree_sitter.Node {
+	parser := tree_sitter.NewParser()
+	t.Cleanup(func() { parser.Close() })
+
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language())))
+
+	tree := parser.Parse([]byte(code), nil)
+	t.Cleanup(func() { tree.Close() })
+
+	return tree.RootNode()
+}
+
+func TestParseTemplateExpressions(t *testing.T) {
+	template := `{% block sw_product_list %}
+<sw-card v-if="isLoading && product.name" :title="$tc('sw-product.title')" class="card">
+    <div v-for="(item, index) in items" :key="item.id" @click="onSelect(item)">
+        {{ item.label }} {{ total }}
+        <template #default="{ row, column: col }">{{ row }}</template>
+        <input v-model="searchTerm">
+    </div>
+    {{ afterLoop?.value }}
+</sw-card>
+{% endblock %}`
+
+	expressions := ParseTemplateExpressions(parseTwig(t, template), []byte(template))
+	require.Len(t, expressions, 10)
+
+	byText := make(map[string]TemplateExpression)
+	for _, expression := range expressions {
+		byText[strings.TrimSpace(expression.Text)] = expression
+		assert.Equal(t, expression.Text, template[expression.Offset:expression.Offset+len(expression.Text)])
+	}
+
+	assert.Equal(t, "v-if", byText["isLoading && product.name"].Attribute)
+	assert.Empty(t, byText["isLoading && product.name"].Scope)
+
+	assert.Equal(t, "v-for", byText["items"].Attribute)
+	assert.Equal(t, []string{"item", "index"}, byText["item.id"].Scope, "v-for variables are visible in the own tag")
+	assert.Equal(t, []string{"item", "index"}, byText["item.label"].Scope)
+	assert.Equal(t, []string{"item", "index", "row", "col"}, byText["row"].Scope)
+	assert.Equal(t, []string{"item", "index"}, byText["searchTerm"].Scope, "void elements don't open a scope")
+	assert.Empty(t, byText["afterLoop?.value"].Scope, "JavaScript only syntax is read as well")
+}
+
+func TestTemplateExpressionAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  string
+		cursor    string
+		text      string
+		attribute string
+		scope     []string
+		found     bool
+	}{
+		{
+			name:     "interpolation",
+			template: "<div v-for=\"item in items\">{{ item.name + to }}</div>",
+			cursor:   "to }}",
+			text:     " item.name + ",
+			scope:    []string{"item"},
+			found:    true,
+		},
+		{
+			name:      "bound attribute",
+			template:  "<sw-button v-for=\"entry in entries\" :disabled=\"isLo\"></sw-button>",
+			cursor:    "\"></sw-button>",
+			text:      "isLo",
+			attribute: ":disabled",
+			scope:     []string{"entry"},
+			found:     true,
+		},
+		{
+			name:      "event handler",
+			template:  "<sw-button @click=\"on\"></sw-button>",
+			cursor:    "\"></sw-button>",
+			text:      "on",
+			attribute: "@click",
+			found:     true,
+		},
+		{
+			name:     "unfinished member access",
+			template: "<p>{{ product. }}</p>",
+			cursor:   " }}",
+			text:     " product.",
+			found:    true,
+		},
+		{
+			name:     "static attribute",
+			template: "<sw-button label=\"on\"></sw-button>",
+			cursor:   "\"></sw-button>",
+		},
+		{
+			name:     "closed interpolation",
+			template: "{{ total }} text",
+			cursor:   " text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := strings.Index(tt.template, tt.cursor)
+			expression, found := TemplateExpressionAt(parseTwig(t, tt.template), []byte(tt.template), offset)
+			assert.Equal(t, tt.found, found)
+			if !tt.found {
+				return
+			}
+			assert.Equal(t, tt.text, expression.Text)
+			assert.Equal(t, tt.attribute, expression.Attribute)
+			assert.Equal(t, tt.scope, expression.Scope)
+		})
+	}
+}
+
+func TestExpressionReferences(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   []string
+	}{
+		{"isLoading && product.name", []string{"isLoading", "product"}},
+		{"$tc('sw-product.title', total)", []string{"total"}},
+		{"{ 'is-active': active, disabled: isDisabled }", []string{"active", "isDisabled"}},
+		{"enabled ? primary : secondary", []string{"enabled", "primary", "secondary"}},
+		{"(value) => onChange(value, 10.5)", []string{"onChange"}},
+		{"items.filter(item => item.active).length > 0", []string{"items"}},
+		{"`${prefix}-label` + suffix", []string{"prefix", "suffix"}},
+		{"rows.map(({ id, name: label = fallback }) => id + label)", []string{"rows", "fallback"}},
+		{"Math.max(count, 1) === null", []string{"count"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			var names []string
+			for _, reference := range ExpressionReferences(tt.expression) {
+				names = append(names, reference.Name)
+				assert.Equal(t, reference.Name, tt.expression[reference.Offset:reference.Offset+len(reference.Name)])
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
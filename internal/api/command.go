@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+)
+
+type ApiCommandProvider struct {
+	schemaIndex *ApiSchemaIndexer
+	routeIndex  *symfony.RouteIndexer
+}
+
+func NewApiCommandProvider(lsp *lsp.Server) *ApiCommandProvider {
+	schemaIndex, _ := lsp.GetIndexer("api.schema")
+	routeIndex, _ := lsp.GetIndexer("symfony.route")
+
+	return &ApiCommandProvider{
+		schemaIndex: schemaIndex.(*ApiSchemaIndexer),
+		routeIndex:  routeIndex.(*symfony.RouteIndexer),
+	}
+}
+
+func (a *ApiCommandProvider) GetCommands(ctx context.Context) map[string]lsp.CommandFunc {
+	return map[string]lsp.CommandFunc{
+		"shopware/api/routes": a.allRoutes,
+	}
+}
+
+type apiRoute struct {
+	Name       string         `json:"name"`
+	Path       string         `json:"path"`
+	Methods    []string       `json:"methods"`
+	Scope      string         `json:"scope"`
+	Controller string         `json:"controller"`
+	File       string         `json:"file"`
+	Line       int            `json:"line"`
+	HasSchema  bool           `json:"hasSchema"`
+	Operations []apiOperation `json:"operations"`
+}
+
+type apiOperation struct {
+	Method      string `json:"method"`
+	OperationID string `json:"operationId,omitempty"`
+	SchemaFile  string `json:"schemaFile"`
+	Line        int    `json:"line"`
+}
+
+func (a *ApiCommandProvider) allRoutes(ctx context.Context, args *json.RawMessage) (interface{}, error) {
+	routes, err := a.routeIndex.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []apiRoute{}
+
+	for _, route := range routes {
+		scope := ScopeOfRoute(route)
+		if scope == "" {
+			continue
+		}
+
+		item := apiRoute{
+			Name:       route.Name,
+			Path:       route.Path,
+			Methods:    route.Methods,
+			Scope:      scope,
+			Controller: route.Controller,
+			File:       route.FilePath,
+			Line:       route.Line,
+			Operations: []apiOperation{},
+		}
+
+		for _, operation := range a.schemaIndex.GetOperationsForRoute(route) {
+			item.Operations = append(item.Operations, apiOperation{
+				Method:      operation.Method,
+				OperationID: operation.OperationID,
+				SchemaFile:  operation.FilePath,
+				Line:        operation.Line,
+			})
+		}
+		item.HasSchema = len(item.Operations) > 0
+
+		result = append(result, item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Scope != result[j].Scope {
+			return result[i].Scope < result[j].Scope
+		}
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/indexer"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// ApiSchemaIndexer indexes the OpenAPI schema files of the Store API and Admin API
+type ApiSchemaIndexer struct {
+	dataIndexer *indexer.DataIndexer[ApiOperation]
+}
+
+func NewApiSchemaIndexer(configDir string) (*ApiSchemaIndexer, error) {
+	dataIndexer, err := indexer.NewDataIndexer[ApiOperation](filepath.Join(configDir, "api_schema.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiSchemaIndexer{
+		dataIndexer: dataIndexer,
+	}, nil
+}
+
+func (idx *ApiSchemaIndexer) ID() string {
+	return "api.schema"
+}
+
+func (idx *ApiSchemaIndexer) Index(path string, node *tree_sitter.Node, fileContent []byte) error {
+	if filepath.Ext(path) != ".json" || scopeOfSchemaFile(path) == "" {
+		return nil
+	}
+
+	batchSave := make(map[string]map[string]ApiOperation)
+
+	for _, operation := range ParseApiSchema(node, fileContent, path) {
+		if _, ok := batchSave[path]; !ok {
+			batchSave[path] = make(map[string]ApiOperation)
+		}
+		batchSave[path][operationKey(operation.Scope, operation.Method, operation.Path)] = operation
+	}
+
+	return idx.dataIndexer.BatchSaveItems(batchSave)
+}
+
+func (idx *ApiSchemaIndexer) RemovedFiles(paths []string) error {
+	return idx.dataIndexer.BatchDeleteByFilePaths(paths)
+}
+
+func (idx *ApiSchemaIndexer) Close() error {
+	return idx.dataIndexer.Close()
+}
+
+func (idx *ApiSchemaIndexer) Clear() error {
+	return idx.dataIndexer.Clear()
+}
+
+// GetAllOperations returns all indexed schema operations
+func (idx *ApiSchemaIndexer) GetAllOperations() ([]ApiOperation, error) {
+	return idx.dataIndexer.GetAllValues()
+}
+
+// GetOperation returns the schema operations documenting the given scope, method and schema path
+func (idx *ApiSchemaIndexer) GetOperation(scope, method, path string) ([]ApiOperation, error) {
+	return idx.dataIndexer.GetValues(operationKey(scope, method, path))
+}
+
+// GetOperationsForRoute returns the schema operations documenting the given PHP route
+func (idx *ApiSchemaIndexer) GetOperationsForRoute(route symfony.Route) []ApiOperation {
+	scope := ScopeOfRoute(route)
+	if scope == "" {
+		return nil
+	}
+
+	schemaPath := SchemaPathOfRoute(route, scope)
+
+	methods := route.Methods
+	if len(methods) == 0 {
+		methods = httpMethods
+	}
+
+	var operations []ApiOperation
+	for _, method := range methods {
+		found, err := idx.GetOperation(scope, method, schemaPath)
+		if err != nil {
+			continue
+		}
+		operations = append(operations, found...)
+	}
+
+	return operations
+}
+
+// ScopeOfRoute returns the API scope of a route, or an empty string for non API routes
+func ScopeOfRoute(route symfony.Route) string {
+	if slices.Contains(route.Scopes, ScopeStoreApi) {
+		return ScopeStoreApi
+	}
+
+	if slices.Contains(route.Scopes, ScopeAdminApi) {
+		return ScopeAdminApi
+	}
+
+	// Routes without a declared scope are recognized by their prefix
+	if len(route.Scopes) == 0 {
+		if strings.HasPrefix(route.Path, "/store-api/") {
+			return ScopeStoreApi
+		}
+
+		if strings.HasPrefix(route.Path, "/api/") {
+			return ScopeAdminApi
+		}
+	}
+
+	return ""
+}
+
+// SchemaPathOfRoute returns the path of the route as used in the schema files, which omit the API prefix
+func SchemaPathOfRoute(route symfony.Route, scope string) string {
+	switch scope {
+	case ScopeStoreApi:
+		return strings.TrimPrefix(route.Path, "/store-api")
+	case ScopeAdminApi:
+		return strings.TrimPrefix(route.Path, "/api")
+	default:
+		return route.Path
+	}
+}
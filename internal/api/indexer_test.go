@@ -0,0 +1,94 @@
+package api
+
+import (
+	"os"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/symfony"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+func parseJSONFile(t *testing.T, filePath string) (*tree_sitter.Tree, []byte) {
+	bytes, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language())))
+	defer parser.Close()
+
+	tree := parser.Parse(bytes, nil)
+	require.NotNil(t, tree)
+
+	return tree, bytes
+}
+
+func TestParseApiSchema(t *testing.T) {
+	filePath := "testdata/Resources/Schema/StoreApi/customer.json"
+	tree, bytes := parseJSONFile(t, filePath)
+	defer tree.Close()
+
+	operations := ParseApiSchema(tree.RootNode(), bytes, filePath)
+	require.Len(t, operations, 1)
+
+	operation := operations[0]
+	assert.Equal(t, ScopeStoreApi, operation.Scope)
+	assert.Equal(t, "/account/customer", operation.Path)
+	assert.Equal(t, "POST", operation.Method)
+	assert.Equal(t, "readCustomer", operation.OperationID)
+	assert.Equal(t, "Get information about current customer", operation.Summary)
+	assert.Contains(t, operation.RequestSchema, "#/components/schemas/Criteria")
+	assert.Contains(t, operation.ResponseSchema, "#/components/schemas/Customer")
+	assert.Equal(t, 6, operation.Line)
+}
+
+func TestParseApiSchemaIgnoresOtherFiles(t *testing.T) {
+	filePath := "testdata/Resources/Schema/StoreApi/customer.json"
+	tree, bytes := parseJSONFile(t, filePath)
+	defer tree.Close()
+
+	assert.Empty(t, ParseApiSchema(tree.RootNode(), bytes, "testdata/Resources/snippet/customer.json"))
+}
+
+func TestApiSchemaIndexerRouteCorrelation(t *testing.T) {
+	idx, err := NewApiSchemaIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	filePath := "testdata/Resources/Schema/StoreApi/customer.json"
+	tree, bytes := parseJSONFile(t, filePath)
+	defer tree.Close()
+
+	require.NoError(t, idx.Index(filePath, tree.RootNode(), bytes))
+
+	documented := symfony.Route{
+		Name:    "store-api.account.customer",
+		Path:    "/store-api/account/customer",
+		Methods: []string{"GET", "POST"},
+		Scopes:  []string{"store-api"},
+	}
+	operations := idx.GetOperationsForRoute(documented)
+	require.Len(t, operations, 1)
+	assert.Equal(t, "readCustomer", operations[0].OperationID)
+
+	undocumented := symfony.Route{
+		Name:    "store-api.account.logout",
+		Path:    "/store-api/account/logout",
+		Methods: []string{"POST"},
+		Scopes:  []string{"store-api"},
+	}
+	assert.Empty(t, idx.GetOperationsForRoute(undocumented))
+
+	storefront := symfony.Route{
+		Name:   "frontend.account.home.page",
+		Path:   "/account",
+		Scopes: []string{"storefront"},
+	}
+	assert.Equal(t, "", ScopeOfRoute(storefront))
+	assert.Empty(t, idx.GetOperationsForRoute(storefront))
+
+	require.NoError(t, idx.RemovedFiles([]string{filePath}))
+	assert.Empty(t, idx.GetOperationsForRoute(documented))
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+const (
+	// ScopeStoreApi is the route scope of the Store API
+	ScopeStoreApi = "store-api"
+	// ScopeAdminApi is the route scope of the Admin API
+	ScopeAdminApi = "api"
+)
+
+// ApiOperation represents a single operation of an OpenAPI schema file
+type ApiOperation struct {
+	Scope          string
+	Path           string
+	Method         string
+	OperationID    string
+	Summary        string
+	Description    string
+	RequestSchema  string
+	ResponseSchema string
+	FilePath       string
+	Line           int
+}
+
+var httpMethods = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+
+// operationKey builds the index key of an operation like "store-api POST /account/customer"
+func operationKey(scope, method, path string) string {
+	return scope + " " + strings.ToUpper(method) + " " + path
+}
+
+// scopeOfSchemaFile returns the route scope the schema file documents
+func scopeOfSchemaFile(path string) string {
+	switch {
+	case strings.Contains(path, "/Schema/StoreApi/"):
+		return ScopeStoreApi
+	case strings.Contains(path, "/Schema/AdminApi/"):
+		return ScopeAdminApi
+	default:
+		return ""
+	}
+}
+
+// ParseApiSchema parses the paths section of an OpenAPI schema file
+func ParseApiSchema(root *tree_sitter.Node, content []byte, filePath string) []ApiOperation {
+	scope := scopeOfSchemaFile(filePath)
+	if scope == "" {
+		return nil
+	}
+
+	if root.Kind() == "document" && root.NamedChildCount() > 0 {
+		root = root.NamedChild(0)
+	}
+
+	if root.Kind() != "object" {
+		return nil
+	}
+
+	pathsNode := findPairValue(root, content, "paths")
+	if pathsNode == nil || pathsNode.Kind() != "object" {
+		return nil
+	}
+
+	var operations []ApiOperation
+
+	for i := 0; i < int(pathsNode.NamedChildCount()); i++ {
+		pathPair := pathsNode.NamedChild(uint(i))
+		if pathPair.Kind() != "pair" {
+			continue
+		}
+
+		pathValue := pathPair.ChildByFieldName("value")
+		if pathValue == nil || pathValue.Kind() != "object" {
+			continue
+		}
+
+		routePath := stringValue(pathPair.ChildByFieldName("key"), content)
+
+		for j := 0; j < int(pathValue.NamedChildCount()); j++ {
+			methodPair := pathValue.NamedChild(uint(j))
+			if methodPair.Kind() != "pair" {
+				continue
+			}
+
+			method := stringValue(methodPair.ChildByFieldName("key"), content)
+			if !slices.Contains(httpMethods, strings.ToLower(method)) {
+				continue
+			}
+
+			operationNode := methodPair.ChildByFieldName("value")
+			if operationNode == nil || operationNode.Kind() != "object" {
+				continue
+			}
+
+			operation := ApiOperation{
+				Scope:       scope,
+				Path:        routePath,
+				Method:      strings.ToUpper(method),
+				OperationID: stringValue(findPairValue(operationNode, content, "operationId"), content),
+				Summary:     stringValue(findPairValue(operationNode, content, "summary"), content),
+				Description: stringValue(findPairValue(operationNode, content, "description"), content),
+				FilePath:    filePath,
+				Line:        int(methodPair.StartPosition().Row) + 1,
+			}
+
+			if requestBody := findPairValue(operationNode, content, "requestBody"); requestBody != nil {
+				operation.RequestSchema = extractContentSchema(requestBody, content)
+			}
+
+			if responses := findPairValue(operationNode, content, "responses"); responses != nil {
+				operation.ResponseSchema = extractSuccessResponseSchema(responses, content)
+			}
+
+			operations = append(operations, operation)
+		}
+	}
+
+	return operations
+}
+
+// extractSuccessResponseSchema returns the schema of the first 2xx response
+func extractSuccessResponseSchema(responses *tree_sitter.Node, content []byte) string {
+	var statusCodes []string
+	byStatusCode := make(map[string]*tree_sitter.Node)
+
+	for i := 0; i < int(responses.NamedChildCount()); i++ {
+		pair := responses.NamedChild(uint(i))
+		if pair.Kind() != "pair" {
+			continue
+		}
+
+		statusCode := stringValue(pair.ChildByFieldName("key"), content)
+		if strings.HasPrefix(statusCode, "2") {
+			statusCodes = append(statusCodes, statusCode)
+			byStatusCode[statusCode] = pair.ChildByFieldName("value")
+		}
+	}
+
+	sort.Strings(statusCodes)
+
+	for _, statusCode := range statusCodes {
+		if schema := extractContentSchema(byStatusCode[statusCode], content); schema != "" {
+			return schema
+		}
+	}
+
+	return ""
+}
+
+// extractContentSchema returns the raw JSON of content.<media-type>.schema, preferring application/json
+func extractContentSchema(node *tree_sitter.Node, content []byte) string {
+	if node == nil || node.Kind() != "object" {
+		return ""
+	}
+
+	contentNode := findPairValue(node, content, "content")
+	if contentNode == nil || contentNode.Kind() != "object" {
+		return ""
+	}
+
+	mediaType := findPairValue(contentNode, content, "application/json")
+	if mediaType == nil {
+		for i := 0; i < int(contentNode.NamedChildCount()); i++ {
+			pair := contentNode.NamedChild(uint(i))
+			if pair.Kind() == "pair" {
+				mediaType = pair.ChildByFieldName("value")
+				break
+			}
+		}
+	}
+
+	if mediaType == nil || mediaType.Kind() != "object" {
+		return ""
+	}
+
+	schema := findPairValue(mediaType, content, "schema")
+	if schema == nil {
+		return ""
+	}
+
+	return string(schema.Utf8Text(content))
+}
+
+// findPairValue returns the value node of the given key in a JSON object
+func findPairValue(object *tree_sitter.Node, content []byte, key string) *tree_sitter.Node {
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		pair := object.NamedChild(uint(i))
+		if pair.Kind() != "pair" {
+			continue
+		}
+
+		if stringValue(pair.ChildByFieldName("key"), content) == key {
+			return pair.ChildByFieldName("value")
+		}
+	}
+
+	return nil
+}
+
+// stringValue returns the unquoted value of a JSON string node
+func stringValue(node *tree_sitter.Node, content []byte) string {
+	if node == nil || node.Kind() != "string" {
+		return ""
+	}
+
+	return strings.Trim(string(node.Utf8Text(content)), "\"")
+}
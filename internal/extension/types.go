@@ -19,6 +19,11 @@ type ShopwareExtension struct {
 }
 
 func (e ShopwareExtension) GetStorefrontViewsPath() string {
+	return filepath.Join(e.GetResourcesPath(), "views")
+}
+
+// GetResourcesPath returns the Resources directory of the bundle or app
+func (e ShopwareExtension) GetResourcesPath() string {
 	path := strings.TrimSuffix(e.Path, string(filepath.Separator)+e.Name+".php")
-	return filepath.Join(path, "Resources", "views")
+	return filepath.Join(path, "Resources")
 }
@@ -660,6 +660,14 @@ func (fs *FileScanner) IndexFiles(ctx context.Context, files []string) error {
 		log.Printf("Error processing file: %v", err)
 	}
 
+	for _, indexer := range fs.indexer {
+		if refresher, ok := indexer.(Refresher); ok {
+			if err := refresher.Refresh(); err != nil {
+				log.Printf("Error refreshing %s: %v", indexer.ID(), err)
+			}
+		}
+	}
+
 	if fs.onUpdate != nil {
 		fs.onUpdate()
 	}
@@ -106,6 +106,29 @@ func TestFileScanner_IndexAll_SkipDirsAtAnyDepth(t *testing.T) {
 	assert.False(t, mockIndexer.indexedFiles[filepath.Join(tempDir, "nested", "node_modules", "file.php")], "Excluded file was indexed")
 }
 
+func TestFileScanner_IndexAll_Refresh(t *testing.T) {
+	tempDir := t.TempDir()
+
+	createTestFiles(t, tempDir)
+
+	refreshing := &refreshingIndexer{mockIndexer: mockIndexer{indexedFiles: make(map[string]bool)}}
+
+	fs, err := NewFileScanner(tempDir, filepath.Join(tempDir, "test.db"))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, fs.Close())
+	}()
+
+	fs.AddIndexer(refreshing)
+
+	require.NoError(t, fs.IndexAll(context.Background()))
+	assert.Equal(t, 1, refreshing.refreshes)
+
+	// Indexers reading untracked files are refreshed even if no tracked file changed
+	require.NoError(t, fs.IndexAll(context.Background()))
+	assert.Equal(t, 2, refreshing.refreshes)
+}
+
 // Helper function to create test files
 func createTestFiles(t *testing.T, baseDir string) {
 	// Create directories and files for testing
@@ -161,3 +184,14 @@ func (m *mockIndexer) Close() error {
 func (m *mockIndexer) Clear() error {
 	return nil
 }
+
+// Mock indexer reading untracked files
+type refreshingIndexer struct {
+	mockIndexer
+	refreshes int
+}
+
+func (m *refreshingIndexer) Refresh() error {
+	m.refreshes++
+	return nil
+}
@@ -9,3 +9,9 @@ type Indexer interface {
 	Close() error
 	Clear() error
 }
+
+// Refresher is implemented by indexers which read files the file scanner does not track, like packages in
+// node_modules. Refresh is called after every indexing run to pick up changes of those files.
+type Refresher interface {
+	Refresh() error
+}
@@ -9,6 +9,7 @@ import (
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
 	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
 	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
 )
 
 var scannedFileTypes = []string{
@@ -21,6 +22,8 @@ var scannedFileTypes = []string{
 	".scss",
 	".js",
 	".ts",
+	".tsx",
+	".mts",
 }
 
 func CreateTreesitterParsers() map[string]*tree_sitter.Parser {
@@ -66,8 +69,15 @@ func CreateTreesitterParsers() map[string]*tree_sitter.Parser {
 		panic(err)
 	}
 
-	parsers[".ts"] = tree_sitter.NewParser()
-	if err := parsers[".ts"].SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())); err != nil {
+	for _, ext := range []string{".ts", ".mts"} {
+		parsers[ext] = tree_sitter.NewParser()
+		if err := parsers[ext].SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript())); err != nil {
+			panic(err)
+		}
+	}
+
+	parsers[".tsx"] = tree_sitter.NewParser()
+	if err := parsers[".tsx"].SetLanguage(tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTSX())); err != nil {
 		panic(err)
 	}
 
@@ -2,7 +2,9 @@ package codeaction
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
@@ -40,6 +42,11 @@ func (p *AdminCodeActionProvider) GetCodeActions(ctx context.Context, params *pr
 		return nil
 	}
 
+	// Undeclared events are reported in component scripts
+	if admin.IsScriptFile(strings.ToLower(filepath.Ext(params.TextDocument.URI))) {
+		return p.getScriptCodeActions(params)
+	}
+
 	// Only handle .twig files
 	if !strings.HasSuffix(params.TextDocument.URI, ".twig") {
 		return nil
@@ -51,17 +58,94 @@ func (p *AdminCodeActionProvider) GetCodeActions(ctx context.Context, params *pr
 	for _, diag := range params.Context.Diagnostics {
 		// Code comes as interface{} from JSON, so convert to string for comparison
 		codeStr, _ := diag.Code.(string)
-		if codeStr == "admin.component.missing-required-prop" {
+		switch codeStr {
+		case "admin.component.missing-required-prop":
 			action := p.createAddPropAction(params, &diag)
 			if action != nil {
 				codeActions = append(codeActions, *action)
 			}
+		case "admin.component.prop-type-mismatch":
+			codeActions = append(codeActions, p.createBindAttributeAction(params, &diag))
+		case "admin.component.invalid-prop-value":
+			codeActions = append(codeActions, p.createReplaceValueActions(params, &diag)...)
+			if action := p.createRemoveAttributeAction(params, &diag); action != nil {
+				codeActions = append(codeActions, *action)
+			}
+		case "admin.component.unknown-attribute":
+			if action := p.createRemoveAttributeAction(params, &diag); action != nil {
+				codeActions = append(codeActions, *action)
+			}
+		}
+	}
+
+	return codeActions
+}
+
+// getScriptCodeActions returns code actions for diagnostics of component scripts
+func (p *AdminCodeActionProvider) getScriptCodeActions(params *protocol.CodeActionParams) []protocol.CodeAction {
+	var codeActions []protocol.CodeAction
+
+	for _, diag := range params.Context.Diagnostics {
+		codeStr, _ := diag.Code.(string)
+		if codeStr != "admin.component.undeclared-emit" {
+			continue
+		}
+
+		if action := p.createDeclareEmitAction(params, &diag); action != nil {
+			codeActions = append(codeActions, *action)
 		}
 	}
 
 	return codeActions
 }
 
+// createDeclareEmitAction adds the emitted event to the emits of the component definition
+func (p *AdminCodeActionProvider) createDeclareEmitAction(params *protocol.CodeActionParams, diag *protocol.Diagnostic) *protocol.CodeAction {
+	data, ok := diag.Data.(map[string]any)
+	if !ok || params.Node == nil {
+		return nil
+	}
+
+	eventName, _ := data["eventName"].(string)
+	if eventName == "" {
+		return nil
+	}
+
+	root := params.Node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	point := tree_sitter.Point{Row: uint(diag.Range.Start.Line), Column: uint(diag.Range.Start.Character)}
+	node := root.NamedDescendantForPointRange(point, point)
+	if node == nil {
+		return nil
+	}
+
+	position, text, ok := admin.EmitsInsertion(node, params.DocumentContent, eventName)
+	if !ok {
+		return nil
+	}
+
+	insertAt := protocol.Position{Line: int(position.Row), Character: int(position.Column)}
+
+	return &protocol.CodeAction{
+		Title:       fmt.Sprintf("Add '%s' to emits", eventName),
+		Kind:        protocol.CodeActionQuickFix,
+		Diagnostics: []protocol.Diagnostic{*diag},
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[string][]protocol.TextEdit{
+				params.TextDocument.URI: {
+					{
+						Range:   protocol.Range{Start: insertAt, End: insertAt},
+						NewText: text,
+					},
+				},
+			},
+		},
+	}
+}
+
 // createAddPropAction creates a code action to add a missing prop
 func (p *AdminCodeActionProvider) createAddPropAction(params *protocol.CodeActionParams, diag *protocol.Diagnostic) *protocol.CodeAction {
 	data, ok := diag.Data.(map[string]any)
@@ -104,6 +188,130 @@ func (p *AdminCodeActionProvider) createAddPropAction(params *protocol.CodeActio
 	}
 }
 
+// createBindAttributeAction turns disabled="true" into :disabled="true"
+func (p *AdminCodeActionProvider) createBindAttributeAction(params *protocol.CodeActionParams, diag *protocol.Diagnostic) protocol.CodeAction {
+	attributeName := ""
+	if data, ok := diag.Data.(map[string]any); ok {
+		attributeName, _ = data["attributeName"].(string)
+	}
+
+	return protocol.CodeAction{
+		Title:       fmt.Sprintf("Bind ':%s' as JavaScript expression", attributeName),
+		Kind:        protocol.CodeActionQuickFix,
+		Diagnostics: []protocol.Diagnostic{*diag},
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[string][]protocol.TextEdit{
+				params.TextDocument.URI: {
+					{
+						Range:   protocol.Range{Start: diag.Range.Start, End: diag.Range.Start},
+						NewText: ":",
+					},
+				},
+			},
+		},
+	}
+}
+
+// createReplaceValueActions offers every value accepted by the prop validator
+func (p *AdminCodeActionProvider) createReplaceValueActions(params *protocol.CodeActionParams, diag *protocol.Diagnostic) []protocol.CodeAction {
+	data, ok := diag.Data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	allowedValues := toStringSlice(data["allowedValues"])
+
+	codeActions := make([]protocol.CodeAction, 0, len(allowedValues))
+	for _, value := range allowedValues {
+		codeActions = append(codeActions, protocol.CodeAction{
+			Title:       fmt.Sprintf("Change value to '%s'", value),
+			Kind:        protocol.CodeActionQuickFix,
+			Diagnostics: []protocol.Diagnostic{*diag},
+			Edit: &protocol.WorkspaceEdit{
+				Changes: map[string][]protocol.TextEdit{
+					params.TextDocument.URI: {
+						{
+							Range:   diag.Range,
+							NewText: value,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return codeActions
+}
+
+// createRemoveAttributeAction removes the attribute including the whitespace in front of it
+func (p *AdminCodeActionProvider) createRemoveAttributeAction(params *protocol.CodeActionParams, diag *protocol.Diagnostic) *protocol.CodeAction {
+	data, ok := diag.Data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	attributeName, _ := data["attributeName"].(string)
+
+	attributeRange, ok := toRange(data["attributeRange"])
+	if !ok {
+		return nil
+	}
+
+	lines := strings.Split(string(params.DocumentContent), "\n")
+	if attributeRange.Start.Line < len(lines) {
+		line := lines[attributeRange.Start.Line]
+		for attributeRange.Start.Character > 0 && attributeRange.Start.Character <= len(line) && (line[attributeRange.Start.Character-1] == ' ' || line[attributeRange.Start.Character-1] == '\t') {
+			attributeRange.Start.Character--
+		}
+	}
+
+	return &protocol.CodeAction{
+		Title:       fmt.Sprintf("Remove attribute '%s'", attributeName),
+		Kind:        protocol.CodeActionQuickFix,
+		Diagnostics: []protocol.Diagnostic{*diag},
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[string][]protocol.TextEdit{
+				params.TextDocument.URI: {
+					{
+						Range:   attributeRange,
+						NewText: "",
+					},
+				},
+			},
+		},
+	}
+}
+
+// toRange converts diagnostic data back to a range, data sent by the client is decoded JSON
+func toRange(value any) (protocol.Range, bool) {
+	var result protocol.Range
+
+	encoded, err := json.Marshal(value)
+	if err != nil || value == nil {
+		return result, false
+	}
+
+	return result, json.Unmarshal(encoded, &result) == nil
+}
+
+// toStringSlice converts diagnostic data back to a string slice, data sent by the client is decoded JSON
+func toStringSlice(value any) []string {
+	switch values := value.(type) {
+	case []string:
+		return values
+	case []any:
+		result := make([]string, 0, len(values))
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+
+	return nil
+}
+
 // getPropAttributeFormat returns the attribute format and default value for a prop
 func (p *AdminCodeActionProvider) getPropAttributeFormat(componentName, propName string) (string, string) {
 	// Convert camelCase prop name to kebab-case for the attribute
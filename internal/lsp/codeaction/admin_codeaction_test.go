@@ -455,3 +455,110 @@ Component.register('sw-icon', {
 	line := action.Command.Arguments[1].(int)
 	assert.Equal(t, 0, line)
 }
+
+func TestAdminCodeActionProvider_AttributeFixes(t *testing.T) {
+	provider := &AdminCodeActionProvider{}
+
+	uri := "file:///project/src/Resources/app/administration/src/views/test.html.twig"
+	content := `<sw-button disabled="true" variant="primray" lable="Save"></sw-button>`
+
+	attributeData := func(name string, start, end int) map[string]any {
+		return map[string]any{
+			"componentName": "sw-button",
+			"attributeName": name,
+			// Data is sent back by the client as decoded JSON
+			"attributeRange": map[string]any{
+				"start": map[string]any{"line": float64(0), "character": float64(start)},
+				"end":   map[string]any{"line": float64(0), "character": float64(end)},
+			},
+		}
+	}
+
+	variantData := attributeData("variant", 27, 44)
+	variantData["allowedValues"] = []any{"primary", "ghost"}
+
+	params := &protocol.CodeActionParams{DocumentContent: []byte(content)}
+	params.TextDocument.URI = uri
+	params.Context.Diagnostics = []protocol.Diagnostic{
+		{
+			Code:  "admin.component.prop-type-mismatch",
+			Range: protocol.Range{Start: protocol.Position{Line: 0, Character: 11}, End: protocol.Position{Line: 0, Character: 19}},
+			Data:  attributeData("disabled", 11, 26),
+		},
+		{
+			Code:  "admin.component.invalid-prop-value",
+			Range: protocol.Range{Start: protocol.Position{Line: 0, Character: 36}, End: protocol.Position{Line: 0, Character: 43}},
+			Data:  variantData,
+		},
+		{
+			Code:  "admin.component.unknown-attribute",
+			Range: protocol.Range{Start: protocol.Position{Line: 0, Character: 45}, End: protocol.Position{Line: 0, Character: 50}},
+			Data:  attributeData("lable", 45, 57),
+		},
+	}
+
+	actions := provider.GetCodeActions(context.Background(), params)
+
+	var titles []string
+	for _, action := range actions {
+		titles = append(titles, action.Title)
+	}
+	assert.Equal(t, []string{
+		"Bind ':disabled' as JavaScript expression",
+		"Change value to 'primary'",
+		"Change value to 'ghost'",
+		"Remove attribute 'variant'",
+		"Remove attribute 'lable'",
+	}, titles)
+
+	bind := actions[0].Edit.Changes[uri][0]
+	assert.Equal(t, ":", bind.NewText)
+	assert.Equal(t, protocol.Position{Line: 0, Character: 11}, bind.Range.Start)
+	assert.Equal(t, protocol.Position{Line: 0, Character: 11}, bind.Range.End)
+
+	replace := actions[1].Edit.Changes[uri][0]
+	assert.Equal(t, "primary", replace.NewText)
+	assert.Equal(t, 36, replace.Range.Start.Character)
+
+	remove := actions[4].Edit.Changes[uri][0]
+	assert.Equal(t, "", remove.NewText)
+	assert.Equal(t, protocol.Position{Line: 0, Character: 44}, remove.Range.Start)
+	assert.Equal(t, protocol.Position{Line: 0, Character: 57}, remove.Range.End)
+}
+
+func TestAdminCodeActionProvider_DeclareEmit(t *testing.T) {
+	provider := &AdminCodeActionProvider{}
+
+	code := "export default {\n    emits: ['save'],\n    methods: {\n        onClose() {\n            this.$emit('close');\n        },\n    },\n};\n"
+
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+	defer parser.Close()
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	diag := protocol.Diagnostic{
+		Range: protocol.Range{Start: protocol.Position{Line: 4, Character: 23}, End: protocol.Position{Line: 4, Character: 30}},
+		Code:  "admin.component.undeclared-emit",
+		Data: map[string]any{
+			"componentName": "sw-foo",
+			"eventName":     "close",
+		},
+	}
+
+	params := &protocol.CodeActionParams{
+		Node:            findNodeAtPosition(tree.RootNode(), 4, 23),
+		DocumentContent: []byte(code),
+	}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.Context.Diagnostics = []protocol.Diagnostic{diag}
+
+	actions := provider.GetCodeActions(context.Background(), params)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "Add 'close' to emits", actions[0].Title)
+
+	edits := actions[0].Edit.Changes[params.TextDocument.URI]
+	require.Len(t, edits, 1)
+	assert.Equal(t, protocol.Position{Line: 1, Character: 18}, edits[0].Range.Start)
+	assert.Equal(t, ", 'close'", edits[0].NewText)
+}
@@ -2,6 +2,8 @@ package codeaction
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/shopware/shopware-lsp/internal/lsp"
@@ -64,6 +66,8 @@ func (p *TwigCodeActionProvider) GetCodeActions(ctx context.Context, params *pro
 		if action := p.getShowDiffAction(params); action != nil {
 			codeActions = append(codeActions, *action)
 		}
+
+		codeActions = append(codeActions, p.getUnknownBlockActions(params)...)
 	}
 
 	if action := p.getShowDiffActionFromComment(params); action != nil {
@@ -255,6 +259,102 @@ func (p *TwigCodeActionProvider) getShowDiffActionFromComment(params *protocol.C
 	}
 }
 
+// getUnknownBlockActions offers to rename a block which does not exist in the parent template chain
+func (p *TwigCodeActionProvider) getUnknownBlockActions(params *protocol.CodeActionParams) []protocol.CodeAction {
+	if p.twigIndexer == nil {
+		return nil
+	}
+
+	rootNode := params.Node
+	for rootNode.Parent() != nil {
+		rootNode = rootNode.Parent()
+	}
+
+	isTopLevelBlock := false
+	for _, nameNode := range twig.TopLevelBlockNames(rootNode) {
+		if nameNode.Id() == params.Node.Id() {
+			isTopLevelBlock = true
+			break
+		}
+	}
+
+	if !isTopLevelBlock {
+		return nil
+	}
+
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+
+	twigFile, err := twig.ParseTwig(filePath, rootNode, params.DocumentContent)
+	if err != nil || twigFile.ExtendsFile == "" {
+		return nil
+	}
+
+	parentBlocks, found := p.twigIndexer.GetParentBlocks(twigFile.ExtendsFile, filePath)
+	if !found {
+		return nil
+	}
+
+	blockName := treesitterhelper.GetNodeText(params.Node, params.DocumentContent)
+	if _, ok := parentBlocks[blockName]; ok {
+		return nil
+	}
+
+	parentBlockNames := make([]string, 0, len(parentBlocks))
+	for name := range parentBlocks {
+		parentBlockNames = append(parentBlockNames, name)
+	}
+	sort.Strings(parentBlockNames)
+
+	// The name is repeated in {% endblock name %}, which has to be renamed as well
+	nameRanges := []protocol.Range{blockNameRange(params.Node)}
+	if blockNode := params.Node.Parent(); blockNode != nil {
+		for i := uint(0); i < blockNode.ChildCount(); i++ {
+			child := blockNode.Child(i)
+			if child.Id() != params.Node.Id() && blockNode.FieldNameForChild(uint32(i)) == "name" {
+				nameRanges = append(nameRanges, blockNameRange(child))
+			}
+		}
+	}
+
+	var codeActions []protocol.CodeAction
+
+	for _, suggestion := range twig.SuggestBlockNames(blockName, parentBlockNames) {
+		edits := make([]protocol.TextEdit, 0, len(nameRanges))
+		for _, nameRange := range nameRanges {
+			edits = append(edits, protocol.TextEdit{Range: nameRange, NewText: suggestion})
+		}
+
+		codeActions = append(codeActions, protocol.CodeAction{
+			Title: fmt.Sprintf("Rename block to '%s'", suggestion),
+			Kind:  protocol.CodeActionQuickFix,
+			Edit: &protocol.WorkspaceEdit{
+				Changes: map[string][]protocol.TextEdit{
+					params.TextDocument.URI: edits,
+				},
+			},
+		})
+	}
+
+	codeActions = append(codeActions, protocol.CodeAction{
+		Title: "Choose block of parent template",
+		Kind:  protocol.CodeActionQuickFix,
+		Command: &protocol.CommandAction{
+			Title:     "Choose Parent Block",
+			Command:   "shopware.twig.chooseParentBlock",
+			Arguments: []any{params.TextDocument.URI, nameRanges, parentBlockNames},
+		},
+	})
+
+	return codeActions
+}
+
+func blockNameRange(node *tree_sitter.Node) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: int(node.StartPosition().Row), Character: int(node.StartPosition().Column)},
+		End:   protocol.Position{Line: int(node.EndPosition().Row), Character: int(node.EndPosition().Column)},
+	}
+}
+
 func (p *TwigCodeActionProvider) hasVersioningComment(blockNode *tree_sitter.Node, content []byte) bool {
 	parent := blockNode.Parent()
 	if parent == nil {
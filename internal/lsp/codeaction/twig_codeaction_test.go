@@ -0,0 +1,67 @@
+package codeaction
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwigCodeActionProvider_RenameUnknownBlock(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	parentCode := `{% block base_body %}{% block base_header %}{% endblock %}{% endblock %}`
+	parentTree, parentParser := parseTwig(t, parentCode)
+	defer parentParser.Close()
+	defer parentTree.Close()
+	require.NoError(t, twigIndexer.Index("/project/vendor/shopware/storefront/Resources/views/storefront/base.html.twig", parentTree.RootNode(), []byte(parentCode)))
+
+	provider := &TwigCodeActionProvider{twigIndexer: twigIndexer}
+
+	codeActionsAt := func(code string, line, character int) []protocol.CodeAction {
+		tree, parser := parseTwig(t, code)
+		defer parser.Close()
+		defer tree.Close()
+
+		params := &protocol.CodeActionParams{}
+		params.TextDocument.URI = "file:///project/custom/plugins/Acme/src/Resources/views/storefront/base.html.twig"
+		params.DocumentContent = []byte(code)
+		params.Node = findNodeAtPosition(tree.RootNode(), line, character)
+
+		return provider.getUnknownBlockActions(params)
+	}
+
+	t.Run("renames the endblock name as well", func(t *testing.T) {
+		code := "{% sw_extends '@Storefront/storefront/base.html.twig' %}\n{% block base_haeder %}{% endblock base_haeder %}"
+
+		codeActions := codeActionsAt(code, 1, 12)
+		require.Len(t, codeActions, 2)
+
+		assert.Equal(t, "Rename block to 'base_header'", codeActions[0].Title)
+		edits := codeActions[0].Edit.Changes["file:///project/custom/plugins/Acme/src/Resources/views/storefront/base.html.twig"]
+		require.Len(t, edits, 2)
+
+		assert.Equal(t, protocol.Position{Line: 1, Character: 9}, edits[0].Range.Start)
+		assert.Equal(t, protocol.Position{Line: 1, Character: 20}, edits[0].Range.End)
+		assert.Equal(t, protocol.Position{Line: 1, Character: 35}, edits[1].Range.Start)
+		assert.Equal(t, protocol.Position{Line: 1, Character: 46}, edits[1].Range.End)
+		for _, edit := range edits {
+			assert.Equal(t, "base_header", edit.NewText)
+		}
+
+		assert.Equal(t, "shopware.twig.chooseParentBlock", codeActions[1].Command.Command)
+		assert.Len(t, codeActions[1].Command.Arguments[1], 2)
+	})
+
+	t.Run("endblock without name", func(t *testing.T) {
+		code := "{% sw_extends '@Storefront/storefront/base.html.twig' %}\n{% block base_haeder %}{% endblock %}"
+
+		codeActions := codeActionsAt(code, 1, 12)
+		require.Len(t, codeActions, 2)
+		assert.Len(t, codeActions[0].Edit.Changes["file:///project/custom/plugins/Acme/src/Resources/views/storefront/base.html.twig"], 1)
+	})
+}
@@ -0,0 +1,89 @@
+package codelens
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+)
+
+type PHPRouteCodeLensProvider struct {
+	routeIndex      *symfony.RouteIndexer
+	routeUsageIndex *symfony.RouteUsageIndexer
+}
+
+func NewPHPRouteCodeLensProvider(lspServer *lsp.Server) *PHPRouteCodeLensProvider {
+	routeIndex, _ := lspServer.GetIndexer("symfony.route")
+	routeUsageIndex, _ := lspServer.GetIndexer("symfony.route_usage")
+
+	return &PHPRouteCodeLensProvider{
+		routeIndex:      routeIndex.(*symfony.RouteIndexer),
+		routeUsageIndex: routeUsageIndex.(*symfony.RouteUsageIndexer),
+	}
+}
+
+func (p *PHPRouteCodeLensProvider) GetCodeLenses(ctx context.Context, params *protocol.CodeLensParams) []protocol.CodeLens {
+	if !strings.HasSuffix(params.TextDocument.URI, ".php") {
+		return []protocol.CodeLens{}
+	}
+
+	routes, err := p.routeIndex.GetRoutes()
+	if err != nil {
+		return []protocol.CodeLens{}
+	}
+
+	var lenses []protocol.CodeLens
+
+	for _, route := range routes.GetByFilePath(strings.TrimPrefix(params.TextDocument.URI, "file://")) {
+		// Class level routes only provide a prefix and are not an action
+		if route.Name == "" || !strings.Contains(route.Controller, "::") {
+			continue
+		}
+
+		// Same locations as returned by the RouteReferenceProvider
+		var fileLocations []string
+		usages, _ := p.routeUsageIndex.GetRoute(route.Name)
+		for _, usage := range usages {
+			fileLocations = append(fileLocations, fmt.Sprintf("file://%s#%d", usage.File, usage.Line))
+		}
+
+		lenses = append(lenses, protocol.CodeLens{
+			Command: &protocol.Command{
+				Title:   formatRouteTitle(route),
+				Command: "shopware.openReferences",
+				Arguments: []any{
+					fileLocations,
+				},
+			},
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      route.Line - 1,
+					Character: 0,
+				},
+				End: protocol.Position{
+					Line:      route.Line - 1,
+					Character: 0,
+				},
+			},
+		})
+	}
+
+	return lenses
+}
+
+func (p *PHPRouteCodeLensProvider) ResolveCodeLens(ctx context.Context, params *protocol.CodeLens) (*protocol.CodeLens, error) {
+	return params, nil
+}
+
+// formatRouteTitle builds a title like "GET /account/order/{id} · frontend.account.order.single.page"
+func formatRouteTitle(route symfony.Route) string {
+	title := route.Path
+	if len(route.Methods) > 0 {
+		title = strings.Join(route.Methods, "|") + " " + title
+	}
+
+	return title + " · " + route.Name
+}
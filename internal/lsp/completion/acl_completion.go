@@ -0,0 +1,106 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/acl"
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// AclCompletionProvider provides completions for ACL privileges
+type AclCompletionProvider struct {
+	aclIndexer *acl.AclIndexer
+}
+
+// NewAclCompletionProvider creates a new ACL completion provider
+func NewAclCompletionProvider(server *lsp.Server) *AclCompletionProvider {
+	aclIndexer, _ := server.GetIndexer("acl.indexer")
+
+	return &AclCompletionProvider{
+		aclIndexer: aclIndexer.(*acl.AclIndexer),
+	}
+}
+
+// GetCompletions returns privileges inside acl.can('...'), route meta privileges and PHP `_acl` route defaults
+func (p *AclCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if params.Node == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(params.TextDocument.URI)); {
+	case admin.IsScriptFile(ext):
+		if acl.ScriptPrivilegeAt(params.Node, params.DocumentContent) != nil {
+			return p.getPrivilegeCompletions()
+		}
+	case ext == ".php":
+		if acl.PHPPrivilegeAt(params.Node, params.DocumentContent) != nil {
+			return p.getPrivilegeCompletions()
+		}
+	case ext == ".twig":
+		if !strings.Contains(params.TextDocument.URI, "Resources/app/administration") {
+			break
+		}
+
+		offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+		if acl.TemplatePrivilegeAt(rootNode(params.Node), params.DocumentContent, offset) {
+			return p.getPrivilegeCompletions()
+		}
+	}
+
+	return []protocol.CompletionItem{}
+}
+
+// getPrivilegeCompletions returns all known roles and privileges, roles first
+func (p *AclCompletionProvider) getPrivilegeCompletions() []protocol.CompletionItem {
+	privileges, err := p.aclIndexer.GetAllPrivileges()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	sort.SliceStable(privileges, func(i, j int) bool {
+		if privileges[i].Kind != privileges[j].Kind {
+			return privileges[i].Kind == acl.PrivilegeKindRole
+		}
+		return privileges[i].Name < privileges[j].Name
+	})
+
+	seen := make(map[string]bool, len(privileges))
+	items := make([]protocol.CompletionItem, 0, len(privileges))
+	for _, privilege := range privileges {
+		if seen[privilege.Name] {
+			continue
+		}
+		seen[privilege.Name] = true
+
+		item := protocol.CompletionItem{
+			Label:  privilege.Name,
+			Kind:   int(protocol.EnumMemberCompletion),
+			Detail: string(privilege.Kind),
+		}
+
+		doc := "**Defined in:** `" + filepath.Base(privilege.FilePath) + "`\n\n"
+		if privilege.Category != "" {
+			doc += "**Category:** `" + privilege.Category + "`\n\n"
+		}
+		if privilege.Parent != "" {
+			doc += "**Parent:** `" + privilege.Parent + "`\n"
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = doc
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *AclCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"'", "\""}
+}
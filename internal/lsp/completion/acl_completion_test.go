@@ -0,0 +1,67 @@
+package completion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/acl"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAclCompletionProvider(t *testing.T) {
+	indexer, err := acl.NewAclIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	require.NoError(t, indexer.SavePrivilege(acl.Privilege{
+		Name:     "product:read",
+		Kind:     acl.PrivilegeKindPrivilege,
+		Category: "permissions",
+		FilePath: "/project/src/Resources/app/administration/src/module/sw-product/acl/index.js",
+		Line:     9,
+	}))
+	require.NoError(t, indexer.SavePrivilege(acl.Privilege{
+		Name:     "product.viewer",
+		Kind:     acl.PrivilegeKindRole,
+		Category: "permissions",
+		Parent:   "catalogues",
+		FilePath: "/project/src/Resources/app/administration/src/module/sw-product/acl/viewer.js",
+		Line:     7,
+	}))
+
+	provider := &AclCompletionProvider{aclIndexer: indexer}
+
+	code := "this.acl.can('');\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.Position.Character = 14
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 14)
+
+	items := provider.GetCompletions(t.Context(), params)
+	require.Len(t, items, 2)
+	assert.Equal(t, "product.viewer", items[0].Label)
+	assert.Equal(t, "role", items[0].Detail)
+	assert.Contains(t, items[0].Documentation.Value, "catalogues")
+	assert.Equal(t, "product:read", items[1].Label)
+
+	template := `<sw-button v-if="acl.can('product.')"></sw-button>`
+	templateTree, twigParser := parseTwig(t, template)
+	defer templateTree.Close()
+	defer twigParser.Close()
+
+	params = &protocol.CompletionParams{DocumentContent: []byte(template), Node: templateTree.RootNode()}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/sw-foo.html.twig"
+	params.Position.Character = strings.Index(template, "')")
+
+	assert.Len(t, provider.GetCompletions(t.Context(), params), 2)
+
+	params.TextDocument.URI = "file:///project/src/Resources/views/storefront/page/index.html.twig"
+	assert.Empty(t, provider.GetCompletions(t.Context(), params))
+}
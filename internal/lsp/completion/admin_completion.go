@@ -4,6 +4,7 @@ import (
 	"context"
 	"path/filepath"
 	"strings"
+	"unicode"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
 	"github.com/shopware/shopware-lsp/internal/lsp"
@@ -35,7 +36,7 @@ func (p *AdminCompletionProvider) GetCompletions(ctx context.Context, params *pr
 	ext := strings.ToLower(filepath.Ext(params.TextDocument.URI))
 
 	// Handle JS/TS files
-	if ext == ".js" || ext == ".ts" {
+	if admin.IsScriptFile(ext) {
 		return p.jsCompletions(ctx, params)
 	}
 
@@ -59,14 +60,387 @@ func (p *AdminCompletionProvider) jsCompletions(_ context.Context, params *proto
 		items = append(items, p.getComponentCompletions()...)
 	}
 
+	// Check if we're in Shopware.Service('<caret>') or an inject entry
+	if admin.ServiceReferenceAt(params.Node, params.DocumentContent) != nil {
+		items = append(items, p.getServiceCompletions()...)
+	}
+
+	// Check if we're in this.$emit('<caret>')
+	if admin.EmitReferenceAt(params.Node, params.DocumentContent) != nil {
+		items = append(items, p.getEmitCompletions(params)...)
+	}
+
+	// Check if we're in Mixin.getByName('<caret>') or a mixins array
+	if admin.MixinReferenceAt(params.Node, params.DocumentContent) != nil {
+		items = append(items, p.getMixinCompletions()...)
+	}
+
+	// Check if we're in $router.push({ name: '<caret>' }) or a navigation entry path
+	if admin.RouteReferenceAt(params.Node, params.DocumentContent) != nil {
+		items = append(items, p.getRouteCompletions()...)
+	}
+
+	// Check if we're in a store call like Shopware.Store.get('<caret>') or mapState('<caret>')
+	items = append(items, p.getStoreCompletions(params)...)
+
+	// Check if we're completing a member after `this.`
+	items = append(items, p.getThisMemberCompletions(params)...)
+
+	return items
+}
+
+// getEmitCompletions returns the events declared in the emits of the component or mixin surrounding the cursor
+func (p *AdminCompletionProvider) getEmitCompletions(params *protocol.CompletionParams) []protocol.CompletionItem {
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+	scriptContext := p.adminIndexer.GetScriptContext(filePath, rootNode(params.Node), params.DocumentContent, uint(offset))
+
+	emits, err := p.adminIndexer.GetScriptEmits(scriptContext)
+	if err != nil {
+		return nil
+	}
+
+	items := make([]protocol.CompletionItem, 0, len(emits))
+	for _, emit := range emits {
+		items = append(items, protocol.CompletionItem{
+			Label:  emit,
+			Kind:   int(protocol.EventCompletion),
+			Detail: "emits",
+		})
+	}
+
+	return items
+}
+
+// getMixinCompletions returns the names of all registered mixins
+func (p *AdminCompletionProvider) getMixinCompletions() []protocol.CompletionItem {
+	mixins, err := p.adminIndexer.GetAllMixins()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	seen := make(map[string]bool, len(mixins))
+	items := make([]protocol.CompletionItem, 0, len(mixins))
+	for _, mixin := range mixins {
+		if seen[mixin.Name] {
+			continue
+		}
+		seen[mixin.Name] = true
+
+		item := protocol.CompletionItem{
+			Label:  mixin.Name,
+			Kind:   int(protocol.ModuleCompletion),
+			Detail: "mixin",
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = "**Registered in:** `" + filepath.Base(mixin.FilePath) + "`"
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// getRouteCompletions returns the names of all module routes
+func (p *AdminCompletionProvider) getRouteCompletions() []protocol.CompletionItem {
+	routes, err := p.adminIndexer.GetAllRoutes()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	seen := make(map[string]bool, len(routes))
+	items := make([]protocol.CompletionItem, 0, len(routes))
+	for _, route := range routes {
+		if seen[route.Name] {
+			continue
+		}
+		seen[route.Name] = true
+
+		item := protocol.CompletionItem{
+			Label:  route.Name,
+			Kind:   int(protocol.ReferenceCompletion),
+			Detail: route.Component,
+		}
+
+		doc := "**Module:** `" + route.Module + "`\n\n"
+		if route.Path != "" {
+			doc += "**Path:** `" + route.Path + "`\n\n"
+		}
+		if route.Component != "" {
+			doc += "**Component:** `" + route.Component + "`\n"
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = doc
+
+		items = append(items, item)
+	}
+
 	return items
 }
 
+// getStoreCompletions returns store ids, Vuex module names, store members and namespaced
+// mutations/actions depending on the store call surrounding the cursor
+func (p *AdminCompletionProvider) getStoreCompletions(params *protocol.CompletionParams) []protocol.CompletionItem {
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+
+	// Shopware.Store.get('session').<caret>
+	reference := admin.StoreMemberAccessAt(rootNode(params.Node), params.DocumentContent, uint(offset))
+	if reference == nil {
+		reference = admin.StoreReferenceAt(params.Node, params.DocumentContent)
+	}
+	if reference == nil {
+		return nil
+	}
+
+	switch reference.Kind {
+	case admin.StoreReferenceID, admin.StoreReferenceModule:
+		return p.getStoreIDCompletions(reference.StoreKind)
+	case admin.StoreReferenceMember:
+		members, filePath := p.adminIndexer.GetStoreMembers(reference.Store, reference.StoreKind, reference.MemberKinds...)
+		return storeMemberCompletionItems(members, reference.Store, filePath, "")
+	case admin.StoreReferencePath:
+		stores, err := p.adminIndexer.GetAllStores()
+		if err != nil {
+			return nil
+		}
+
+		var items []protocol.CompletionItem
+		for _, store := range stores {
+			if store.Kind != admin.StoreKindVuex {
+				continue
+			}
+			members, filePath := p.adminIndexer.GetStoreMembers(store.ID, store.Kind, reference.MemberKinds...)
+			items = append(items, storeMemberCompletionItems(members, store.ID, filePath, store.ID+"/")...)
+		}
+		return items
+	}
+
+	return nil
+}
+
+// getStoreIDCompletions returns the ids of all Pinia stores or the names of all Vuex modules
+func (p *AdminCompletionProvider) getStoreIDCompletions(kind admin.AdminStoreKind) []protocol.CompletionItem {
+	stores, err := p.adminIndexer.GetAllStores()
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(stores))
+	var items []protocol.CompletionItem
+	for _, store := range stores {
+		if store.Kind != kind || seen[store.ID] {
+			continue
+		}
+		seen[store.ID] = true
+
+		item := protocol.CompletionItem{
+			Label:  store.ID,
+			Kind:   int(protocol.ModuleCompletion),
+			Detail: string(store.Kind) + " store",
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = "**Registered in:** `" + filepath.Base(store.FilePath) + "`"
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// storeMemberCompletionItems creates completion items for store members, labels are prefixed with the given prefix
+func storeMemberCompletionItems(members []admin.StoreMember, storeID, filePath, prefix string) []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0, len(members))
+	for _, member := range members {
+		kind := protocol.PropertyCompletion
+		if member.Kind == admin.StoreMemberMutation || member.Kind == admin.StoreMemberAction {
+			kind = protocol.MethodCompletion
+		}
+
+		item := protocol.CompletionItem{
+			Label:  prefix + member.Name,
+			Kind:   int(kind),
+			Detail: string(member.Kind) + " from " + storeID,
+		}
+		if filePath != "" {
+			item.Documentation.Kind = "markdown"
+			item.Documentation.Value = "**Defined in:** `" + filepath.Base(filePath) + "`"
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// getServiceCompletions returns the names of all registered admin services
+func (p *AdminCompletionProvider) getServiceCompletions() []protocol.CompletionItem {
+	services, err := p.adminIndexer.GetAllServices()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	seen := make(map[string]bool, len(services))
+	items := make([]protocol.CompletionItem, 0, len(services))
+	for _, service := range services {
+		if seen[service.Name] {
+			continue
+		}
+		seen[service.Name] = true
+
+		item := protocol.CompletionItem{
+			Label:  service.Name,
+			Kind:   int(protocol.ModuleCompletion),
+			Detail: string(service.Source),
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = "**Shopware Admin Service**\n\n**Registered in:** `" + filepath.Base(service.FilePath) + "`"
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// getThisMemberCompletions returns the props, data, methods, computed properties and injects
+// of the component or mixin surrounding the cursor after `this.`
+func (p *AdminCompletionProvider) getThisMemberCompletions(params *protocol.CompletionParams) []protocol.CompletionItem {
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+	if object := admin.MemberObjectAt(rootNode(params.Node), uint(offset)); object == nil || object.Kind() != "this" {
+		return nil
+	}
+
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+	scriptContext := p.adminIndexer.GetScriptContext(filePath, rootNode(params.Node), params.DocumentContent, uint(offset))
+
+	members, err := p.adminIndexer.GetScriptMembers(scriptContext)
+	if err != nil {
+		return nil
+	}
+
+	return memberCompletionItems(members)
+}
+
+// getTemplateExpressionCompletions returns the members of the component owning the template and the
+// v-for and slot scope variables when the cursor is inside a Vue expression
+func (p *AdminCompletionProvider) getTemplateExpressionCompletions(params *protocol.CompletionParams) ([]protocol.CompletionItem, bool) {
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+
+	expression, ok := admin.TemplateExpressionAt(rootNode(params.Node), params.DocumentContent, offset)
+	if !ok {
+		return nil, false
+	}
+
+	// Strings like acl.can('<caret>') are completed by other providers
+	if isInsideStringLiteral(expression.Text) {
+		return []protocol.CompletionItem{}, true
+	}
+
+	// product.<caret> accesses a member of another object, which we can't resolve
+	partial := strings.TrimRightFunc(expression.Text, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+	})
+	if strings.HasSuffix(partial, ".") {
+		return []protocol.CompletionItem{}, true
+	}
+
+	items := make([]protocol.CompletionItem, 0, len(expression.Scope))
+	for _, variable := range expression.Scope {
+		items = append(items, protocol.CompletionItem{
+			Label:  variable,
+			Kind:   int(protocol.VariableCompletion),
+			Detail: "template variable",
+		})
+	}
+
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+	comp, err := p.adminIndexer.GetComponentByTemplatePath(filePath)
+	if err != nil || comp == nil {
+		return items, true
+	}
+
+	members, err := p.adminIndexer.GetComponentMembers(comp.Name)
+	if err != nil {
+		return items, true
+	}
+
+	return append(items, memberCompletionItems(members)...), true
+}
+
+// isInsideStringLiteral checks if the end of a JavaScript expression is inside an unclosed string
+func isInsideStringLiteral(expression string) bool {
+	var quote rune
+	escaped := false
+
+	for _, r := range expression {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		}
+	}
+
+	return quote != 0
+}
+
+// memberCompletionItems creates completion items for component members showing their origin
+func memberCompletionItems(members []admin.ComponentMember) []protocol.CompletionItem {
+	items := make([]protocol.CompletionItem, 0, len(members))
+	for _, member := range members {
+		item := protocol.CompletionItem{
+			Label:  member.Name,
+			Kind:   int(memberCompletionKind(member.Kind)),
+			Detail: string(member.Kind) + " from " + member.Origin,
+		}
+
+		if member.FilePath != "" {
+			item.Documentation.Kind = "markdown"
+			item.Documentation.Value = "**Defined in:** `" + member.Origin + "`\n\n`" + filepath.Base(member.FilePath) + "`"
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// memberCompletionKind returns the completion kind for a component member kind
+func memberCompletionKind(kind admin.ComponentMemberKind) protocol.CompletionItemKind {
+	switch kind {
+	case admin.MemberMethod:
+		return protocol.MethodCompletion
+	case admin.MemberData:
+		return protocol.FieldCompletion
+	case admin.MemberInject:
+		return protocol.ReferenceCompletion
+	default:
+		return protocol.PropertyCompletion
+	}
+}
+
 // twigCompletions handles completions in Twig admin templates
 func (p *AdminCompletionProvider) twigCompletions(_ context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
 	node := params.Node
 	content := params.DocumentContent
 
+	// Check if we're in a route location like <router-link :to="{ name: '<caret>' }">
+	offset := treesitterhelper.OffsetAt(content, params.Position.Line, params.Position.Character)
+	if _, ok := admin.TemplateRouteNameAt(rootNode(node), content, offset); ok {
+		return p.getRouteCompletions()
+	}
+
+	// Check if we're inside a Vue expression ({{ }}, v-if, v-for, :prop, @event)
+	if items, ok := p.getTemplateExpressionCompletions(params); ok {
+		return items
+	}
+
 	// Check if we're in an HTML tag name position
 	if p.isInHTMLTagName(node, content) {
 		return p.getComponentTagCompletions()
@@ -419,13 +793,13 @@ func (p *AdminCompletionProvider) getComponentPropCompletions(componentName stri
 			item := protocol.CompletionItem{
 				Label:  prop.Name,
 				Kind:   int(protocol.PropertyCompletion),
-				Detail: prop.Type,
+				Detail: prop.DisplayType(),
 			}
 
 			// Build documentation
 			doc := ""
-			if prop.Type != "" {
-				doc += "**Type:** `" + prop.Type + "`\n\n"
+			if prop.DisplayType() != "" {
+				doc += "**Type:** `" + prop.DisplayType() + "`\n\n"
 			}
 			if prop.Required {
 				doc += "**Required**\n\n"
@@ -445,7 +819,7 @@ func (p *AdminCompletionProvider) getComponentPropCompletions(componentName stri
 			bindingItem := protocol.CompletionItem{
 				Label:            ":" + prop.Name,
 				Kind:             int(protocol.PropertyCompletion),
-				Detail:           prop.Type + " (v-bind)",
+				Detail:           prop.DisplayType() + " (v-bind)",
 				InsertText:       ":" + prop.Name + "=\"$0\"",
 				InsertTextFormat: int(protocol.SnippetTextFormat),
 			}
@@ -474,7 +848,7 @@ func (p *AdminCompletionProvider) getComponentPropCompletions(componentName stri
 
 // GetTriggerCharacters returns the characters that trigger this completion provider
 func (p *AdminCompletionProvider) GetTriggerCharacters() []string {
-	return []string{"'", "\"", "<", " ", "#"}
+	return []string{"'", "\"", "<", " ", "#", "."}
 }
 
 // getComponentNameForSlotCompletion checks if we're in a position to complete slot names
@@ -1,9 +1,13 @@
 package completion
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
 )
@@ -161,3 +165,350 @@ func TestGetFirstChildOfKind(t *testing.T) {
 	nonExistent := provider.getFirstChildOfKind(root, "class_declaration")
 	assert.Nil(t, nonExistent)
 }
+
+func TestGetThisMemberCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	filePath := "/project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	require.NoError(t, indexer.SaveComponent(admin.VueComponent{
+		Name:             "sw-foo",
+		FilePath:         filePath,
+		DefinitionPath:   filePath,
+		ExtendsComponent: "sw-base",
+	}))
+	require.NoError(t, indexer.SaveComponentDefinition("sw-foo", admin.ComponentDefinition{
+		FilePath: filePath,
+		Methods:  []string{"onSave"},
+	}))
+	require.NoError(t, indexer.SaveComponent(admin.VueComponent{Name: "sw-base", FilePath: "/base.js", DefinitionPath: "/base.js"}))
+	require.NoError(t, indexer.SaveComponentDefinition("/base", admin.ComponentDefinition{
+		FilePath: "/base.js",
+		Data:     []string{"isLoading"},
+	}))
+
+	code := "Component.extend('sw-foo', 'sw-base', {\n    methods: {\n        onSave() {\n            this.\n        },\n    },\n});\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	params := &protocol.CompletionParams{}
+	params.TextDocument.URI = "file://" + filePath
+	params.Position.Line = 3
+	params.Position.Character = 17
+	params.DocumentContent = []byte(code)
+	params.Node = findNodeAtPosition(tree.RootNode(), 3, 17)
+
+	items := provider.getThisMemberCompletions(params)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "onSave", items[0].Label)
+	assert.Equal(t, int(protocol.MethodCompletion), items[0].Kind)
+	assert.Equal(t, "method from sw-foo", items[0].Detail)
+
+	assert.Equal(t, "isLoading", items[1].Label)
+	assert.Equal(t, "data from sw-base", items[1].Detail)
+
+	params.Position.Character = 12
+	assert.Empty(t, provider.getThisMemberCompletions(params))
+}
+
+func TestGetTemplateExpressionCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	definitionPath := "/project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	templatePath := "/project/src/Resources/app/administration/src/component/sw-foo/sw-foo.html.twig"
+
+	require.NoError(t, indexer.SaveComponent(admin.VueComponent{Name: "sw-foo", FilePath: definitionPath, DefinitionPath: definitionPath}))
+	require.NoError(t, indexer.SaveComponentDefinition("/project/src/Resources/app/administration/src/component/sw-foo", admin.ComponentDefinition{
+		FilePath:     definitionPath,
+		TemplatePath: templatePath,
+		Computed:     []string{"total"},
+	}))
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	tests := []struct {
+		name     string
+		code     string
+		cursor   string
+		expected []string
+		ok       bool
+	}{
+		{
+			name:     "interpolation inside v-for",
+			code:     `<div v-for="item in items">{{ to }}</div>`,
+			cursor:   " }}",
+			expected: []string{"item", "total"},
+			ok:       true,
+		},
+		{
+			name:     "bound attribute",
+			code:     `<sw-button :disabled=""></sw-button>`,
+			cursor:   `"></sw-button>`,
+			expected: []string{"total"},
+			ok:       true,
+		},
+		{
+			name:     "member access",
+			code:     `{{ item. }}`,
+			cursor:   " }}",
+			expected: []string{},
+			ok:       true,
+		},
+		{
+			name:     "string literal",
+			code:     `<sw-button v-if="acl.can('product.')"></sw-button>`,
+			cursor:   `')"`,
+			expected: []string{},
+			ok:       true,
+		},
+		{
+			name:   "static attribute",
+			code:   `<sw-button label=""></sw-button>`,
+			cursor: `"></sw-button>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, parser := parseTwig(t, tt.code)
+			defer tree.Close()
+			defer parser.Close()
+
+			params := &protocol.CompletionParams{DocumentContent: []byte(tt.code), Node: tree.RootNode()}
+			params.TextDocument.URI = "file://" + templatePath
+			params.Position.Character = strings.Index(tt.code, tt.cursor)
+
+			items, ok := provider.getTemplateExpressionCompletions(params)
+			assert.Equal(t, tt.ok, ok)
+			if !tt.ok {
+				return
+			}
+
+			labels := []string{}
+			for _, item := range items {
+				labels = append(labels, item.Label)
+			}
+			assert.Equal(t, tt.expected, labels)
+		})
+	}
+}
+
+func TestGetServiceCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	require.NoError(t, indexer.SaveService(admin.AdminService{
+		Name:     "repositoryFactory",
+		Source:   admin.ServiceSourceProvider,
+		FilePath: "/project/src/Administration/Resources/app/administration/src/app/init-pre/api-services.init.js",
+		Line:     2,
+	}))
+
+	code := "Shopware.Component.register('sw-foo', {\n    inject: ['rep'],\n    created() {\n        Shopware.Service('');\n    },\n});\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	params := &protocol.CompletionParams{}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.DocumentContent = []byte(code)
+
+	for _, position := range []struct{ line, character uint }{{1, 15}, {3, 26}} {
+		params.Position.Line = int(position.line)
+		params.Position.Character = int(position.character)
+		params.Node = findNodeAtPosition(tree.RootNode(), position.line, position.character)
+
+		items := provider.GetCompletions(t.Context(), params)
+		require.Len(t, items, 1)
+		assert.Equal(t, "repositoryFactory", items[0].Label)
+		assert.Equal(t, "Application.addServiceProvider", items[0].Detail)
+	}
+
+	params.Position.Line = 0
+	params.Position.Character = 31
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 31)
+	assert.Empty(t, provider.GetCompletions(t.Context(), params))
+}
+
+func TestGetStoreCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	storePath := "/project/src/Resources/app/administration/src/app/store/index.js"
+	require.NoError(t, indexer.SaveStore(admin.AdminStore{
+		ID:       "session",
+		Kind:     admin.StoreKindPinia,
+		FilePath: storePath,
+		Line:     1,
+		Definition: &admin.StoreDefinition{
+			FilePath: storePath,
+			Members: []admin.StoreMember{
+				{Name: "currentUser", Kind: admin.StoreMemberState, Line: 3},
+				{Name: "setCurrentUser", Kind: admin.StoreMemberAction, Line: 6},
+			},
+		},
+	}))
+	modulePath := "/project/src/Resources/app/administration/src/module/sw-product/state.js"
+	require.NoError(t, indexer.SaveStore(admin.AdminStore{
+		ID:       "swProductDetail",
+		Kind:     admin.StoreKindVuex,
+		FilePath: modulePath,
+		Line:     10,
+		Definition: &admin.StoreDefinition{
+			FilePath: modulePath,
+			Members: []admin.StoreMember{
+				{Name: "product", Kind: admin.StoreMemberState, Line: 12},
+				{Name: "setProduct", Kind: admin.StoreMemberMutation, Line: 15},
+			},
+		},
+	}))
+
+	code := "Shopware.Store.get('');\nShopware.State.commit('');\nmapState('swProductDetail', ['']);\nShopware.Store.get('session').\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	params := &protocol.CompletionParams{}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.DocumentContent = []byte(code)
+
+	labels := func(line, character uint) []string {
+		params.Position.Line = int(line)
+		params.Position.Character = int(character)
+		params.Node = findNodeAtPosition(tree.RootNode(), line, character)
+
+		var result []string
+		for _, item := range provider.GetCompletions(t.Context(), params) {
+			result = append(result, item.Label)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"session"}, labels(0, 20))
+	assert.Equal(t, []string{"swProductDetail/setProduct"}, labels(1, 23))
+	assert.Equal(t, []string{"product"}, labels(2, 30))
+	assert.Equal(t, []string{"currentUser", "setCurrentUser"}, labels(3, 30))
+}
+
+func TestGetRouteCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	require.NoError(t, indexer.SaveRoute(admin.AdminRoute{
+		Name:      "sw.product.detail",
+		Module:    "sw-product",
+		Component: "sw-product-detail",
+		Path:      "detail/:id",
+		FilePath:  "/project/src/Resources/app/administration/src/module/sw-product/index.js",
+		Line:      8,
+	}))
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	code := "this.$router.push({ name: '' });\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.Position.Character = 27
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 27)
+
+	items := provider.GetCompletions(t.Context(), params)
+	require.Len(t, items, 1)
+	assert.Equal(t, "sw.product.detail", items[0].Label)
+	assert.Equal(t, "sw-product-detail", items[0].Detail)
+
+	template := `<router-link :to="{ name: 'sw.pro' }"></router-link>`
+	templateTree, twigParser := parseTwig(t, template)
+	defer templateTree.Close()
+	defer twigParser.Close()
+
+	params = &protocol.CompletionParams{DocumentContent: []byte(template), Node: templateTree.RootNode()}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/sw-foo.html.twig"
+	params.Position.Character = strings.Index(template, "' }")
+
+	items = provider.twigCompletions(t.Context(), params)
+	require.Len(t, items, 1)
+	assert.Equal(t, "sw.product.detail", items[0].Label)
+}
+
+func TestGetMixinCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	require.NoError(t, indexer.SaveMixin(admin.VueMixin{
+		Name:     "notification",
+		FilePath: "/project/src/Resources/app/administration/src/app/mixin/notification.mixin.js",
+		Line:     3,
+	}))
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	code := "Shopware.Component.register('sw-foo', { mixins: [Mixin.getByName('')] });\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.Position.Character = 66
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 66)
+
+	items := provider.GetCompletions(t.Context(), params)
+	require.Len(t, items, 1)
+	assert.Equal(t, "notification", items[0].Label)
+	assert.Equal(t, "mixin", items[0].Detail)
+}
+
+func TestGetEmitCompletions(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	code := "Shopware.Component.register('sw-foo', {\n    emits: ['save', 'close'],\n    methods: { onSave() { this.$emit(''); } },\n});\n"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	filePath := "/project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+
+	provider := &AdminCompletionProvider{adminIndexer: indexer}
+
+	params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file://" + filePath
+	params.Position.Line = 2
+	params.Position.Character = 38
+	params.Node = findNodeAtPosition(tree.RootNode(), 2, 38)
+
+	items := provider.GetCompletions(t.Context(), params)
+
+	labels := []string{}
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	assert.Equal(t, []string{"save", "close"}, labels)
+}
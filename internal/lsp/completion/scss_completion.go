@@ -0,0 +1,112 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+var (
+	// scssIncludeRegex matches a mixin name being typed after @include
+	scssIncludeRegex = regexp.MustCompile(`@include\s+[\w-]*$`)
+
+	// scssExtendRegex matches a placeholder being typed after @extend
+	scssExtendRegex = regexp.MustCompile(`@extend\s+%?[\w-]*$`)
+
+	// scssVariableRegex matches a variable being typed
+	scssVariableRegex = regexp.MustCompile(`\$[\w-]*$`)
+
+	// scssValueRegex matches a property or variable value being typed, where functions can be called
+	scssValueRegex = regexp.MustCompile(`[\w-]\s*:\s*[^;{}]*[\s(,:][\w-]*$`)
+)
+
+// ScssCompletionProvider provides completions for SCSS variables, mixins, functions and placeholders
+type ScssCompletionProvider struct {
+	scssIndexer *scss.ScssIndexer
+}
+
+// NewScssCompletionProvider creates a new SCSS completion provider
+func NewScssCompletionProvider(lspServer *lsp.Server) *ScssCompletionProvider {
+	scssIndexer, _ := lspServer.GetIndexer("scss.indexer")
+	return &ScssCompletionProvider{
+		scssIndexer: scssIndexer.(*scss.ScssIndexer),
+	}
+}
+
+// GetCompletions returns variables after $, mixins after @include, placeholders after @extend and functions in values
+func (p *ScssCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.CompletionItem{}
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+	textBeforeCursor := string(params.DocumentContent[:offset])
+	if lineStart := strings.LastIndexByte(textBeforeCursor, '\n'); lineStart != -1 {
+		textBeforeCursor = textBeforeCursor[lineStart+1:]
+	}
+
+	switch {
+	case scssIncludeRegex.MatchString(textBeforeCursor):
+		return p.getSymbolCompletions(scss.SymbolMixin)
+	case scssExtendRegex.MatchString(textBeforeCursor):
+		return p.getSymbolCompletions(scss.SymbolPlaceholder)
+	case scssVariableRegex.MatchString(textBeforeCursor):
+		return p.getSymbolCompletions(scss.SymbolVariable)
+	case scssValueRegex.MatchString(textBeforeCursor):
+		return p.getSymbolCompletions(scss.SymbolFunction)
+	}
+
+	return []protocol.CompletionItem{}
+}
+
+// getSymbolCompletions returns one item per symbol name of the given kind, documented with its first declaration
+func (p *ScssCompletionProvider) getSymbolCompletions(kind scss.SymbolKind) []protocol.CompletionItem {
+	symbols, err := p.scssIndexer.GetAllSymbols(kind)
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	items := []protocol.CompletionItem{}
+	seen := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		if seen[symbol.Name] {
+			continue
+		}
+		seen[symbol.Name] = true
+
+		item := protocol.CompletionItem{
+			Label: symbol.Label(),
+		}
+
+		switch kind {
+		case scss.SymbolVariable:
+			item.Kind = int(protocol.VariableCompletion)
+			if !strings.Contains(symbol.Value, "\n") {
+				item.Detail = symbol.Value
+			}
+		case scss.SymbolMixin, scss.SymbolFunction:
+			item.Kind = int(protocol.FunctionCompletion)
+			item.Detail = symbol.Name + symbol.Value
+		case scss.SymbolPlaceholder:
+			item.Kind = int(protocol.ClassCompletion)
+		}
+
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = "**Defined in:** `" + filepath.Base(symbol.FilePath) + "`\n"
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *ScssCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"$", "%"}
+}
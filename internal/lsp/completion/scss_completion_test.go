@@ -0,0 +1,60 @@
+package completion
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestScssCompletionProvider(t *testing.T) {
+	indexer, err := scss.NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	variables := `$primary: #0042a0 !default;
+@mixin button-size($padding-y, $padding-x) {}
+@function tint-color($color, $weight) { @return $color; }
+%btn-base { display: block; }
+`
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())))
+	tree := parser.Parse([]byte(variables), nil)
+	defer tree.Close()
+	require.NoError(t, indexer.Index("/project/vendor/shopware/storefront/Resources/app/storefront/src/scss/abstract/_variables.scss", tree.RootNode(), []byte(variables)))
+
+	provider := &ScssCompletionProvider{scssIndexer: indexer}
+
+	complete := func(text string) []protocol.CompletionItem {
+		params := &protocol.CompletionParams{DocumentContent: []byte(text)}
+		params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+		params.Position.Line = 1
+		params.Position.Character = len(text) - len(".btn {\n")
+		return provider.GetCompletions(t.Context(), params)
+	}
+
+	items := complete(".btn {\n  color: $pri")
+	require.Len(t, items, 1)
+	assert.Equal(t, "$primary", items[0].Label)
+	assert.Equal(t, "#0042a0", items[0].Detail)
+
+	items = complete(".btn {\n  @include but")
+	require.Len(t, items, 1)
+	assert.Equal(t, "button-size", items[0].Label)
+	assert.Equal(t, "button-size($padding-y, $padding-x)", items[0].Detail)
+
+	items = complete(".btn {\n  @extend %")
+	require.Len(t, items, 1)
+	assert.Equal(t, "%btn-base", items[0].Label)
+
+	items = complete(".btn {\n  color: ti")
+	require.Len(t, items, 1)
+	assert.Equal(t, "tint-color", items[0].Label)
+
+	assert.Empty(t, complete(".btn {\n  .link"))
+}
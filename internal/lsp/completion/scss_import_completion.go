@@ -0,0 +1,134 @@
+package completion
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// scssImportRegex matches an import path being typed in @import, @use or @forward
+var scssImportRegex = regexp.MustCompile(`@(?:import|use|forward)\s+(?:[^;]*,\s*)?["']([^"']*)$`)
+
+// ScssImportCompletionProvider provides completions for import paths in SCSS files
+type ScssImportCompletionProvider struct {
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewScssImportCompletionProvider creates a new SCSS import completion provider
+func NewScssImportCompletionProvider(projectRoot string, lspServer *lsp.Server) *ScssImportCompletionProvider {
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	return &ScssImportCompletionProvider{
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetCompletions returns the directories and stylesheets of the typed import path. Partials are offered
+// without underscore and extension, and at the start of the path the ~aliases and @Bundle prefixes.
+func (p *ScssImportCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.CompletionItem{}
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+	textBeforeCursor := string(params.DocumentContent[:offset])
+	if lineStart := strings.LastIndexByte(textBeforeCursor, '\n'); lineStart != -1 {
+		textBeforeCursor = textBeforeCursor[lineStart+1:]
+	}
+
+	match := scssImportRegex.FindStringSubmatch(textBeforeCursor)
+	if match == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	extensions, _ := p.extensionIndexer.GetAll()
+	resolver := scss.NewResolver(p.projectRoot, extensions)
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+
+	typed := match[1]
+	directory := typed[:strings.LastIndexByte(typed, '/')+1]
+
+	// The typed segment after the last / is replaced
+	replace := protocol.Range{
+		Start: protocol.Position{Line: params.Position.Line, Character: params.Position.Character - len(typed) + len(directory)},
+		End:   protocol.Position{Line: params.Position.Line, Character: params.Position.Character},
+	}
+
+	items := []protocol.CompletionItem{}
+	if directory == "" {
+		replace.Start.Character = params.Position.Character - len(typed)
+		for _, alias := range resolver.Aliases(filePath) {
+			items = append(items, importCompletionItem(alias, int(protocol.FolderCompletion), replace))
+		}
+	}
+
+	dir, ok := resolver.ResolveDirectory(directory, filePath)
+	if !ok {
+		return items
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return items
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || name == "node_modules" {
+			continue
+		}
+
+		if entry.IsDir() {
+			items = append(items, importCompletionItem(name+"/", int(protocol.FolderCompletion), replace))
+			continue
+		}
+
+		ext := filepath.Ext(name)
+		if ext != ".scss" && ext != ".css" {
+			continue
+		}
+
+		// Sass resolves partials and extensions, so _variables.scss is imported as variables
+		label := strings.TrimPrefix(strings.TrimSuffix(name, ext), "_")
+		if ext == ".css" {
+			label = name
+		}
+		if filepath.Join(dir, name) == filePath || seen[label] {
+			continue
+		}
+		seen[label] = true
+
+		item := importCompletionItem(label, int(protocol.FileCompletion), replace)
+		item.Detail = name
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// importCompletionItem creates an item replacing the typed path segment
+func importCompletionItem(label string, kind int, replace protocol.Range) protocol.CompletionItem {
+	return protocol.CompletionItem{
+		Label: label,
+		Kind:  kind,
+		TextEdit: protocol.TextEdit{
+			Range:   replace,
+			NewText: label,
+		},
+	}
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *ScssImportCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"/", "'", "\""}
+}
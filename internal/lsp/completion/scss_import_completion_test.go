@@ -0,0 +1,63 @@
+package completion
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScssImportCompletionProvider(t *testing.T) {
+	root := t.TempDir()
+	pluginScss := filepath.Join(root, "custom/plugins/MyTheme/src/Resources/app/storefront/src/scss")
+	for _, path := range []string{
+		"vendor/shopware/storefront/Resources/app/storefront/src/scss/_variables.scss",
+		"vendor/shopware/storefront/Resources/app/storefront/src/scss/abstract/_mixins.scss",
+		"custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss",
+		"custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/components/_card.scss",
+		"custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/components/README.md",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(""), 0o644))
+	}
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	provider := &ScssImportCompletionProvider{extensionIndexer: extensionIndexer, projectRoot: root}
+
+	complete := func(line string) []protocol.CompletionItem {
+		params := &protocol.CompletionParams{DocumentContent: []byte(line)}
+		params.TextDocument.URI = "file://" + filepath.Join(pluginScss, "base.scss")
+		params.Position.Character = len(line)
+		return provider.GetCompletions(t.Context(), params)
+	}
+
+	labels := func(items []protocol.CompletionItem) []string {
+		var result []string
+		for _, item := range items {
+			result = append(result, item.Label)
+		}
+		return result
+	}
+
+	items := complete(`@import '`)
+	assert.Equal(t, []string{"@Storefront/", "~scss/", "~src/", "~vendor/", "components/"}, labels(items))
+
+	items = complete(`@import 'components/ca`)
+	require.Len(t, items, 1)
+	assert.Equal(t, "card", items[0].Label)
+	assert.Equal(t, "_card.scss", items[0].Detail)
+	edit := items[0].TextEdit.(protocol.TextEdit)
+	assert.Equal(t, len(`@import 'components/`), edit.Range.Start.Character)
+
+	items = complete(`@use "~scss/`)
+	assert.Equal(t, []string{"variables", "abstract/"}, labels(items))
+
+	assert.Empty(t, complete(`.btn { color: red; }`))
+}
@@ -33,7 +33,7 @@ func (s *SnippetCompletionProvider) GetCompletions(ctx context.Context, params *
 		return s.twigCompletion(ctx, params)
 	case ".php":
 		return s.phpCompletion(ctx, params)
-	case ".js", ".ts":
+	case ".js", ".ts", ".tsx", ".mts":
 		return s.jsCompletion(ctx, params)
 	default:
 		return []protocol.CompletionItem{}
@@ -0,0 +1,195 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/storefront"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// StorefrontPluginCompletionProvider provides completions for Storefront JavaScript plugins
+type StorefrontPluginCompletionProvider struct {
+	pluginIndexer *storefront.StorefrontPluginIndexer
+}
+
+// NewStorefrontPluginCompletionProvider creates a new Storefront plugin completion provider
+func NewStorefrontPluginCompletionProvider(server *lsp.Server) *StorefrontPluginCompletionProvider {
+	pluginIndexer, _ := server.GetIndexer("storefront.plugin.indexer")
+
+	return &StorefrontPluginCompletionProvider{
+		pluginIndexer: pluginIndexer.(*storefront.StorefrontPluginIndexer),
+	}
+}
+
+// GetCompletions returns plugin names in PluginManager calls, and plugin data attributes and option keys in Storefront templates
+func (p *StorefrontPluginCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	uri := params.TextDocument.URI
+
+	switch ext := strings.ToLower(filepath.Ext(uri)); {
+	case admin.IsScriptFile(ext):
+		if params.Node != nil && strings.Contains(uri, "Resources/app/storefront/") && storefront.PluginNameAt(params.Node, params.DocumentContent) != nil {
+			return p.getPluginNameCompletions()
+		}
+	case ext == ".twig":
+		if strings.Contains(uri, "Resources/app/administration") {
+			break
+		}
+
+		if params.Node == nil {
+			break
+		}
+
+		offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+		attribute, ok := storefront.TemplateAttributeAt(rootNode(params.Node), params.DocumentContent, offset)
+		if !ok {
+			break
+		}
+
+		if !attribute.InValue {
+			if strings.HasPrefix(attribute.Name, "data-") || strings.HasPrefix("data-", attribute.Name) {
+				return p.getAttributeCompletions()
+			}
+			break
+		}
+
+		if pluginName, ok := storefront.OptionsPluginName(attribute.Name); ok && storefront.OptionKeyAt(attribute.Value) {
+			return p.getOptionCompletions(pluginName)
+		}
+	}
+
+	return []protocol.CompletionItem{}
+}
+
+// getPluginNameCompletions returns all registered plugin names
+func (p *StorefrontPluginCompletionProvider) getPluginNameCompletions() []protocol.CompletionItem {
+	plugins, err := p.pluginIndexer.GetAllPlugins()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	// Registrations sort before overrides, so their selector is shown
+	sort.SliceStable(plugins, func(i, j int) bool {
+		if plugins[i].Name != plugins[j].Name {
+			return plugins[i].Name < plugins[j].Name
+		}
+		return !plugins[i].Override && plugins[j].Override
+	})
+
+	seen := make(map[string]bool, len(plugins))
+	items := make([]protocol.CompletionItem, 0, len(plugins))
+	for _, plugin := range plugins {
+		if seen[plugin.Name] {
+			continue
+		}
+		seen[plugin.Name] = true
+
+		item := protocol.CompletionItem{
+			Label:  plugin.Name,
+			Kind:   int(protocol.ClassCompletion),
+			Detail: plugin.Selector,
+		}
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = "**Registered in:** `" + filepath.Base(plugin.FilePath) + "`\n"
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// getAttributeCompletions returns the selector and options data attributes of all plugins
+func (p *StorefrontPluginCompletionProvider) getAttributeCompletions() []protocol.CompletionItem {
+	plugins, err := p.pluginIndexer.GetAllPlugins()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	sort.SliceStable(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	seen := make(map[string]bool)
+	var items []protocol.CompletionItem
+	for _, plugin := range plugins {
+		for _, attribute := range plugin.Attributes() {
+			if seen[attribute] {
+				continue
+			}
+			seen[attribute] = true
+
+			item := protocol.CompletionItem{
+				Label:  attribute,
+				Kind:   int(protocol.PropertyCompletion),
+				Detail: plugin.Name,
+			}
+			item.Documentation.Kind = "markdown"
+			if attribute == plugin.OptionsAttribute() {
+				item.Documentation.Value = "Options of the `" + plugin.Name + "` plugin as JSON"
+			} else {
+				item.Documentation.Value = "Initializes the `" + plugin.Name + "` plugin (`" + plugin.Selector + "`)"
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	if items == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	return items
+}
+
+// getOptionCompletions returns the option keys of the plugin with the given dashed name
+func (p *StorefrontPluginCompletionProvider) getOptionCompletions(dashedName string) []protocol.CompletionItem {
+	plugins, err := p.pluginIndexer.GetAllPlugins()
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	items := []protocol.CompletionItem{}
+	seen := make(map[string]bool)
+	for _, plugin := range plugins {
+		if storefront.DashCase(plugin.Name) != dashedName || seen[plugin.Name] {
+			continue
+		}
+		seen[plugin.Name] = true
+
+		options, err := p.pluginIndexer.GetPluginOptions(plugin.Name)
+		if err != nil {
+			continue
+		}
+
+		for _, option := range options {
+			item := protocol.CompletionItem{
+				Label: option.Name,
+				Kind:  int(protocol.PropertyCompletion),
+			}
+			if !strings.Contains(option.Default, "\n") {
+				item.Detail = option.Default
+			}
+
+			doc := ""
+			if option.Description != "" {
+				doc = option.Description + "\n\n"
+			}
+			item.Documentation.Kind = "markdown"
+			item.Documentation.Value = doc + "**Plugin:** `" + plugin.Name + "`\n"
+
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *StorefrontPluginCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"'", "\"", "-"}
+}
@@ -0,0 +1,85 @@
+package completion
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/storefront"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorefrontPluginCompletionProvider(t *testing.T) {
+	indexer, err := storefront.NewStorefrontPluginIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	storefrontSrc := "/project/src/Storefront/Resources/app/storefront/src"
+	files := map[string]string{
+		storefrontSrc + "/main.js": `
+import OffCanvasCartPlugin from './plugin/offcanvas-cart.plugin';
+PluginManager.register('OffCanvasCart', OffCanvasCartPlugin, '[data-off-canvas-cart]');
+`,
+		storefrontSrc + "/plugin/offcanvas-cart.plugin.js": `
+export default class OffCanvasCartPlugin extends Plugin {
+    static options = {
+        // selector of the remove button
+        removeProductTriggerSelector: '.js-remove',
+    };
+}
+`,
+	}
+	for filePath, code := range files {
+		tree, parser := parseJS(t, code)
+		require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+		tree.Close()
+		parser.Close()
+	}
+
+	provider := &StorefrontPluginCompletionProvider{pluginIndexer: indexer}
+
+	code := "PluginManager.getPluginInstances('');\n"
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file://" + storefrontSrc + "/plugin/listing.plugin.js"
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 34)
+
+	items := provider.GetCompletions(t.Context(), params)
+	require.Len(t, items, 1)
+	assert.Equal(t, "OffCanvasCart", items[0].Label)
+	assert.Equal(t, "[data-off-canvas-cart]", items[0].Detail)
+
+	template := `<div class="cart" data-off`
+	twigTree, twigParser := parseTwig(t, template)
+	defer twigParser.Close()
+
+	params = &protocol.CompletionParams{DocumentContent: []byte(template), Node: twigTree.RootNode()}
+	params.TextDocument.URI = "file:///project/src/Storefront/Resources/views/storefront/page/checkout/cart.html.twig"
+	params.Position.Character = len(template)
+
+	items = provider.GetCompletions(t.Context(), params)
+	twigTree.Close()
+	require.Len(t, items, 2)
+	assert.Equal(t, "data-off-canvas-cart", items[0].Label)
+	assert.Equal(t, "data-off-canvas-cart-options", items[1].Label)
+
+	template = `<div data-off-canvas-cart-options='{"remove`
+	twigTree = twigParser.Parse([]byte(template), nil)
+	defer twigTree.Close()
+
+	params.DocumentContent = []byte(template)
+	params.Node = twigTree.RootNode()
+	params.Position.Character = len(template)
+
+	items = provider.GetCompletions(t.Context(), params)
+	require.Len(t, items, 1)
+	assert.Equal(t, "removeProductTriggerSelector", items[0].Label)
+	assert.Equal(t, "'.js-remove'", items[0].Detail)
+	assert.Contains(t, items[0].Documentation.Value, "selector of the remove button")
+
+	params.TextDocument.URI = "file:///project/src/Administration/Resources/app/administration/src/sw-foo.html.twig"
+	assert.Empty(t, provider.GetCompletions(t.Context(), params))
+}
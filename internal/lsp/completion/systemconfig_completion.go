@@ -10,6 +10,7 @@ import (
 	"github.com/shopware/shopware-lsp/internal/php"
 	"github.com/shopware/shopware-lsp/internal/systemconfig"
 	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 )
 
 type SystemConfigCompletionProvider struct {
@@ -47,6 +48,14 @@ func (s *SystemConfigCompletionProvider) GetCompletions(ctx context.Context, par
 
 func (s *SystemConfigCompletionProvider) phpCompletion(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
 	if s.phpIndex.IsMethodCalledOnClass(ctx, params.Node, params.DocumentContent, "Shopware\\Core\\System\\SystemConfig\\SystemConfigService") {
+		// Only the first argument is a config key, the value of set() completes the options of the field
+		if call, index := php.MethodCallArgument(params.Node); index > 0 {
+			if index == 1 && s.phpIndex.IsMethodCalledName(ctx, params.Node, params.DocumentContent, "set") {
+				return s.optionCompletions(call, params.DocumentContent)
+			}
+			return nil
+		}
+
 		if s.phpIndex.IsMethodCalledName(ctx, params.Node, params.DocumentContent, "get", "getInt", "getString", "getFloat", "getBool", "set") {
 			completions, err := s.indexer.GetAllSystemConfigEntries()
 			if err != nil {
@@ -90,6 +99,35 @@ func (s *SystemConfigCompletionProvider) phpCompletion(ctx context.Context, para
 	return nil
 }
 
+// optionCompletions returns the allowed values of the config key passed as first argument of the call
+func (s *SystemConfigCompletionProvider) optionCompletions(call *tree_sitter.Node, content []byte) []protocol.CompletionItem {
+	key, ok := php.StringArgument(call, 0, content)
+	if !ok {
+		return nil
+	}
+
+	entries, err := s.indexer.GetSystemConfigEntry(key)
+	if err != nil {
+		return nil
+	}
+
+	var completionItems []protocol.CompletionItem
+	for _, entry := range entries {
+		for _, option := range entry.Options {
+			item := protocol.CompletionItem{
+				Label: option.ID,
+				Kind:  int(protocol.EnumMemberCompletion),
+			}
+			if name, ok := option.Names[systemconfig.DefaultLocale]; ok {
+				item.Detail = name
+			}
+			completionItems = append(completionItems, item)
+		}
+	}
+
+	return completionItems
+}
+
 func (s *SystemConfigCompletionProvider) GetTriggerCharacters() []string {
 	return []string{}
 }
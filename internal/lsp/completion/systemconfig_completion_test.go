@@ -0,0 +1,108 @@
+package completion
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_xml "github.com/tree-sitter-grammars/tree-sitter-xml/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+const systemConfigModeXML = `<?xml version="1.0" encoding="UTF-8"?>
+<config xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:noNamespaceSchemaLocation="https://raw.githubusercontent.com/shopware/shopware/trunk/src/Core/System/SystemConfig/Schema/config.xsd">
+    <card>
+        <input-field type="single-select">
+            <name>mode</name>
+            <options>
+                <option>
+                    <id>fast</id>
+                    <name>Fast</name>
+                </option>
+                <option>
+                    <id>safe</id>
+                    <name>Safe</name>
+                </option>
+            </options>
+        </input-field>
+    </card>
+</config>`
+
+const systemConfigSubscriber = `<?php
+
+namespace App;
+
+use Shopware\Core\System\SystemConfig\SystemConfigService;
+
+class ModeSubscriber
+{
+    public function __construct(private SystemConfigService $systemConfigService)
+    {
+    }
+
+    public function update(): void
+    {
+        $this->systemConfigService->set('core.basicInformation.mode', 'f');
+    }
+}
+`
+
+func TestSystemConfigCompletionSetOptions(t *testing.T) {
+	tempDir := t.TempDir()
+
+	phpIndex, err := php.NewPHPIndex(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = phpIndex.Close() }()
+
+	configIndexer, err := systemconfig.NewSystemConfigIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = configIndexer.Close() }()
+
+	xmlParser := tree_sitter.NewParser()
+	defer xmlParser.Close()
+	require.NoError(t, xmlParser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML())))
+	xmlTree := xmlParser.Parse([]byte(systemConfigModeXML), nil)
+	defer xmlTree.Close()
+	require.NoError(t, configIndexer.Index(filepath.Join(tempDir, "basicInformation.xml"), xmlTree.RootNode(), []byte(systemConfigModeXML)))
+
+	phpPath := filepath.Join(tempDir, "ModeSubscriber.php")
+	indexPHP(t, []func(string, *tree_sitter.Node, []byte) error{phpIndex.Index}, phpPath, systemConfigSubscriber)
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+	tree := parser.Parse([]byte(systemConfigSubscriber), nil)
+	defer tree.Close()
+
+	provider := &SystemConfigCompletionProvider{indexer: configIndexer, phpIndex: phpIndex}
+
+	complete := func(needle string) []protocol.CompletionItem {
+		offset := strings.Index(systemConfigSubscriber, needle)
+		require.GreaterOrEqual(t, offset, 0)
+		lines := strings.Split(systemConfigSubscriber[:offset+1], "\n")
+
+		params := &protocol.CompletionParams{DocumentContent: []byte(systemConfigSubscriber)}
+		params.TextDocument.URI = "file://" + phpPath
+		params.Node = findNodeAtPosition(tree.RootNode(), uint(len(lines)-1), uint(len(lines[len(lines)-1])))
+		require.NotNil(t, params.Node)
+
+		ctx := phpIndex.AddContext(t.Context(), params.Node, params.DocumentContent)
+		return provider.GetCompletions(ctx, params)
+	}
+
+	values := complete("f')")
+	require.Len(t, values, 2)
+	assert.Equal(t, "fast", values[0].Label)
+	assert.Equal(t, "Fast", values[0].Detail)
+	assert.Equal(t, "safe", values[1].Label)
+
+	keys := complete("core.basicInformation.mode")
+	require.Len(t, keys, 1)
+	assert.Equal(t, "core.basicInformation.mode", keys[0].Label)
+}
@@ -0,0 +1,96 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// SystemConfigXMLCompletionProvider provides completions inside system config.xml files
+type SystemConfigXMLCompletionProvider struct {
+	adminIndexer *admin.AdminComponentIndexer
+}
+
+// NewSystemConfigXMLCompletionProvider creates a new config.xml completion provider
+func NewSystemConfigXMLCompletionProvider(lspServer *lsp.Server) *SystemConfigXMLCompletionProvider {
+	adminIndexer, _ := lspServer.GetIndexer("admin.component.indexer")
+	return &SystemConfigXMLCompletionProvider{
+		adminIndexer: adminIndexer.(*admin.AdminComponentIndexer),
+	}
+}
+
+// GetCompletions returns the field types for <input-field type=""> and the admin components for
+// <component name="">
+func (p *SystemConfigXMLCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if params.Node == nil || strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".xml" || !systemconfig.IsSystemConfigXML(params.DocumentContent) {
+		return []protocol.CompletionItem{}
+	}
+
+	element, attribute := xmlAttributeAt(params.Node, params.DocumentContent)
+
+	var items []protocol.CompletionItem
+
+	switch {
+	case element == "input-field" && attribute == "type":
+		for _, fieldType := range systemconfig.FieldTypes() {
+			items = append(items, protocol.CompletionItem{
+				Label:  fieldType,
+				Kind:   int(protocol.EnumMemberCompletion),
+				Detail: systemconfig.FieldValueType(fieldType),
+			})
+		}
+	case element == "component" && attribute == "name":
+		names, err := p.adminIndexer.GetAllComponentNames()
+		if err != nil {
+			return []protocol.CompletionItem{}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			items = append(items, protocol.CompletionItem{
+				Label: name,
+				Kind:  int(protocol.ClassCompletion),
+			})
+		}
+	}
+
+	return items
+}
+
+// xmlAttributeAt returns the element and attribute name of the attribute value containing node
+func xmlAttributeAt(node *tree_sitter.Node, content []byte) (string, string) {
+	for current := node; current != nil; current = current.Parent() {
+		if current.Kind() != "Attribute" {
+			continue
+		}
+
+		tag := current.Parent()
+		value := treesitterhelper.GetFirstNodeOfKind(current, "AttValue")
+		if tag == nil || value == nil || node.StartByte() < value.StartByte() {
+			return "", ""
+		}
+
+		attributeName := treesitterhelper.GetFirstNodeOfKind(current, "Name")
+		elementName := treesitterhelper.GetFirstNodeOfKind(tag, "Name")
+		if attributeName == nil || elementName == nil {
+			return "", ""
+		}
+
+		return string(elementName.Utf8Text(content)), string(attributeName.Utf8Text(content))
+	}
+
+	return "", ""
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *SystemConfigXMLCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"\""}
+}
@@ -0,0 +1,67 @@
+package completion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_xml "github.com/tree-sitter-grammars/tree-sitter-xml/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestSystemConfigXMLCompletionProvider(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	require.NoError(t, adminIndexer.SaveComponent(admin.VueComponent{Name: "sw-entity-single-select", FilePath: "/select.js"}))
+	require.NoError(t, adminIndexer.SaveComponent(admin.VueComponent{Name: "sw-media-field", FilePath: "/media.js"}))
+
+	code := `<?xml version="1.0" encoding="UTF-8"?>
+<config xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:noNamespaceSchemaLocation="https://raw.githubusercontent.com/shopware/shopware/trunk/src/Core/System/SystemConfig/Schema/config.xsd">
+    <card>
+        <input-field type="bo">
+            <name>enabled</name>
+        </input-field>
+        <component name="sw-">
+            <name>category</name>
+        </component>
+    </card>
+</config>`
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML())))
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	provider := &SystemConfigXMLCompletionProvider{adminIndexer: adminIndexer}
+
+	complete := func(uri, needle string) []string {
+		offset := strings.Index(code, needle) + len(needle)
+		lines := strings.Split(code[:offset], "\n")
+
+		params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+		params.TextDocument.URI = uri
+		params.Node = findNodeAtPosition(tree.RootNode(), uint(len(lines)-1), uint(len(lines[len(lines)-1])))
+
+		var labels []string
+		for _, item := range provider.GetCompletions(t.Context(), params) {
+			labels = append(labels, item.Label)
+		}
+		return labels
+	}
+
+	uri := "file:///project/custom/plugins/SwagExample/src/Resources/config/config.xml"
+
+	types := complete(uri, `type="bo`)
+	assert.Contains(t, types, "bool")
+	assert.Contains(t, types, "single-select")
+
+	assert.Equal(t, []string{"sw-entity-single-select", "sw-media-field"}, complete(uri, `name="sw-`))
+	assert.Empty(t, complete(uri, `<name>enab`))
+	assert.Empty(t, complete("file:///project/config/config.yaml", `name="sw-`))
+}
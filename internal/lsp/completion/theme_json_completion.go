@@ -0,0 +1,148 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// themeBooleanPropertyRegex matches the value of a boolean field property being typed
+var themeBooleanPropertyRegex = regexp.MustCompile(`"(?:editable|scss|fullWidth)"\s*:\s*\w*$`)
+
+// ThemeJSONCompletionProvider provides completions inside theme.json files
+type ThemeJSONCompletionProvider struct {
+	extensionIndexer *extension.ExtensionIndexer
+	themeIndexer     *theme.ThemeConfigIndexer
+	projectRoot      string
+}
+
+// NewThemeJSONCompletionProvider creates a new theme.json completion provider
+func NewThemeJSONCompletionProvider(projectRoot string, lspServer *lsp.Server) *ThemeJSONCompletionProvider {
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
+	return &ThemeJSONCompletionProvider{
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		themeIndexer:     themeIndexer.(*theme.ThemeConfigIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetCompletions returns field types, field properties, editable values, block/section/tab names and the
+// theme references of views, style, script and configInheritance
+func (p *ThemeJSONCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if filepath.Base(params.TextDocument.URI) != "theme.json" {
+		return []protocol.CompletionItem{}
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+	textBeforeCursor := string(params.DocumentContent[:offset])
+	if themeBooleanPropertyRegex.MatchString(textBeforeCursor) {
+		return []protocol.CompletionItem{
+			{Label: "true", Kind: int(protocol.KeywordCompletion)},
+			{Label: "false", Kind: int(protocol.KeywordCompletion)},
+		}
+	}
+
+	if params.Node == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	path, isKey := theme.JSONPath(params.Node, params.DocumentContent)
+	if path == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	isField := len(path) >= 3 && path[0] == "config" && path[1] == "fields"
+
+	switch {
+	case isKey && isField && len(path) == 3:
+		return p.valueCompletions(params.Node, theme.FieldProperties, protocol.PropertyCompletion)
+	case isKey:
+		return []protocol.CompletionItem{}
+	case isField && len(path) == 4 && path[3] == "type":
+		return p.valueCompletions(params.Node, theme.FieldTypes, protocol.EnumMemberCompletion)
+	case isField && len(path) == 4 && theme.GroupProperties[path[3]] != "":
+		return p.valueCompletions(params.Node, p.groupNames(rootNode(params.Node), params.DocumentContent, path[3]), protocol.ReferenceCompletion)
+	case len(path) == 2 && path[1] == "[]":
+		switch path[0] {
+		case "views", "style", "script":
+			return p.valueCompletions(params.Node, p.themeReferences(true), protocol.ModuleCompletion)
+		case "configInheritance":
+			return p.valueCompletions(params.Node, p.themeReferences(false), protocol.ModuleCompletion)
+		}
+	}
+
+	return []protocol.CompletionItem{}
+}
+
+// groupNames returns the blocks, sections or tabs declared in the document and in the theme.json of the Storefront
+func (p *ThemeJSONCompletionProvider) groupNames(root *tree_sitter.Node, content []byte, property string) []string {
+	var names []string
+	for _, group := range theme.ParseConfigGroups(root, content)[property] {
+		names = append(names, group.Name)
+	}
+
+	if storefrontGroups, ok := p.themeIndexer.GetStorefrontGroups(p.projectRoot); ok {
+		names = append(names, storefrontGroups[property]...)
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// themeReferences returns @Name references of all themes, and @Plugins if requested
+func (p *ThemeJSONCompletionProvider) themeReferences(withPlugins bool) []string {
+	var references []string
+	if withPlugins {
+		references = append(references, "@Plugins")
+	}
+
+	extensions, _ := p.extensionIndexer.GetAll()
+	for _, name := range theme.ThemeNames(p.projectRoot, extensions) {
+		references = append(references, "@"+name)
+	}
+
+	return references
+}
+
+// valueCompletions returns items replacing the content of the string node at the cursor
+func (p *ThemeJSONCompletionProvider) valueCompletions(node *tree_sitter.Node, values []string, kind protocol.CompletionItemKind) []protocol.CompletionItem {
+	for node != nil && node.Kind() != "string" {
+		node = node.Parent()
+	}
+
+	items := []protocol.CompletionItem{}
+	for _, value := range values {
+		item := protocol.CompletionItem{
+			Label: value,
+			Kind:  int(kind),
+		}
+
+		if node != nil && node.StartPosition().Row == node.EndPosition().Row {
+			item.TextEdit = protocol.TextEdit{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: int(node.StartPosition().Row), Character: int(node.StartPosition().Column) + 1},
+					End:   protocol.Position{Line: int(node.EndPosition().Row), Character: max(int(node.EndPosition().Column)-1, int(node.StartPosition().Column)+1)},
+				},
+				NewText: value,
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// GetTriggerCharacters returns the characters that trigger this completion provider
+func (p *ThemeJSONCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"\"", "@"}
+}
@@ -0,0 +1,88 @@
+package completion
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+func TestThemeJSONCompletionProvider(t *testing.T) {
+	root := t.TempDir()
+	storefrontTheme := filepath.Join(root, "vendor/shopware/storefront/Resources/theme.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(storefrontTheme), 0o755))
+	storefrontContent := []byte(`{"config": {"blocks": {"themeColors": {}}, "tabs": {"colors": {}}}}`)
+	require.NoError(t, os.WriteFile(storefrontTheme, storefrontContent, 0o644))
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+	require.NoError(t, themeIndexer.Index(storefrontTheme, nil, storefrontContent))
+
+	provider := &ThemeJSONCompletionProvider{extensionIndexer: extensionIndexer, themeIndexer: themeIndexer, projectRoot: root}
+
+	labels := func(items []protocol.CompletionItem) []string {
+		var result []string
+		for _, item := range items {
+			result = append(result, item.Label)
+		}
+		return result
+	}
+
+	// complete places the cursor behind the last character of marker, which has to be inside a string
+	complete := func(code, marker string) []protocol.CompletionItem {
+		parser := tree_sitter.NewParser()
+		defer parser.Close()
+		require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language())))
+		tree := parser.Parse([]byte(code), nil)
+		defer tree.Close()
+
+		offset := strings.Index(code, marker) + len(marker)
+		line := strings.Count(code[:offset], "\n")
+		character := offset - strings.LastIndex(code[:offset], "\n") - 1
+
+		params := &protocol.CompletionParams{DocumentContent: []byte(code)}
+		params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/theme.json"
+		params.Position.Line = line
+		params.Position.Character = character
+		params.Node = findNodeAtPosition(tree.RootNode(), uint(line), uint(character))
+		return provider.GetCompletions(t.Context(), params)
+	}
+
+	code := `{
+  "views": ["@Storefront", ""],
+  "configInheritance": [""],
+  "config": {
+    "blocks": {"myBlock": {}},
+    "fields": {
+      "sw-color-brand": {"type": "", "block": "", "editable": },
+      "sw-font": {"": ""}
+    }
+  }
+}`
+
+	items := complete(code, `"type": "`)
+	assert.Contains(t, labels(items), "color")
+	assert.Contains(t, labels(items), "fontFamily")
+	edit := items[0].TextEdit.(protocol.TextEdit)
+	assert.Equal(t, edit.Range.Start, edit.Range.End)
+
+	assert.Equal(t, []string{"myBlock", "themeColors"}, labels(complete(code, `"block": "`)))
+	assert.Equal(t, []string{"true", "false"}, labels(complete(code, `"editable": `)))
+	assert.Contains(t, labels(complete(code, `"sw-font": {"`)), "label")
+	assert.Empty(t, complete(code, `"sw-font": {"": "`))
+	assert.Equal(t, []string{"@Plugins", "@Storefront"}, labels(complete(code, `"@Storefront", "`)))
+	assert.Equal(t, []string{"@Storefront"}, labels(complete(code, `"configInheritance": ["`)))
+}
@@ -0,0 +1,93 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type TwigMacroCompletionProvider struct {
+	twigIndexer *twig.TwigIndexer
+}
+
+func NewTwigMacroCompletionProvider(lspServer *lsp.Server) *TwigMacroCompletionProvider {
+	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
+
+	return &TwigMacroCompletionProvider{
+		twigIndexer: twigIndexer.(*twig.TwigIndexer),
+	}
+}
+
+func (p *TwigMacroCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".twig" || params.DocumentContent == nil || params.Node == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+	currentTemplate := twig.ConvertToRelativePath(strings.TrimPrefix(params.TextDocument.URI, "file://"))
+
+	macroImport, ok := twig.MacroImportAt(rootNode(params.Node), params.DocumentContent, offset, currentTemplate)
+	if !ok {
+		return []protocol.CompletionItem{}
+	}
+
+	// Macros of an alias are called as forms.input(), macros imported with from are only listed by name
+	return p.macroCompletions(macroImport.TemplatePath, macroImport.Alias != "")
+}
+
+func (p *TwigMacroCompletionProvider) GetTriggerCharacters() []string {
+	return []string{".", " ", ","}
+}
+
+func (p *TwigMacroCompletionProvider) macroCompletions(templatePath string, withArguments bool) []protocol.CompletionItem {
+	macros, err := p.twigIndexer.GetMacros(templatePath)
+	if err != nil {
+		return []protocol.CompletionItem{}
+	}
+
+	completionItems := make([]protocol.CompletionItem, 0, len(macros))
+	for _, macro := range macros {
+		item := protocol.CompletionItem{
+			Label:  macro.Name,
+			Kind:   int(protocol.FunctionCompletion),
+			Detail: macro.Signature(),
+		}
+
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = fmt.Sprintf("Macro defined in `%s`", twig.NormalizeTemplatePath(templatePath))
+
+		if withArguments {
+			placeholders := make([]string, 0, len(macro.Arguments))
+			for i, argument := range macro.Arguments {
+				placeholders = append(placeholders, fmt.Sprintf("${%d:%s}", i+1, argument.Name))
+			}
+
+			item.InsertText = fmt.Sprintf("%s(%s)", macro.Name, strings.Join(placeholders, ", "))
+			item.InsertTextFormat = int(protocol.SnippetTextFormat)
+		}
+
+		completionItems = append(completionItems, item)
+	}
+
+	sort.Slice(completionItems, func(i, j int) bool {
+		return completionItems[i].Label < completionItems[j].Label
+	})
+
+	return completionItems
+}
+
+func rootNode(node *tree_sitter.Node) *tree_sitter.Node {
+	for node.Parent() != nil {
+		node = node.Parent()
+	}
+
+	return node
+}
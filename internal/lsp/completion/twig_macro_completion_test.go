@@ -0,0 +1,54 @@
+package completion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwigMacroCompletion(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	macros := `{% macro input(name, type = 'text') %}{% endmacro %}{% macro label(text) %}{% endmacro %}`
+	macroTree, macroParser := parseTwig(t, macros)
+	defer macroParser.Close()
+	defer macroTree.Close()
+	require.NoError(t, twigIndexer.Index("/project/Resources/views/storefront/macros/forms.html.twig", macroTree.RootNode(), []byte(macros)))
+
+	provider := &TwigMacroCompletionProvider{twigIndexer: twigIndexer}
+
+	complete := func(content string) []protocol.CompletionItem {
+		tree, parser := parseTwig(t, content)
+		defer parser.Close()
+		defer tree.Close()
+
+		params := &protocol.CompletionParams{}
+		params.TextDocument.URI = "file:///project/Resources/views/storefront/page/index.html.twig"
+		params.Position.Character = len(content)
+		params.DocumentContent = []byte(content)
+		params.Node = tree.RootNode()
+
+		return provider.GetCompletions(context.Background(), params)
+	}
+
+	items := complete("{% import '@Storefront/storefront/macros/forms.html.twig' as forms %}{{ forms.")
+	require.Len(t, items, 2)
+	assert.Equal(t, "input", items[0].Label)
+	assert.Equal(t, "input(name, type = 'text')", items[0].Detail)
+	assert.Equal(t, "input(${1:name}, ${2:type})", items[0].InsertText)
+	assert.Equal(t, "label", items[1].Label)
+
+	items = complete("{% from 'storefront/macros/forms.html.twig' import input, ")
+	require.Len(t, items, 2)
+	assert.Equal(t, "label", items[1].Label)
+	assert.Empty(t, items[1].InsertText)
+
+	assert.Empty(t, complete("{% import '@Storefront/storefront/macros/forms.html.twig' as forms %}{{ page.forms."))
+	assert.Empty(t, complete("{{ forms."))
+}
@@ -0,0 +1,202 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/php"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	"github.com/shopware/shopware-lsp/internal/twig"
+)
+
+// maxTemplateVariableDepth limits how many includes are followed to find the type of a variable
+const maxTemplateVariableDepth = 5
+
+type TwigVariableCompletionProvider struct {
+	twigIndexer *twig.TwigIndexer
+	phpIndex    *php.PHPIndex
+}
+
+func NewTwigVariableCompletionProvider(lspServer *lsp.Server) *TwigVariableCompletionProvider {
+	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
+	phpIndex, _ := lspServer.GetIndexer("php.index")
+
+	return &TwigVariableCompletionProvider{
+		twigIndexer: twigIndexer.(*twig.TwigIndexer),
+		phpIndex:    phpIndex.(*php.PHPIndex),
+	}
+}
+
+func (p *TwigVariableCompletionProvider) GetCompletions(ctx context.Context, params *protocol.CompletionParams) []protocol.CompletionItem {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".twig" || params.DocumentContent == nil || params.Node == nil {
+		return []protocol.CompletionItem{}
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+
+	expression, ok := twig.AttributeChainAt(rootNode(params.Node), params.DocumentContent, offset)
+	if !ok {
+		return []protocol.CompletionItem{}
+	}
+
+	templatePath := twig.ConvertToRelativePath(strings.TrimPrefix(params.TextDocument.URI, "file://"))
+	className := p.resolveExpression(ctx, templatePath, expression, 0)
+	if className == "" {
+		return []protocol.CompletionItem{}
+	}
+
+	return p.attributeCompletions(className)
+}
+
+func (p *TwigVariableCompletionProvider) GetTriggerCharacters() []string {
+	return []string{"."}
+}
+
+// resolveExpression returns the class of a Twig expression like page.product inside the given template
+func (p *TwigVariableCompletionProvider) resolveExpression(ctx context.Context, templatePath, expression string, depth int) string {
+	segments := strings.Split(expression, ".")
+
+	className := p.resolveVariable(ctx, templatePath, segments[0], depth)
+	for _, attribute := range segments[1:] {
+		if className == "" {
+			return ""
+		}
+
+		className = p.resolveAttribute(className, attribute)
+	}
+
+	return className
+}
+
+// resolveVariable looks up the class of a root variable passed into the template
+func (p *TwigVariableCompletionProvider) resolveVariable(ctx context.Context, templatePath, name string, depth int) string {
+	if depth > maxTemplateVariableDepth {
+		return ""
+	}
+
+	variables, err := p.twigIndexer.GetTemplateVariables(templatePath)
+	if err != nil {
+		return ""
+	}
+
+	for _, variable := range variables {
+		var className string
+
+		switch {
+		case variable.InheritsContext:
+			className = p.resolveVariable(ctx, variable.SourceTemplate, name, depth+1)
+		case variable.Name != name:
+			continue
+		case variable.Expression != "":
+			className = p.resolveExpression(ctx, variable.SourceTemplate, variable.Expression, depth+1)
+		default:
+			className = php.ClassNameOfType(p.phpIndex.ResolveTypeChain(variable.Type))
+		}
+
+		if className != "" {
+			return className
+		}
+	}
+
+	return ""
+}
+
+// resolveAttribute returns the class of page.product the same way Twig resolves attributes:
+// public property, method, getter, isser and hasser
+func (p *TwigVariableCompletionProvider) resolveAttribute(className, attribute string) string {
+	methods, properties := p.phpIndex.GetPublicMembers(className)
+
+	if property, ok := properties[attribute]; ok {
+		return php.ClassNameOfType(property.Type)
+	}
+
+	for _, methodName := range []string{attribute, "get" + upperFirst(attribute), "is" + upperFirst(attribute), "has" + upperFirst(attribute)} {
+		for name := range methods {
+			if strings.EqualFold(name, methodName) {
+				return php.ClassNameOfType(p.phpIndex.GetMethodReturnType(className, name))
+			}
+		}
+	}
+
+	return ""
+}
+
+func (p *TwigVariableCompletionProvider) attributeCompletions(className string) []protocol.CompletionItem {
+	methods, properties := p.phpIndex.GetPublicMembers(className)
+	seen := make(map[string]struct{})
+
+	var completionItems []protocol.CompletionItem
+
+	for _, property := range properties {
+		seen[property.Name] = struct{}{}
+
+		item := protocol.CompletionItem{
+			Label: property.Name,
+			Kind:  int(protocol.PropertyCompletion),
+		}
+
+		if property.Type != nil {
+			item.Detail = property.Type.Name()
+		}
+
+		completionItems = append(completionItems, item)
+	}
+
+	for _, method := range methods {
+		if strings.HasPrefix(method.Name, "__") || strings.HasPrefix(method.Name, "set") {
+			continue
+		}
+
+		label := twigAttributeName(method.Name)
+		if _, ok := seen[label]; ok {
+			continue
+		}
+		seen[label] = struct{}{}
+
+		item := protocol.CompletionItem{
+			Label: label,
+			Kind:  int(protocol.MethodCompletion),
+		}
+
+		if method.ReturnType != nil {
+			item.Detail = method.ReturnType.Name()
+		}
+
+		item.Documentation.Kind = "markdown"
+		item.Documentation.Value = fmt.Sprintf("`%s::%s()`", className, method.Name)
+
+		completionItems = append(completionItems, item)
+	}
+
+	sort.Slice(completionItems, func(i, j int) bool {
+		return completionItems[i].Label < completionItems[j].Label
+	})
+
+	return completionItems
+}
+
+// twigAttributeName converts getProduct to product, other methods are kept as they are
+func twigAttributeName(methodName string) string {
+	for _, prefix := range []string{"get", "is", "has"} {
+		rest := strings.TrimPrefix(methodName, prefix)
+		if rest != methodName && rest != "" && unicode.IsUpper(rune(rest[0])) {
+			return strings.ToLower(rest[:1]) + rest[1:]
+		}
+	}
+
+	return methodName
+}
+
+func upperFirst(text string) string {
+	if text == "" {
+		return text
+	}
+
+	return strings.ToUpper(text[:1]) + text[1:]
+}
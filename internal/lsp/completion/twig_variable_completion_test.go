@@ -0,0 +1,148 @@
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+const twigVariableController = `<?php
+
+namespace Acme\Controller;
+
+use Acme\Page\ProductPage;
+use Acme\Page\ProductPageLoader;
+
+class ProductController
+{
+    public function __construct(private readonly ProductPageLoader $productPageLoader)
+    {
+    }
+
+    public function index(Request $request): Response
+    {
+        $page = $this->productPageLoader->load($request);
+
+        return $this->renderStorefront('@Storefront/storefront/page/product-detail/index.html.twig', ['page' => $page]);
+    }
+}
+`
+
+const twigVariablePages = `<?php
+
+namespace Acme\Page;
+
+class ProductPageLoader
+{
+    public function load(Request $request): ProductPage
+    {
+    }
+}
+
+class ProductPage
+{
+    public function getProduct(): ?Product
+    {
+    }
+
+    public function isEmpty(): bool
+    {
+    }
+
+    public function setProduct(Product $product): void
+    {
+    }
+}
+
+class Product
+{
+    public string $productNumber;
+
+    protected string $internal;
+
+    public function getName(): string
+    {
+    }
+
+    public function hasStock(): bool
+    {
+    }
+}
+`
+
+func indexPHP(t *testing.T, indexers []func(string, *tree_sitter.Node, []byte) error, filePath, code string) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	for _, index := range indexers {
+		require.NoError(t, index(filePath, tree.RootNode(), []byte(code)))
+	}
+}
+
+func TestTwigVariableCompletion(t *testing.T) {
+	tempDir := t.TempDir()
+
+	phpIndex, err := php.NewPHPIndex(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = phpIndex.Close() }()
+
+	twigIndexer, err := twig.NewTwigIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	indexers := []func(string, *tree_sitter.Node, []byte) error{phpIndex.Index, twigIndexer.Index}
+	indexPHP(t, indexers, filepath.Join(tempDir, "ProductController.php"), twigVariableController)
+	indexPHP(t, indexers, filepath.Join(tempDir, "Pages.php"), twigVariablePages)
+
+	includeTemplate := "{% sw_include '@Storefront/storefront/component/buy-widget.html.twig' with { item: page.product } %}"
+	includeTree, includeParser := parseTwig(t, includeTemplate)
+	defer includeParser.Close()
+	defer includeTree.Close()
+	require.NoError(t, twigIndexer.Index("/project/Resources/views/storefront/page/product-detail/index.html.twig", includeTree.RootNode(), []byte(includeTemplate)))
+
+	provider := &TwigVariableCompletionProvider{
+		twigIndexer: twigIndexer,
+		phpIndex:    phpIndex,
+	}
+
+	complete := func(uri, content string) []string {
+		tree, parser := parseTwig(t, content)
+		defer parser.Close()
+		defer tree.Close()
+
+		params := &protocol.CompletionParams{}
+		params.TextDocument.URI = uri
+		params.Position.Character = len(content)
+		params.DocumentContent = []byte(content)
+		params.Node = tree.RootNode()
+
+		var labels []string
+		for _, item := range provider.GetCompletions(context.Background(), params) {
+			labels = append(labels, item.Label)
+		}
+		return labels
+	}
+
+	pageURI := "file:///project/Resources/views/storefront/page/product-detail/index.html.twig"
+	assert.Equal(t, []string{"empty", "product"}, complete(pageURI, "{{ page."))
+	assert.Equal(t, []string{"name", "productNumber", "stock"}, complete(pageURI, "{% if page.product."))
+	assert.Empty(t, complete(pageURI, "{{ page.product }} page."))
+	assert.Empty(t, complete(pageURI, "{{ unknown."))
+	assert.Empty(t, complete(pageURI, "{{ 'page."))
+
+	widgetURI := "file:///project/Resources/views/storefront/component/buy-widget.html.twig"
+	assert.Equal(t, []string{"name", "productNumber", "stock"}, complete(widgetURI, "{{ item.na"))
+	assert.Equal(t, []string{"empty", "product"}, complete(widgetURI, "{{ page."))
+}
@@ -36,7 +36,7 @@ func (p *AdminDefinitionProvider) GetDefinition(ctx context.Context, params *pro
 	ext := strings.ToLower(filepath.Ext(params.TextDocument.URI))
 
 	// Handle JS/TS files
-	if ext == ".js" || ext == ".ts" {
+	if admin.IsScriptFile(ext) {
 		return p.jsDefinition(ctx, params)
 	}
 
@@ -67,6 +67,19 @@ func (p *AdminDefinitionProvider) twigDefinition(_ context.Context, params *prot
 		return p.propDefinition(node, content)
 	}
 
+	// <router-link :to="{ name: 'sw.product.detail<caret>' }">
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	offset := treesitterhelper.OffsetAt(content, params.Position.Line, params.Position.Character)
+	for _, reference := range admin.TemplateRouteReferences(root, content) {
+		if reference.Offset <= offset && offset <= reference.Offset+len(reference.Name) {
+			return p.routeDefinition(reference.Name)
+		}
+	}
+
 	// <template #default<caret>> or <template #actions<caret>>
 	// cursor on slot name (# shorthand parsed as inline_comment or vue_directive)
 	if p.isSlotReference(node, content) {
@@ -83,6 +96,11 @@ func (p *AdminDefinitionProvider) componentDefinition(node *tree_sitter.Node, co
 		return []protocol.Location{}
 	}
 
+	return p.componentLocations(componentName)
+}
+
+// componentLocations returns the definition files of a component, or its registration when the file is missing
+func (p *AdminDefinitionProvider) componentLocations(componentName string) []protocol.Location {
 	// Look up the component in the index
 	components, err := p.adminIndexer.GetComponent(componentName)
 	if err != nil || len(components) == 0 {
@@ -410,6 +428,26 @@ func (p *AdminDefinitionProvider) jsDefinition(ctx context.Context, params *prot
 	node := params.Node
 	content := params.DocumentContent
 
+	// this.someMethod - cursor on a member accessed through this
+	if isThisMember(node, content) {
+		return p.thisMemberDefinition(params)
+	}
+
+	// Shopware.Service('repositoryFactory') or inject: ['repositoryFactory']
+	if reference := admin.ServiceReferenceAt(node, content); reference != nil {
+		return p.serviceDefinition(reference.Name)
+	}
+
+	// this.$router.push({ name: 'sw.product.detail' }) or navigation: [{ path: 'sw.product.index' }]
+	if reference := admin.RouteReferenceAt(node, content); reference != nil {
+		return p.routeDefinition(reference.Name)
+	}
+
+	// Shopware.Store.get('session'), mapState('module', ['member']), commit('module/mutation')
+	if reference := admin.StoreReferenceAt(node, content); reference != nil {
+		return p.storeDefinition(reference)
+	}
+
 	// Check if we're on a string that could be a component name
 	if node.Kind() != "string" && node.Kind() != "string_fragment" {
 		return []protocol.Location{}
@@ -467,6 +505,173 @@ func (p *AdminDefinitionProvider) jsDefinition(ctx context.Context, params *prot
 	return locations
 }
 
+// isThisMember checks if the node is the property of a `this.member` expression
+func isThisMember(node *tree_sitter.Node, content []byte) bool {
+	if node.Kind() != "property_identifier" {
+		return false
+	}
+
+	parent := node.Parent()
+	if parent == nil || parent.Kind() != "member_expression" {
+		return false
+	}
+
+	object := parent.ChildByFieldName("object")
+	return object != nil && object.Kind() == "this"
+}
+
+// thisMemberDefinition returns the location of the component, override or mixin defining the member
+func (p *AdminDefinitionProvider) thisMemberDefinition(params *protocol.DefinitionParams) []protocol.Location {
+	node := params.Node
+	memberName := string(node.Utf8Text(params.DocumentContent))
+
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+	scriptContext := p.adminIndexer.GetScriptContext(filePath, root, params.DocumentContent, node.StartByte())
+
+	members, err := p.adminIndexer.GetScriptMembers(scriptContext)
+	if err != nil {
+		return []protocol.Location{}
+	}
+
+	for _, member := range members {
+		if member.Name != memberName || member.FilePath == "" {
+			continue
+		}
+
+		line := max(member.Line-1, 0)
+
+		return []protocol.Location{
+			{
+				URI: fmt.Sprintf("file://%s", member.FilePath),
+				Range: protocol.Range{
+					Start: protocol.Position{Line: line, Character: 0},
+					End:   protocol.Position{Line: line, Character: 0},
+				},
+			},
+		}
+	}
+
+	return []protocol.Location{}
+}
+
+// serviceDefinition returns the locations where a service is registered
+func (p *AdminDefinitionProvider) serviceDefinition(name string) []protocol.Location {
+	services, err := p.adminIndexer.GetService(name)
+	if err != nil {
+		return []protocol.Location{}
+	}
+
+	locations := make([]protocol.Location, 0, len(services))
+	for _, service := range services {
+		line := max(service.Line-1, 0)
+		locations = append(locations, protocol.Location{
+			URI: fmt.Sprintf("file://%s", service.FilePath),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: line, Character: 0},
+				End:   protocol.Position{Line: line, Character: 0},
+			},
+		})
+	}
+
+	return locations
+}
+
+// routeDefinition returns the component rendered by a route, or the route definition if the component is unknown
+func (p *AdminDefinitionProvider) routeDefinition(name string) []protocol.Location {
+	routes, err := p.adminIndexer.GetRoute(name)
+	if err != nil {
+		return []protocol.Location{}
+	}
+
+	var locations []protocol.Location
+	for _, route := range routes {
+		if route.Component != "" {
+			if componentLocations := p.componentLocations(route.Component); len(componentLocations) > 0 {
+				locations = append(locations, componentLocations...)
+				continue
+			}
+		}
+
+		line := max(route.Line-1, 0)
+		locations = append(locations, protocol.Location{
+			URI: fmt.Sprintf("file://%s", route.FilePath),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: line, Character: 0},
+				End:   protocol.Position{Line: line, Character: 0},
+			},
+		})
+	}
+
+	return locations
+}
+
+// storeDefinition returns the location of a store registration or store member
+func (p *AdminDefinitionProvider) storeDefinition(reference *admin.StoreReference) []protocol.Location {
+	storeID, memberName := reference.Store, reference.Name
+	switch reference.Kind {
+	case admin.StoreReferenceID, admin.StoreReferenceModule:
+		return p.storeRegistrationDefinition(reference.Name, reference.StoreKind)
+	case admin.StoreReferencePath:
+		memberName = strings.TrimPrefix(reference.Name, storeID+"/")
+	}
+
+	members, filePath := p.adminIndexer.GetStoreMembers(storeID, reference.StoreKind, reference.MemberKinds...)
+	for _, member := range members {
+		if member.Name != memberName {
+			continue
+		}
+
+		line := max(member.Line-1, 0)
+
+		return []protocol.Location{
+			{
+				URI: fmt.Sprintf("file://%s", filePath),
+				Range: protocol.Range{
+					Start: protocol.Position{Line: line, Character: 0},
+					End:   protocol.Position{Line: line, Character: 0},
+				},
+			},
+		}
+	}
+
+	return []protocol.Location{}
+}
+
+// storeRegistrationDefinition returns the registration of a Pinia store or Vuex module, or its imported definition file
+func (p *AdminDefinitionProvider) storeRegistrationDefinition(id string, kind admin.AdminStoreKind) []protocol.Location {
+	stores, err := p.adminIndexer.GetStore(id)
+	if err != nil {
+		return []protocol.Location{}
+	}
+
+	var locations []protocol.Location
+	for _, store := range stores {
+		if store.Kind != kind {
+			continue
+		}
+
+		targetPath, line := store.FilePath, max(store.Line-1, 0)
+		if store.DefinitionPath != "" && fileExists(store.DefinitionPath) {
+			targetPath, line = store.DefinitionPath, 0
+		}
+
+		locations = append(locations, protocol.Location{
+			URI: fmt.Sprintf("file://%s", targetPath),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: line, Character: 0},
+				End:   protocol.Position{Line: line, Character: 0},
+			},
+		})
+	}
+
+	return locations
+}
+
 // isInComponentCall checks if the node is within a Component.register/extend call
 // Component.extend('<caret>', 'parent', ...) or Component.register('<caret>', ...)
 func (p *AdminDefinitionProvider) isInComponentCall(node *tree_sitter.Node, content []byte) bool {
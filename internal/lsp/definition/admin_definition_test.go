@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
 )
@@ -210,3 +212,174 @@ func TestKebabToCamel(t *testing.T) {
 		})
 	}
 }
+
+func TestThisMemberDefinition(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	mixinPath := "/project/src/Resources/app/administration/src/mixin/notification.mixin.js"
+	require.NoError(t, indexer.SaveMixin(admin.VueMixin{
+		Name:     "notification",
+		FilePath: mixinPath,
+		Definition: admin.ComponentDefinition{
+			FilePath:    mixinPath,
+			Methods:     []string{"createNotificationError"},
+			MemberLines: map[string]int{"createNotificationError": 5},
+		},
+	}))
+
+	code := `Component.register('sw-foo', {
+    mixins: [Mixin.getByName('notification')],
+    methods: {
+        onSave() {
+            this.createNotificationError({});
+        },
+    },
+});`
+	filePath := "/project/src/Resources/app/administration/src/component/sw-foo/index.js"
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	// The registration itself provides the inline definition with the mixin
+	require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+
+	provider := &AdminDefinitionProvider{adminIndexer: indexer}
+
+	node := findNodeAtPosition(tree.RootNode(), 4, 20)
+	require.Equal(t, "property_identifier", node.Kind())
+	assert.True(t, isThisMember(node, []byte(code)))
+
+	params := &protocol.DefinitionParams{DocumentContent: []byte(code), Node: node}
+	params.TextDocument.URI = "file://" + filePath
+
+	locations := provider.GetDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+mixinPath, locations[0].URI)
+	assert.Equal(t, 4, locations[0].Range.Start.Line)
+}
+
+func TestServiceDefinition(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	servicePath := "/project/src/Resources/app/administration/src/main.js"
+	require.NoError(t, indexer.SaveService(admin.AdminService{
+		Name:     "myService",
+		Source:   admin.ServiceSourceRegister,
+		FilePath: servicePath,
+		Line:     3,
+	}))
+
+	code := `Component.register('sw-foo', {
+    inject: ['myService'],
+});`
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	provider := &AdminDefinitionProvider{adminIndexer: indexer}
+
+	params := &protocol.DefinitionParams{DocumentContent: []byte(code), Node: findNodeAtPosition(tree.RootNode(), 1, 15)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+
+	locations := provider.GetDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+servicePath, locations[0].URI)
+	assert.Equal(t, 2, locations[0].Range.Start.Line)
+}
+
+func TestStoreDefinition(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	storePath := "/project/src/Resources/app/administration/src/module/sw-product/state.js"
+	require.NoError(t, indexer.SaveStore(admin.AdminStore{
+		ID:       "swProductDetail",
+		Kind:     admin.StoreKindVuex,
+		FilePath: storePath,
+		Line:     2,
+		Definition: &admin.StoreDefinition{
+			FilePath: storePath,
+			Members: []admin.StoreMember{
+				{Name: "product", Kind: admin.StoreMemberState, Line: 5},
+				{Name: "setProduct", Kind: admin.StoreMemberMutation, Line: 9},
+			},
+		},
+	}))
+
+	code := `Shopware.State.commit('swProductDetail/setProduct', {});
+const product = Shopware.State.get('swProductDetail').product;`
+
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	provider := &AdminDefinitionProvider{adminIndexer: indexer}
+
+	definitionAt := func(line, character uint) []protocol.Location {
+		params := &protocol.DefinitionParams{DocumentContent: []byte(code), Node: findNodeAtPosition(tree.RootNode(), line, character)}
+		params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+		return provider.GetDefinition(t.Context(), params)
+	}
+
+	locations := definitionAt(0, 30)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+storePath, locations[0].URI)
+	assert.Equal(t, 8, locations[0].Range.Start.Line)
+
+	locations = definitionAt(1, 40)
+	require.Len(t, locations, 1)
+	assert.Equal(t, 1, locations[0].Range.Start.Line)
+
+	locations = definitionAt(1, 58)
+	require.Len(t, locations, 1)
+	assert.Equal(t, 4, locations[0].Range.Start.Line)
+}
+
+func TestRouteDefinition(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	modulePath := "/project/src/Resources/app/administration/src/module/sw-product/index.js"
+	componentPath := "/project/src/Resources/app/administration/src/module/sw-product/page/sw-product-detail/index.js"
+
+	require.NoError(t, indexer.SaveRoute(admin.AdminRoute{Name: "sw.product.detail", Component: "sw-product-detail", FilePath: modulePath, Line: 8}))
+	require.NoError(t, indexer.SaveComponent(admin.VueComponent{Name: "sw-product-detail", FilePath: componentPath, Line: 3}))
+	require.NoError(t, indexer.SaveRoute(admin.AdminRoute{Name: "sw.product.index", Component: "sw-product-list", FilePath: "/project/other.js", Line: 4}))
+
+	provider := &AdminDefinitionProvider{adminIndexer: indexer}
+
+	code := "this.$router.push({ name: 'sw.product.detail' });"
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.DefinitionParams{DocumentContent: []byte(code), Node: findNodeAtPosition(tree.RootNode(), 0, 30)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+
+	locations := provider.GetDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+componentPath, locations[0].URI)
+	assert.Equal(t, 2, locations[0].Range.Start.Line)
+
+	// The route definition is used when the component is unknown
+	template := `<router-link :to="{ name: 'sw.product.index' }">`
+	templateTree, templateParser := parseTwig(t, template)
+	defer templateTree.Close()
+	defer templateParser.Close()
+
+	params = &protocol.DefinitionParams{DocumentContent: []byte(template), Node: findNodeAtPosition(templateTree.RootNode(), 0, 30)}
+	params.Position.Character = 30
+
+	locations = provider.twigDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file:///project/other.js", locations[0].URI)
+	assert.Equal(t, 3, locations[0].Range.Start.Line)
+}
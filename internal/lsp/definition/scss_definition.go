@@ -0,0 +1,59 @@
+package definition
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+)
+
+// ScssDefinitionProvider provides go-to-definition for SCSS variables, mixins, functions and placeholders
+type ScssDefinitionProvider struct {
+	scssIndexer *scss.ScssIndexer
+}
+
+// NewScssDefinitionProvider creates a new SCSS definition provider
+func NewScssDefinitionProvider(lspServer *lsp.Server) *ScssDefinitionProvider {
+	scssIndexer, _ := lspServer.GetIndexer("scss.indexer")
+	return &ScssDefinitionProvider{
+		scssIndexer: scssIndexer.(*scss.ScssIndexer),
+	}
+}
+
+// GetDefinition returns the declarations of the variable, @include mixin, @extend placeholder or function at the cursor
+func (p *ScssDefinitionProvider) GetDefinition(ctx context.Context, params *protocol.DefinitionParams) []protocol.Location {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.Location{}
+	}
+
+	if params.Node == nil {
+		return []protocol.Location{}
+	}
+
+	reference := scss.ReferenceAt(params.Node, params.DocumentContent)
+	if reference == nil {
+		return []protocol.Location{}
+	}
+
+	symbols, err := p.scssIndexer.GetSymbols(reference.Kind, reference.Name)
+	if err != nil {
+		return []protocol.Location{}
+	}
+
+	locations := []protocol.Location{}
+	for _, symbol := range symbols {
+		locations = append(locations, protocol.Location{
+			URI: fmt.Sprintf("file://%s", symbol.FilePath),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: symbol.Line - 1, Character: 0},
+				End:   protocol.Position{Line: symbol.Line - 1, Character: 0},
+			},
+		})
+	}
+
+	return locations
+}
@@ -0,0 +1,64 @@
+package definition
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseScss(t *testing.T, code string) (*tree_sitter.Tree, *tree_sitter.Parser) {
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())); err != nil {
+		t.Fatal(err)
+	}
+	tree := parser.Parse([]byte(code), nil)
+	return tree, parser
+}
+
+func indexScss(t *testing.T, indexer *scss.ScssIndexer, path, code string) {
+	tree, parser := parseScss(t, code)
+	defer parser.Close()
+	defer tree.Close()
+	require.NoError(t, indexer.Index(path, tree.RootNode(), []byte(code)))
+}
+
+func TestScssDefinitionProvider(t *testing.T) {
+	indexer, err := scss.NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	variablesPath := "/project/vendor/shopware/storefront/Resources/app/storefront/vendor/bootstrap/scss/_variables.scss"
+	indexScss(t, indexer, variablesPath, "$white: #fff !default;\n\n@mixin button-variant($background) {}\n")
+
+	provider := &ScssDefinitionProvider{scssIndexer: indexer}
+
+	code := ".btn {\n  color: $white;\n  @include button-variant($white);\n}\n"
+	tree, parser := parseScss(t, code)
+	defer parser.Close()
+	defer tree.Close()
+
+	definition := func(line, character int) []protocol.Location {
+		params := &protocol.DefinitionParams{DocumentContent: []byte(code)}
+		params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+		params.Position.Line = line
+		params.Position.Character = character
+		params.Node = findNodeAtPosition(tree.RootNode(), uint(line), uint(character))
+		return provider.GetDefinition(t.Context(), params)
+	}
+
+	locations := definition(1, 11)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+variablesPath, locations[0].URI)
+	assert.Equal(t, 0, locations[0].Range.Start.Line)
+
+	locations = definition(2, 14)
+	require.Len(t, locations, 1)
+	assert.Equal(t, 2, locations[0].Range.Start.Line)
+
+	assert.Empty(t, definition(0, 2))
+}
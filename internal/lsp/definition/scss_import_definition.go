@@ -0,0 +1,56 @@
+package definition
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+)
+
+// ScssImportDefinitionProvider provides go-to-definition for import paths in SCSS files
+type ScssImportDefinitionProvider struct {
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewScssImportDefinitionProvider creates a new SCSS import definition provider
+func NewScssImportDefinitionProvider(projectRoot string, lspServer *lsp.Server) *ScssImportDefinitionProvider {
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	return &ScssImportDefinitionProvider{
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetDefinition returns the stylesheet an @import, @use or @forward path at the cursor resolves to
+func (p *ScssImportDefinitionProvider) GetDefinition(ctx context.Context, params *protocol.DefinitionParams) []protocol.Location {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.Location{}
+	}
+
+	imp := scss.ImportAt(params.Node, params.DocumentContent)
+	if imp == nil {
+		return []protocol.Location{}
+	}
+
+	extensions, _ := p.extensionIndexer.GetAll()
+	resolved, _ := scss.NewResolver(p.projectRoot, extensions).Resolve(imp.Path, strings.TrimPrefix(params.TextDocument.URI, "file://"))
+	if resolved == "" {
+		return []protocol.Location{}
+	}
+
+	return []protocol.Location{
+		{
+			URI: fmt.Sprintf("file://%s", resolved),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: 0, Character: 0},
+				End:   protocol.Position{Line: 0, Character: 0},
+			},
+		},
+	}
+}
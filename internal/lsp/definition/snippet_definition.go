@@ -33,7 +33,7 @@ func (s *SnippetDefinitionProvider) GetDefinition(ctx context.Context, params *p
 		return s.twigDefinitions(ctx, params)
 	case ".php":
 		return s.phpDefinitions(ctx, params)
-	case ".js", ".ts":
+	case ".js", ".ts", ".tsx", ".mts":
 		return s.jsDefinitions(ctx, params)
 	default:
 		return []protocol.Location{}
@@ -0,0 +1,97 @@
+package definition
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/storefront"
+)
+
+// StorefrontPluginDefinitionProvider provides go-to-definition for Storefront JavaScript plugins
+type StorefrontPluginDefinitionProvider struct {
+	pluginIndexer *storefront.StorefrontPluginIndexer
+}
+
+// NewStorefrontPluginDefinitionProvider creates a new Storefront plugin definition provider
+func NewStorefrontPluginDefinitionProvider(lspServer *lsp.Server) *StorefrontPluginDefinitionProvider {
+	pluginIndexer, _ := lspServer.GetIndexer("storefront.plugin.indexer")
+
+	return &StorefrontPluginDefinitionProvider{
+		pluginIndexer: pluginIndexer.(*storefront.StorefrontPluginIndexer),
+	}
+}
+
+// GetDefinition returns the plugin classes for a data attribute in a Storefront template or a plugin name in a PluginManager call
+func (p *StorefrontPluginDefinitionProvider) GetDefinition(ctx context.Context, params *protocol.DefinitionParams) []protocol.Location {
+	if params.Node == nil {
+		return []protocol.Location{}
+	}
+
+	uri := params.TextDocument.URI
+	node := params.Node
+	content := params.DocumentContent
+
+	switch ext := strings.ToLower(filepath.Ext(uri)); {
+	case admin.IsScriptFile(ext):
+		if !strings.Contains(uri, "Resources/app/storefront/") {
+			break
+		}
+
+		if nameNode := storefront.PluginNameAt(node, content); nameNode != nil {
+			plugins, err := p.pluginIndexer.GetPlugin(strings.Trim(string(nameNode.Utf8Text(content)), "'\"`"))
+			if err == nil {
+				return p.pluginLocations(plugins)
+			}
+		}
+	case ext == ".twig":
+		if strings.Contains(uri, "Resources/app/administration") || node.Kind() != "html_attribute_name" {
+			break
+		}
+
+		attribute := string(node.Utf8Text(content))
+		if !strings.HasPrefix(attribute, "data-") {
+			break
+		}
+
+		plugins, err := p.pluginIndexer.GetPluginsByAttribute(attribute)
+		if err == nil {
+			return p.pluginLocations(plugins)
+		}
+	}
+
+	return []protocol.Location{}
+}
+
+// pluginLocations returns the plugin class of each registration, or the register call when the class is not indexed
+func (p *StorefrontPluginDefinitionProvider) pluginLocations(plugins []storefront.StorefrontPlugin) []protocol.Location {
+	seen := make(map[string]bool)
+	locations := []protocol.Location{}
+
+	for _, plugin := range plugins {
+		filePath, line := plugin.FilePath, plugin.Line
+		if class := p.pluginIndexer.GetPluginClass(plugin); class != nil {
+			filePath, line = class.FilePath, class.Line
+		}
+
+		key := fmt.Sprintf("%s:%d", filePath, line)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		locations = append(locations, protocol.Location{
+			URI: fmt.Sprintf("file://%s", filePath),
+			Range: protocol.Range{
+				Start: protocol.Position{Line: line - 1, Character: 0},
+				End:   protocol.Position{Line: line - 1, Character: 0},
+			},
+		})
+	}
+
+	return locations
+}
@@ -0,0 +1,66 @@
+package definition
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/storefront"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorefrontPluginDefinitionProvider(t *testing.T) {
+	indexer, err := storefront.NewStorefrontPluginIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	storefrontSrc := "/project/src/Storefront/Resources/app/storefront/src"
+	pluginPath := storefrontSrc + "/plugin/offcanvas-cart.plugin.js"
+	files := map[string]string{
+		storefrontSrc + "/main.js": `
+import OffCanvasCartPlugin from './plugin/offcanvas-cart.plugin';
+PluginManager.register('OffCanvasCart', OffCanvasCartPlugin, '[data-off-canvas-cart]');
+`,
+		pluginPath: `
+export default class OffCanvasCartPlugin extends Plugin {
+    static options = {};
+}
+`,
+	}
+	for filePath, code := range files {
+		tree, parser := parseJS(t, code)
+		require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+		tree.Close()
+		parser.Close()
+	}
+
+	provider := &StorefrontPluginDefinitionProvider{pluginIndexer: indexer}
+
+	template := `<div class="cart" data-off-canvas-cart-options='{}'></div>`
+	tree, parser := parseTwig(t, template)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.DefinitionParams{DocumentContent: []byte(template)}
+	params.TextDocument.URI = "file:///project/src/Storefront/Resources/views/storefront/page/checkout/cart.html.twig"
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 25)
+	require.Equal(t, "html_attribute_name", params.Node.Kind())
+
+	locations := provider.GetDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+pluginPath, locations[0].URI)
+	assert.Equal(t, 1, locations[0].Range.Start.Line)
+
+	code := "PluginManager.override('OffCanvasCart', MyPlugin);\n"
+	jsTree, jsParser := parseJS(t, code)
+	defer jsTree.Close()
+	defer jsParser.Close()
+
+	params = &protocol.DefinitionParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/main.js"
+	params.Node = findNodeAtPosition(jsTree.RootNode(), 0, 26)
+
+	locations = provider.GetDefinition(t.Context(), params)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "file://"+pluginPath, locations[0].URI)
+}
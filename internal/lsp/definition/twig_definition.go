@@ -16,19 +16,24 @@ import (
 )
 
 type TwigDefinitionProvider struct {
-	twigIndexer  *twig.TwigIndexer
-	iconProvider *theme.IconProvider
+	twigIndexer      *twig.TwigIndexer
+	themeIndexer     *theme.ThemeConfigIndexer
+	extensionIndexer *extension.ExtensionIndexer
+	iconProvider     *theme.IconProvider
 }
 
 func NewTwigDefinitionProvider(projectRoot string, lspServer *lsp.Server) *TwigDefinitionProvider {
 	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
 	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
 
 	iconProvider := theme.NewIconProvider(projectRoot, extensionIndexer.(*extension.ExtensionIndexer))
 
 	return &TwigDefinitionProvider{
-		twigIndexer:  twigIndexer.(*twig.TwigIndexer),
-		iconProvider: iconProvider,
+		twigIndexer:      twigIndexer.(*twig.TwigIndexer),
+		themeIndexer:     themeIndexer.(*theme.ThemeConfigIndexer),
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		iconProvider:     iconProvider,
 	}
 }
 
@@ -53,6 +58,12 @@ func (p *TwigDefinitionProvider) twigDefinitions(ctx context.Context, params *pr
 
 		files, _ := p.twigIndexer.GetTwigFilesByRelPath(itemValue)
 
+		// sw_extends and sw_include resolve along the template hierarchy of the themes
+		if treesitterhelper.TwigStringInTagPattern("sw_extends", "sw_include").Matches(params.Node, []byte(params.DocumentContent)) {
+			isExtends := treesitterhelper.TwigStringInTagPattern("sw_extends").Matches(params.Node, []byte(params.DocumentContent))
+			files = p.sortByTemplateHierarchy(files, strings.TrimPrefix(params.TextDocument.URI, "file://"), isExtends)
+		}
+
 		var locations []protocol.Location
 		for _, file := range files {
 			if file.Path == strings.TrimPrefix(params.TextDocument.URI, "file://") {
@@ -77,6 +88,32 @@ func (p *TwigDefinitionProvider) twigDefinitions(ctx context.Context, params *pr
 		return locations
 	}
 
+	currentTemplate := twig.ConvertToRelativePath(strings.TrimPrefix(params.TextDocument.URI, "file://"))
+	if templatePath, macroName, ok := twig.ResolveMacroAtNode(params.Node, params.DocumentContent, currentTemplate); ok {
+		macros, _ := p.twigIndexer.GetMacros(templatePath)
+
+		macro, found := macros[macroName]
+		if !found {
+			return []protocol.Location{}
+		}
+
+		return []protocol.Location{
+			{
+				URI: fmt.Sprintf("file://%s", macro.FilePath),
+				Range: protocol.Range{
+					Start: protocol.Position{
+						Line:      macro.Line - 1,
+						Character: 0,
+					},
+					End: protocol.Position{
+						Line:      macro.Line - 1,
+						Character: 0,
+					},
+				},
+			},
+		}
+	}
+
 	if params.Node.Kind() == "function" {
 		functionName := treesitterhelper.GetNodeText(params.Node, params.DocumentContent)
 		parentNode := params.Node.Parent()
@@ -164,6 +201,17 @@ func (p *TwigDefinitionProvider) twigDefinitions(ctx context.Context, params *pr
 	return []protocol.Location{}
 }
 
+// sortByTemplateHierarchy orders the templates by the views of the theme the current template is rendered with
+func (p *TwigDefinitionProvider) sortByTemplateHierarchy(files []twig.TwigFile, currentPath string, parentFirst bool) []twig.TwigFile {
+	definitions, _ := p.themeIndexer.GetThemeDefinitions()
+	extensions, _ := p.extensionIndexer.GetAll()
+	graph := theme.NewGraph(definitions, extensions)
+
+	hierarchy := graph.TemplateHierarchyFor(twig.BundleNameByPath(currentPath))
+
+	return twig.SortByTemplateHierarchy(files, hierarchy, currentPath, parentFirst)
+}
+
 func (p *TwigDefinitionProvider) phpDefinitions(ctx context.Context, params *protocol.DefinitionParams) []protocol.Location {
 	if treesitterhelper.IsPHPThisMethodCall("renderStorefront").Matches(params.Node, params.DocumentContent) {
 		files, _ := p.twigIndexer.GetTwigFilesByRelPath(treesitterhelper.GetNodeText(params.Node, params.DocumentContent))
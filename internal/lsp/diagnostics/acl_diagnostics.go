@@ -0,0 +1,102 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/acl"
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// AclDiagnosticsProvider reports ACL privileges which are not defined by any privilege mapping
+type AclDiagnosticsProvider struct {
+	aclIndexer *acl.AclIndexer
+}
+
+// NewAclDiagnosticsProvider creates a new ACL diagnostics provider
+func NewAclDiagnosticsProvider(lspServer *lsp.Server) *AclDiagnosticsProvider {
+	aclIndexer, _ := lspServer.GetIndexer("acl.indexer")
+
+	return &AclDiagnosticsProvider{
+		aclIndexer: aclIndexer.(*acl.AclIndexer),
+	}
+}
+
+// GetDiagnostics returns diagnostics for privileges in admin scripts, admin templates and PHP `_acl` route defaults.
+func (p *AclDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if rootNode == nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	ext := strings.ToLower(filepath.Ext(uri))
+	isAdmin := strings.Contains(uri, "Resources/app/administration")
+
+	var references []acl.PrivilegeReference
+	var templateReferences []acl.TemplatePrivilegeReference
+
+	switch {
+	case isAdmin && admin.IsScriptFile(ext):
+		references = acl.ScriptPrivilegeReferences(rootNode, content)
+	case isAdmin && ext == ".twig":
+		templateReferences = acl.TemplatePrivilegeReferences(rootNode, content)
+	case ext == ".php":
+		references = acl.PHPPrivilegeReferences(rootNode, content)
+	}
+
+	if (len(references) == 0 && len(templateReferences) == 0) || !p.aclIndexer.HasCorePrivileges() {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	var diagnostics []protocol.Diagnostic
+
+	for _, reference := range references {
+		p.checkPrivilege(reference.Name, protocol.Range{
+			Start: protocol.Position{
+				Line:      int(reference.Node.StartPosition().Row),
+				Character: int(reference.Node.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(reference.Node.EndPosition().Row),
+				Character: int(reference.Node.EndPosition().Column),
+			},
+		}, &diagnostics)
+	}
+
+	for _, reference := range templateReferences {
+		p.checkPrivilege(reference.Name, protocol.Range{
+			Start: treesitterhelper.PositionAt(content, reference.Offset),
+			End:   treesitterhelper.PositionAt(content, reference.Offset+len(reference.Name)),
+		}, &diagnostics)
+	}
+
+	return diagnostics, nil
+}
+
+// checkPrivilege reports a privilege that no privilege mapping or enrichPrivileges() defines
+func (p *AclDiagnosticsProvider) checkPrivilege(name string, rng protocol.Range, diagnostics *[]protocol.Diagnostic) {
+	if name == "" {
+		return
+	}
+
+	privileges, err := p.aclIndexer.GetPrivilege(name)
+	if err != nil || len(privileges) > 0 {
+		return
+	}
+
+	*diagnostics = append(*diagnostics, protocol.Diagnostic{
+		Range:    rng,
+		Message:  fmt.Sprintf("Privilege '%s' is not defined by any privilege mapping", name),
+		Source:   "shopware",
+		Severity: protocol.DiagnosticSeverityWarning,
+		Code:     "acl.privilege.unknown",
+		Data: map[string]any{
+			"privilege": name,
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/acl"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+func TestAclDiagnosticsProvider_UnknownPrivileges(t *testing.T) {
+	aclIndexer, err := acl.NewAclIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = aclIndexer.Close() }()
+
+	provider := &AclDiagnosticsProvider{aclIndexer: aclIndexer}
+
+	code := `this.acl.can('product.editor');
+this.acl.can('product.unknown');`
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	uri := "file:///project/src/Resources/app/administration/src/main.js"
+
+	// Nothing is reported as long as the core privilege mapping is not indexed
+	diagnostics, err := provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+
+	for _, name := range []string{"product.editor", "product:read"} {
+		require.NoError(t, aclIndexer.SavePrivilege(acl.Privilege{
+			Name:     name,
+			Kind:     acl.PrivilegeKindRole,
+			FilePath: "/project/src/Administration/Resources/app/administration/src/module/sw-product/acl/" + name + ".js",
+			Line:     7,
+		}))
+	}
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "acl.privilege.unknown", diagnostics[0].Code)
+	assert.Equal(t, "Privilege 'product.unknown' is not defined by any privilege mapping", diagnostics[0].Message)
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+
+	twigCode := `<sw-button v-if="acl.can('product.missing')">Save</sw-button>`
+	twigTree, twigParser := parseTwig(t, twigCode)
+	defer twigTree.Close()
+	defer twigParser.Close()
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), "file:///project/src/Resources/app/administration/src/component/sw-foo/sw-foo.html.twig", twigTree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 0, Character: 26}, End: protocol.Position{Line: 0, Character: 41}}, diagnostics[0].Range)
+
+	phpCode := `<?php
+class ProductController
+{
+    #[Route(path: '/api/_action/product', defaults: ['_acl' => ['product:read', 'product:delete']])]
+    public function action() {}
+}
+`
+	phpTree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP()), phpCode)
+	defer phpTree.Close()
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), "file:///project/src/Controller/ProductController.php", phpTree.RootNode(), []byte(phpCode))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "Privilege 'product:delete' is not defined by any privilege mapping", diagnostics[0].Message)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
@@ -42,7 +43,7 @@ func (p *AdminDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri strin
 	ext := strings.ToLower(filepath.Ext(uri))
 
 	// Handle JS/TS files
-	if ext == ".js" || ext == ".ts" {
+	if admin.IsScriptFile(ext) {
 		return p.jsDiagnostics(ctx, uri, rootNode, content)
 	}
 
@@ -54,7 +55,7 @@ func (p *AdminDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri strin
 	return []protocol.Diagnostic{}, nil
 }
 
-func (p *AdminDiagnosticsProvider) jsDiagnostics(_ context.Context, _ string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+func (p *AdminDiagnosticsProvider) jsDiagnostics(_ context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
 	var diagnostics []protocol.Diagnostic
 
 	// Find all Component.extend calls
@@ -114,9 +115,133 @@ func (p *AdminDiagnosticsProvider) jsDiagnostics(_ context.Context, _ string, ro
 		}
 	}
 
+	diagnostics = append(diagnostics, p.checkInjectedServices(rootNode, content)...)
+	diagnostics = append(diagnostics, p.checkEmittedEvents(uri, rootNode, content)...)
+
+	// Check route names of $router.push/replace and navigation entries
+	for _, reference := range admin.ScriptRouteReferences(rootNode, content) {
+		p.checkRouteName(reference.Name, protocol.Range{
+			Start: protocol.Position{
+				Line:      int(reference.Node.StartPosition().Row),
+				Character: int(reference.Node.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(reference.Node.EndPosition().Row),
+				Character: int(reference.Node.EndPosition().Column),
+			},
+		}, &diagnostics)
+	}
+
 	return diagnostics, nil
 }
 
+// checkEmittedEvents reports $emit('...') calls of events the component does not declare in its emits.
+// Vue 3 compat mode requires the declaration. Mixins are skipped as the using component may declare the event.
+func (p *AdminDiagnosticsProvider) checkEmittedEvents(uri string, rootNode *tree_sitter.Node, content []byte) []protocol.Diagnostic {
+	references := admin.EmitReferences(rootNode, content)
+	if len(references) == 0 {
+		return nil
+	}
+
+	scopes := p.adminIndexer.GetScriptScopes(strings.TrimPrefix(uri, "file://"), rootNode, content)
+
+	// The emits of each component are resolved once
+	type componentEmits struct {
+		emits    []string
+		complete bool
+	}
+	emitsByComponent := make(map[string]componentEmits)
+
+	var diagnostics []protocol.Diagnostic
+	for _, reference := range references {
+		if reference.Name == "" {
+			continue
+		}
+
+		scriptContext := admin.ScriptContextAt(scopes, reference.Node.StartByte())
+		if scriptContext.ComponentName == "" {
+			continue
+		}
+
+		component, resolved := emitsByComponent[scriptContext.ComponentName]
+		if !resolved {
+			emits, complete, err := p.adminIndexer.ResolveComponentEmits(scriptContext.ComponentName)
+			component = componentEmits{emits: emits, complete: err == nil && complete}
+			emitsByComponent[scriptContext.ComponentName] = component
+		}
+
+		// Only check if all emits are known, otherwise inherited events would be reported
+		if !component.complete || admin.IsEventDeclared(reference.Name, component.emits) {
+			continue
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      int(reference.Node.StartPosition().Row),
+					Character: int(reference.Node.StartPosition().Column),
+				},
+				End: protocol.Position{
+					Line:      int(reference.Node.EndPosition().Row),
+					Character: int(reference.Node.EndPosition().Column),
+				},
+			},
+			Message:  fmt.Sprintf("Event '%s' is not declared in the emits of component '%s'", reference.Name, scriptContext.ComponentName),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "admin.component.undeclared-emit",
+			Data: map[string]any{
+				"componentName": scriptContext.ComponentName,
+				"eventName":     reference.Name,
+			},
+		})
+	}
+
+	return diagnostics
+}
+
+// checkInjectedServices reports injected services that are never registered or provided.
+func (p *AdminDiagnosticsProvider) checkInjectedServices(rootNode *tree_sitter.Node, content []byte) []protocol.Diagnostic {
+	references := admin.InjectedServices(rootNode, content)
+	if len(references) == 0 || !p.adminIndexer.HasCoreServices() {
+		return nil
+	}
+
+	var diagnostics []protocol.Diagnostic
+	for _, reference := range references {
+		if reference.Name == "" {
+			continue
+		}
+
+		services, err := p.adminIndexer.GetService(reference.Name)
+		if err != nil || len(services) > 0 {
+			continue
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      int(reference.Node.StartPosition().Row),
+					Character: int(reference.Node.StartPosition().Column),
+				},
+				End: protocol.Position{
+					Line:      int(reference.Node.EndPosition().Row),
+					Character: int(reference.Node.EndPosition().Column),
+				},
+			},
+			Message:  fmt.Sprintf("Injected service '%s' is not registered", reference.Name),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "admin.service.unknown",
+			Data: map[string]any{
+				"serviceName": reference.Name,
+			},
+		})
+	}
+
+	return diagnostics
+}
+
 // getSecondStringArg returns the second string argument from an arguments node
 func (p *AdminDiagnosticsProvider) getSecondStringArg(argsNode *tree_sitter.Node, content []byte) *tree_sitter.Node {
 	stringCount := 0
@@ -157,9 +282,90 @@ func (p *AdminDiagnosticsProvider) twigDiagnostics(_ context.Context, uri string
 	// Check for invalid block references in component overrides
 	p.checkBlockReferences(uri, rootNode, content, &diagnostics)
 
+	// Check Vue expressions for members the component doesn't have
+	p.checkTemplateExpressions(uri, rootNode, content, &diagnostics)
+
+	// Check route names of :to and :router-link locations
+	for _, reference := range admin.TemplateRouteReferences(rootNode, content) {
+		p.checkRouteName(reference.Name, protocol.Range{
+			Start: treesitterhelper.PositionAt(content, reference.Offset),
+			End:   treesitterhelper.PositionAt(content, reference.Offset+len(reference.Name)),
+		}, &diagnostics)
+	}
+
 	return diagnostics, nil
 }
 
+// checkTemplateExpressions reports identifiers in {{ }}, v-if, v-for, :prop and @event expressions
+// which are neither a member of the component owning the template nor a v-for or slot scope variable
+func (p *AdminDiagnosticsProvider) checkTemplateExpressions(uri string, rootNode *tree_sitter.Node, content []byte, diagnostics *[]protocol.Diagnostic) {
+	filePath := strings.TrimPrefix(uri, "file://")
+
+	comp, err := p.adminIndexer.GetComponentByTemplatePath(filePath)
+	if err != nil || comp == nil {
+		return
+	}
+
+	// Only check if all members are known, otherwise every unresolved member would be reported
+	members, complete, err := p.adminIndexer.ResolveComponentMembers(comp.Name)
+	if err != nil || !complete {
+		return
+	}
+
+	known := make(map[string]bool, len(members))
+	for _, member := range members {
+		known[member.Name] = true
+	}
+
+	for _, expression := range admin.ParseTemplateExpressions(rootNode, content) {
+		for _, reference := range admin.ExpressionReferences(expression.Text) {
+			if known[reference.Name] || slices.Contains(expression.Scope, reference.Name) {
+				continue
+			}
+
+			offset := expression.Offset + reference.Offset
+
+			*diagnostics = append(*diagnostics, protocol.Diagnostic{
+				Range: protocol.Range{
+					Start: treesitterhelper.PositionAt(content, offset),
+					End:   treesitterhelper.PositionAt(content, offset+len(reference.Name)),
+				},
+				Message:  fmt.Sprintf("Property or method '%s' is not defined on component '%s'", reference.Name, comp.Name),
+				Source:   "shopware",
+				Severity: protocol.DiagnosticSeverityWarning,
+				Code:     "admin.template.unknown-member",
+				Data: map[string]any{
+					"componentName": comp.Name,
+					"memberName":    reference.Name,
+				},
+			})
+		}
+	}
+}
+
+// checkRouteName reports a route name that no module registers.
+func (p *AdminDiagnosticsProvider) checkRouteName(name string, rng protocol.Range, diagnostics *[]protocol.Diagnostic) {
+	if name == "" || !p.adminIndexer.HasCoreRoutes() {
+		return
+	}
+
+	routes, err := p.adminIndexer.GetRoute(name)
+	if err != nil || len(routes) > 0 {
+		return
+	}
+
+	*diagnostics = append(*diagnostics, protocol.Diagnostic{
+		Range:    rng,
+		Message:  fmt.Sprintf("Route '%s' is not registered by any module", name),
+		Source:   "shopware",
+		Severity: protocol.DiagnosticSeverityWarning,
+		Code:     "admin.route.unknown",
+		Data: map[string]any{
+			"routeName": name,
+		},
+	})
+}
+
 // checkBlockReferences checks if blocks referenced in an override template exist in the parent component
 func (p *AdminDiagnosticsProvider) checkBlockReferences(uri string, rootNode *tree_sitter.Node, content []byte, diagnostics *[]protocol.Diagnostic) {
 	// Get the file path from URI
@@ -357,6 +563,229 @@ func (p *AdminDiagnosticsProvider) checkComponentProps(startTag *tree_sitter.Nod
 			},
 		})
 	}
+
+	p.checkAttributeValues(startTag, content, tagName, comp, diagnostics)
+}
+
+// checkAttributeValues checks the attributes of a component tag against its props and emits
+// <sw-switch disabled="true"> - static string passed to a Boolean prop
+// <sw-button variant="primray"> - value rejected by the prop validator
+// <sw-button lable="Save"> - attribute is neither a prop, an emitted event nor a native attribute
+func (p *AdminDiagnosticsProvider) checkAttributeValues(startTag *tree_sitter.Node, content []byte, tagName string, comp admin.VueComponent, diagnostics *[]protocol.Diagnostic) {
+	props, emits, complete := p.collectComponentInterface(comp)
+
+	// Without any indexed props we cannot tell apart unknown attributes
+	checkUnknown := complete && len(props) > 0
+
+	for i := uint(0); i < startTag.ChildCount(); i++ {
+		attrNode := startTag.Child(i)
+		if attrNode.Kind() != "html_attribute" {
+			continue
+		}
+
+		nameNode := p.getAttributeNameNode(attrNode)
+		if nameNode == nil {
+			continue
+		}
+
+		attrName := string(nameNode.Utf8Text(content))
+		if strings.ContainsAny(attrName, "{}%") {
+			continue
+		}
+
+		if strings.HasPrefix(attrName, "@") || strings.HasPrefix(attrName, "v-on:") {
+			eventName := strings.TrimPrefix(strings.TrimPrefix(attrName, "@"), "v-on:")
+			if checkUnknown && len(emits) > 0 && !p.isEventEmitted(eventName, emits) && !admin.IsNativeEvent(eventName) {
+				*diagnostics = append(*diagnostics, p.attributeDiagnostic(attrNode, nameNode, tagName, attrName,
+					"admin.component.unknown-attribute",
+					fmt.Sprintf("Event '%s' is not emitted by component '%s'", eventName, tagName)))
+			}
+			continue
+		}
+
+		// Other directives like v-if, v-model and slot shorthands are no props
+		if (strings.HasPrefix(attrName, "v-") && !strings.HasPrefix(attrName, "v-bind:")) || strings.HasPrefix(attrName, "#") {
+			continue
+		}
+
+		propName := admin.NormalizePropName(attrName)
+		if propName == "" {
+			continue
+		}
+
+		bound := strings.HasPrefix(attrName, ":") || strings.HasPrefix(attrName, "v-bind:")
+
+		prop, ok := props[propName]
+		if !ok {
+			plainName := strings.TrimPrefix(strings.TrimPrefix(attrName, "v-bind:"), ":")
+			if checkUnknown && !admin.IsNativeAttribute(plainName) {
+				*diagnostics = append(*diagnostics, p.attributeDiagnostic(attrNode, nameNode, tagName, attrName,
+					"admin.component.unknown-attribute",
+					fmt.Sprintf("Attribute '%s' is not a prop, emitted event or native attribute of component '%s'", plainName, tagName)))
+			}
+			continue
+		}
+
+		valueNode := attrNode.ChildByFieldName("value")
+
+		value := ""
+		if valueNode != nil {
+			value = strings.Trim(string(valueNode.Utf8Text(content)), "\"'")
+		}
+
+		// Twig inside of the value is rendered before Vue sees it
+		if strings.Contains(value, "{{") || strings.Contains(value, "{%") {
+			continue
+		}
+
+		if !bound && p.isStaticValueMismatch(prop, attrName, valueNode != nil, value) {
+			diagnostic := p.attributeDiagnostic(attrNode, nameNode, tagName, attrName,
+				"admin.component.prop-type-mismatch",
+				fmt.Sprintf("Prop '%s' of component '%s' expects %s, but '%s' passes a static string. Use ':%s' to bind the value", prop.Name, tagName, prop.Type, attrName, attrName))
+			diagnostic.Data.(map[string]any)["propName"] = prop.Name
+			*diagnostics = append(*diagnostics, diagnostic)
+			continue
+		}
+
+		if len(prop.AllowedValues) == 0 || valueNode == nil {
+			continue
+		}
+
+		literal, offset, ok := p.literalValue(string(valueNode.Utf8Text(content)), bound)
+		if !ok || slices.Contains(prop.AllowedValues, literal) {
+			continue
+		}
+
+		start := valueNode.StartPosition()
+		valueRange := protocol.Range{
+			Start: protocol.Position{Line: int(start.Row), Character: int(start.Column) + offset},
+			End:   protocol.Position{Line: int(start.Row), Character: int(start.Column) + offset + len(literal)},
+		}
+
+		diagnostic := p.attributeDiagnostic(attrNode, nameNode, tagName, attrName,
+			"admin.component.invalid-prop-value",
+			fmt.Sprintf("Value '%s' is not allowed for prop '%s' of component '%s'. Allowed values: '%s'", literal, prop.Name, tagName, strings.Join(prop.AllowedValues, "', '")))
+		diagnostic.Range = valueRange
+		diagnostic.Data.(map[string]any)["propName"] = prop.Name
+		diagnostic.Data.(map[string]any)["allowedValues"] = prop.AllowedValues
+		*diagnostics = append(*diagnostics, diagnostic)
+	}
+}
+
+// collectComponentInterface collects the props and emits of a component and the components it extends.
+// complete is false when a parent component of the chain is not indexed.
+func (p *AdminDiagnosticsProvider) collectComponentInterface(comp admin.VueComponent) (map[string]admin.VueComponentProp, map[string]bool, bool) {
+	props := make(map[string]admin.VueComponentProp)
+	emits := make(map[string]bool)
+	seen := make(map[string]bool)
+
+	for {
+		for _, prop := range comp.Props {
+			if _, ok := props[prop.Name]; !ok {
+				props[prop.Name] = prop
+			}
+		}
+
+		for _, emit := range comp.Emits {
+			emits[emit] = true
+		}
+
+		seen[comp.Name] = true
+
+		if comp.UnresolvedMixins {
+			return props, emits, false
+		}
+
+		if comp.ExtendsComponent == "" || seen[comp.ExtendsComponent] {
+			return props, emits, true
+		}
+
+		parentComps, err := p.adminIndexer.GetComponentWithDefinition(comp.ExtendsComponent)
+		if err != nil || len(parentComps) == 0 {
+			return props, emits, false
+		}
+
+		comp = parentComps[0]
+	}
+}
+
+// isEventEmitted checks the event against the declared emits, ignoring modifiers and kebab/camel case differences
+func (p *AdminDiagnosticsProvider) isEventEmitted(eventName string, emits map[string]bool) bool {
+	eventName, _, _ = strings.Cut(eventName, ".")
+
+	return emits[eventName] || emits[admin.KebabToCamel(eventName)] || emits[camelToKebab(eventName)]
+}
+
+// isStaticValueMismatch checks if a static attribute value cannot be used for the prop type.
+// Boolean props accept the attribute without a value, an empty value or their own name.
+func (p *AdminDiagnosticsProvider) isStaticValueMismatch(prop admin.VueComponentProp, attrName string, hasValue bool, value string) bool {
+	switch prop.Type {
+	case "Boolean":
+		return hasValue && value != "" && value != attrName && value != prop.Name
+	case "Number", "Array", "Object", "Function":
+		return true
+	}
+
+	return false
+}
+
+// literalValue returns the string passed to the prop and its offset inside the attribute value node.
+// Bound values are only returned when they are a plain string literal like :variant="'primary'".
+func (p *AdminDiagnosticsProvider) literalValue(rawValue string, bound bool) (string, int, bool) {
+	if len(rawValue) < 2 {
+		return "", 0, false
+	}
+
+	inner := rawValue[1 : len(rawValue)-1]
+	if !bound {
+		return inner, 1, true
+	}
+
+	trimmed := strings.TrimSpace(inner)
+	if len(trimmed) < 2 || (trimmed[0] != '\'' && trimmed[0] != '"' && trimmed[0] != '`') || trimmed[len(trimmed)-1] != trimmed[0] {
+		return "", 0, false
+	}
+
+	literal := trimmed[1 : len(trimmed)-1]
+	if strings.ContainsAny(literal, "'\"`$") {
+		return "", 0, false
+	}
+
+	return literal, 1 + strings.Index(inner, trimmed) + 1, true
+}
+
+// attributeDiagnostic creates a warning for an attribute, the attribute range is passed along for the remove quick fix
+func (p *AdminDiagnosticsProvider) attributeDiagnostic(attrNode, nameNode *tree_sitter.Node, tagName, attrName, code, message string) protocol.Diagnostic {
+	return protocol.Diagnostic{
+		Range: protocol.Range{
+			Start: protocol.Position{
+				Line:      int(nameNode.StartPosition().Row),
+				Character: int(nameNode.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(nameNode.EndPosition().Row),
+				Character: int(nameNode.EndPosition().Column),
+			},
+		},
+		Message:  message,
+		Source:   "shopware",
+		Severity: protocol.DiagnosticSeverityWarning,
+		Code:     code,
+		Data: map[string]any{
+			"componentName": tagName,
+			"attributeName": attrName,
+			"attributeRange": protocol.Range{
+				Start: protocol.Position{
+					Line:      int(attrNode.StartPosition().Row),
+					Character: int(attrNode.StartPosition().Column),
+				},
+				End: protocol.Position{
+					Line:      int(attrNode.EndPosition().Row),
+					Character: int(attrNode.EndPosition().Column),
+				},
+			},
+		},
+	}
 }
 
 // getTagName extracts the tag name from an html_start_tag node
@@ -392,6 +821,17 @@ func (p *AdminDiagnosticsProvider) getTagAttributes(startTag *tree_sitter.Node,
 	return attrs
 }
 
+// getAttributeNameNode returns the name node of an html_attribute node
+func (p *AdminDiagnosticsProvider) getAttributeNameNode(attrNode *tree_sitter.Node) *tree_sitter.Node {
+	for i := uint(0); i < attrNode.ChildCount(); i++ {
+		child := attrNode.Child(i)
+		if child.Kind() == "html_attribute_name" || child.Kind() == "vue_directive" {
+			return child
+		}
+	}
+	return nil
+}
+
 // getAttributeName extracts the attribute name from an html_attribute node
 func (p *AdminDiagnosticsProvider) getAttributeName(attrNode *tree_sitter.Node, content []byte) string {
 	for i := uint(0); i < attrNode.ChildCount(); i++ {
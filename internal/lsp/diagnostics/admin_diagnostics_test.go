@@ -3,9 +3,11 @@ package diagnostics
 import (
 	"context"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
 	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -564,3 +566,392 @@ func TestAdminDiagnosticsProvider_BlockReferences_NoOverride(t *testing.T) {
 
 	assert.Empty(t, diagnostics, "Regular components should not produce block reference diagnostics")
 }
+
+func TestAdminDiagnosticsProvider_PropValues(t *testing.T) {
+	tempDir := t.TempDir()
+
+	adminIndexer, err := admin.NewAdminComponentIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	jsParser := tree_sitter.NewParser()
+	require.NoError(t, jsParser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_javascript.Language())))
+	defer jsParser.Close()
+
+	compCode := `
+Component.register('sw-button', {
+	emits: ['save'],
+	props: {
+		label: String,
+		disabled: {
+			type: Boolean,
+			default: false,
+		},
+		size: Number,
+		variant: {
+			type: String,
+			validator(value) {
+				return ['primary', 'ghost'].includes(value);
+			},
+		},
+	},
+});
+`
+	compTree := jsParser.Parse([]byte(compCode), nil)
+	defer compTree.Close()
+
+	compFilePath := filepath.Join(tempDir, "src", "Resources", "app", "administration", "src", "component", "sw-button", "index.js")
+	require.NoError(t, adminIndexer.Index(compFilePath, compTree.RootNode(), []byte(compCode)))
+
+	provider := &AdminDiagnosticsProvider{
+		adminIndexer: adminIndexer,
+	}
+
+	tests := []struct {
+		name        string
+		twigCode    string
+		expectCodes []string
+		expectRange *protocol.Range
+	}{
+		{
+			name:     "valid attributes",
+			twigCode: `<sw-button label="Save" disabled :size="10" variant="ghost" class="btn" data-id="1" @save="onSave" @click="onClick" v-if="show"></sw-button>`,
+		},
+		{
+			name:     "boolean prop with its own name",
+			twigCode: `<sw-button disabled="disabled"></sw-button>`,
+		},
+		{
+			name:        "static string for boolean prop",
+			twigCode:    `<sw-button disabled="false"></sw-button>`,
+			expectCodes: []string{"admin.component.prop-type-mismatch"},
+		},
+		{
+			name:        "static string for number prop",
+			twigCode:    `<sw-button size="10"></sw-button>`,
+			expectCodes: []string{"admin.component.prop-type-mismatch"},
+		},
+		{
+			name:     "twig inside of a static value",
+			twigCode: `<sw-button size="{{ size }}"></sw-button>`,
+		},
+		{
+			name:        "value rejected by validator",
+			twigCode:    `<sw-button variant="primray"></sw-button>`,
+			expectCodes: []string{"admin.component.invalid-prop-value"},
+			expectRange: &protocol.Range{Start: protocol.Position{Line: 0, Character: 20}, End: protocol.Position{Line: 0, Character: 27}},
+		},
+		{
+			name:        "bound literal rejected by validator",
+			twigCode:    `<sw-button :variant="'primray'"></sw-button>`,
+			expectCodes: []string{"admin.component.invalid-prop-value"},
+			expectRange: &protocol.Range{Start: protocol.Position{Line: 0, Character: 22}, End: protocol.Position{Line: 0, Character: 29}},
+		},
+		{
+			name:     "bound expression is not checked against validator",
+			twigCode: `<sw-button :variant="isPrimary ? 'primary' : 'ghost'"></sw-button>`,
+		},
+		{
+			name:        "unknown attribute and event",
+			twigCode:    `<sw-button lable="Save" @saved="onSave"></sw-button>`,
+			expectCodes: []string{"admin.component.unknown-attribute", "admin.component.unknown-attribute"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, parser := parseTwig(t, tt.twigCode)
+			defer tree.Close()
+			defer parser.Close()
+
+			uri := "file:///project/src/Resources/app/administration/src/views/test.html.twig"
+			diagnostics, err := provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(tt.twigCode))
+			require.NoError(t, err)
+
+			var codes []string
+			for _, diag := range diagnostics {
+				codes = append(codes, diag.Code.(string))
+			}
+			assert.Equal(t, tt.expectCodes, codes)
+
+			if tt.expectRange != nil {
+				assert.Equal(t, *tt.expectRange, diagnostics[0].Range)
+			}
+		})
+	}
+}
+
+func TestAdminDiagnosticsProvider_TemplateExpressions(t *testing.T) {
+	tempDir := t.TempDir()
+
+	adminIndexer, err := admin.NewAdminComponentIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	componentDir := filepath.Join(tempDir, "src", "Resources", "app", "administration", "src", "component", "sw-product-list")
+	definitionPath := filepath.Join(componentDir, "index.js")
+	templatePath := filepath.Join(componentDir, "sw-product-list.html.twig")
+
+	require.NoError(t, adminIndexer.SaveComponent(admin.VueComponent{
+		Name:           "sw-product-list",
+		FilePath:       definitionPath,
+		DefinitionPath: definitionPath,
+	}))
+	require.NoError(t, adminIndexer.SaveComponentDefinition(strings.TrimSuffix(definitionPath, "/index.js"), admin.ComponentDefinition{
+		FilePath:     definitionPath,
+		TemplatePath: templatePath,
+		Props:        []admin.VueComponentProp{{Name: "isLoading", Type: "Boolean"}},
+		Data:         []string{"products"},
+		Methods:      []string{"onSelect"},
+	}))
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+
+	twigCode := `<sw-card v-if="!isLoading">
+    <div v-for="product in products" @click="onSelect(product)">{{ product.name }} {{ total }}</div>
+    <sw-button :disabled="isSaving">{{ $tc('global.save') }}</sw-button>
+</sw-card>`
+
+	tree, parser := parseTwig(t, twigCode)
+	defer tree.Close()
+	defer parser.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file://"+templatePath, tree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+
+	var unknown []protocol.Diagnostic
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Code == "admin.template.unknown-member" {
+			unknown = append(unknown, diagnostic)
+		}
+	}
+
+	require.Len(t, unknown, 2)
+	assert.Equal(t, "Property or method 'total' is not defined on component 'sw-product-list'", unknown[0].Message)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 1, Character: 86}, End: protocol.Position{Line: 1, Character: 91}}, unknown[0].Range)
+	assert.Equal(t, "isSaving", unknown[1].Data.(map[string]any)["memberName"])
+
+	// Members added by ...mapState() can't be listed, so nothing is reported
+	require.NoError(t, adminIndexer.SaveComponentDefinition(strings.TrimSuffix(definitionPath, "/index.js"), admin.ComponentDefinition{
+		FilePath:       definitionPath,
+		TemplatePath:   templatePath,
+		DynamicMembers: true,
+	}))
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), "file://"+templatePath, tree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	for _, diagnostic := range diagnostics {
+		assert.NotEqual(t, "admin.template.unknown-member", diagnostic.Code)
+	}
+}
+
+func TestAdminDiagnosticsProvider_UnknownInjectedServices(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+
+	code := `Component.register('sw-foo', {
+    inject: ['repositoryFactory', 'unknownService'],
+});`
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	uri := "file:///project/custom/plugins/MyPlugin/src/Resources/app/administration/src/component/sw-foo/index.js"
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics, "Services are not checked without the core administration in the index")
+
+	require.NoError(t, adminIndexer.SaveService(admin.AdminService{
+		Name:     "repositoryFactory",
+		Source:   admin.ServiceSourceProvider,
+		FilePath: "/project/vendor/shopware/administration/Resources/app/administration/src/app/init-pre/api-services.init.js",
+		Line:     2,
+	}))
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+
+	assert.Equal(t, "admin.service.unknown", diagnostics[0].Code)
+	assert.Equal(t, "Injected service 'unknownService' is not registered", diagnostics[0].Message)
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 34, diagnostics[0].Range.Start.Character)
+}
+
+func TestAdminDiagnosticsProvider_UnknownRoutes(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	require.NoError(t, adminIndexer.SaveRoute(admin.AdminRoute{
+		Name:     "sw.product.detail",
+		FilePath: "/project/src/Administration/Resources/app/administration/src/module/sw-product/index.js",
+		Line:     8,
+	}))
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+
+	code := `this.$router.push({ name: 'sw.product.detail' });
+this.$router.push({ name: 'sw.product.unknown' });`
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file:///project/src/Resources/app/administration/src/main.js", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "admin.route.unknown", diagnostics[0].Code)
+	assert.Equal(t, "Route 'sw.product.unknown' is not registered by any module", diagnostics[0].Message)
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+
+	twigCode := `<router-link :to="{ name: 'sw.product.missing' }">Link</router-link>`
+	twigTree, twigParser := parseTwig(t, twigCode)
+	defer twigTree.Close()
+	defer twigParser.Close()
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), "file:///project/src/Resources/app/administration/src/component/sw-foo/sw-foo.html.twig", twigTree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 0, Character: 27}, End: protocol.Position{Line: 0, Character: 45}}, diagnostics[0].Range)
+}
+
+func TestAdminDiagnosticsProvider_MixinRequiredProps(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	require.NoError(t, adminIndexer.SaveMixin(admin.VueMixin{
+		Name:     "listing",
+		FilePath: "/project/src/Resources/app/administration/src/app/mixin/listing.mixin.js",
+		Line:     1,
+		Definition: admin.ComponentDefinition{
+			Props: []admin.VueComponentProp{{Name: "entityName", Type: "String", Required: true}},
+		},
+	}))
+
+	compCode := `Component.register('sw-entity-list', {
+	mixins: [Mixin.getByName('listing')],
+	props: {
+		label: { type: String, required: false },
+	},
+});`
+	compTree, compParser := parseJS(t, compCode)
+	defer compTree.Close()
+	defer compParser.Close()
+	require.NoError(t, adminIndexer.Index("/project/src/Resources/app/administration/src/component/sw-entity-list/index.js", compTree.RootNode(), []byte(compCode)))
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+	uri := "file:///project/src/Resources/app/administration/src/views/test.html.twig"
+
+	twigCode := `<sw-entity-list label="Products"></sw-entity-list>`
+	tree, parser := parseTwig(t, twigCode)
+	defer tree.Close()
+	defer parser.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "Missing required prop 'entityName' on component 'sw-entity-list'", diagnostics[0].Message)
+
+	twigCode = `<sw-entity-list entity-name="product"></sw-entity-list>`
+	tree2, parser2 := parseTwig(t, twigCode)
+	defer tree2.Close()
+	defer parser2.Close()
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), uri, tree2.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
+
+func TestAdminDiagnosticsProvider_UnresolvedMixin(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	compCode := `Component.register('sw-entity-list', {
+	mixins: [Mixin.getByName('listing')],
+	emits: ['select'],
+	props: {
+		label: { type: String, required: false },
+	},
+});`
+	compTree, compParser := parseJS(t, compCode)
+	defer compTree.Close()
+	defer compParser.Close()
+	require.NoError(t, adminIndexer.Index("/project/src/Resources/app/administration/src/component/sw-entity-list/index.js", compTree.RootNode(), []byte(compCode)))
+
+	components, err := adminIndexer.GetComponentWithDefinition("sw-entity-list")
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+	assert.True(t, components[0].UnresolvedMixins)
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+	uri := "file:///project/src/Resources/app/administration/src/views/test.html.twig"
+
+	// Props and events of the listing mixin are unknown, so they are not reported
+	twigCode := `<sw-entity-list label="Products" entity-name="product" @page-change="onPageChange"></sw-entity-list>`
+	tree, parser := parseTwig(t, twigCode)
+	defer tree.Close()
+	defer parser.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), uri, tree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
+
+func TestAdminDiagnosticsProvider_UndeclaredEmits(t *testing.T) {
+	adminIndexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = adminIndexer.Close() }()
+
+	code := `Component.register('sw-foo', {
+	emits: ['save', 'updateValue'],
+	methods: {
+		onSave() {
+			this.$emit('save');
+			this.$emit('update-value', 1);
+			this.$emit('close');
+		},
+	},
+});`
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	filePath := "/project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	require.NoError(t, adminIndexer.Index(filePath, tree.RootNode(), []byte(code)))
+
+	provider := &AdminDiagnosticsProvider{adminIndexer: adminIndexer}
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file://"+filePath, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "admin.component.undeclared-emit", diagnostics[0].Code)
+	assert.Equal(t, "Event 'close' is not declared in the emits of component 'sw-foo'", diagnostics[0].Message)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 6, Character: 14}, End: protocol.Position{Line: 6, Character: 21}}, diagnostics[0].Range)
+
+	// Events of a component extending an unknown parent may be declared there
+	extendCode := `Component.extend('sw-bar', 'sw-unknown', {
+	methods: {
+		onSave() {
+			this.$emit('close');
+		},
+	},
+});`
+	extendTree, extendParser := parseJS(t, extendCode)
+	defer extendTree.Close()
+	defer extendParser.Close()
+
+	extendPath := "/project/src/Resources/app/administration/src/component/sw-bar/index.js"
+	require.NoError(t, adminIndexer.Index(extendPath, extendTree.RootNode(), []byte(extendCode)))
+
+	diagnostics, err = provider.GetDiagnostics(context.Background(), "file://"+extendPath, extendTree.RootNode(), []byte(extendCode))
+	require.NoError(t, err)
+	for _, diagnostic := range diagnostics {
+		assert.NotEqual(t, "admin.component.undeclared-emit", diagnostic.Code)
+	}
+}
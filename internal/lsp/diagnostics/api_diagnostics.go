@@ -0,0 +1,98 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/api"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type ApiDiagnosticsProvider struct {
+	schemaIndex *api.ApiSchemaIndexer
+	routeIndex  *symfony.RouteIndexer
+}
+
+func NewApiDiagnosticsProvider(lspServer *lsp.Server) *ApiDiagnosticsProvider {
+	schemaIndex, _ := lspServer.GetIndexer("api.schema")
+	routeIndex, _ := lspServer.GetIndexer("symfony.route")
+
+	return &ApiDiagnosticsProvider{
+		schemaIndex: schemaIndex.(*api.ApiSchemaIndexer),
+		routeIndex:  routeIndex.(*symfony.RouteIndexer),
+	}
+}
+
+func (a *ApiDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if strings.ToLower(filepath.Ext(uri)) != ".php" {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	routes, err := a.routeIndex.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	fileRoutes := routes.GetByFilePath(strings.TrimPrefix(uri, "file://"))
+	if len(fileRoutes) == 0 {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	// Map the route attributes of the current document by line
+	attributes := make(map[int]*tree_sitter.Node)
+	for _, attributeNode := range treesitterhelper.FindAll(rootNode, treesitterhelper.PHPRouteAttributePattern(), content) {
+		attributes[int(attributeNode.StartPosition().Row)+1] = attributeNode
+	}
+
+	var diagnostics []protocol.Diagnostic
+
+	for _, route := range fileRoutes {
+		scope := api.ScopeOfRoute(route)
+		if scope == "" {
+			continue
+		}
+
+		attributeNode, ok := attributes[route.Line]
+		if !ok {
+			continue
+		}
+
+		if len(a.schemaIndex.GetOperationsForRoute(route)) > 0 {
+			continue
+		}
+
+		schemaDir := "Resources/Schema/StoreApi"
+		if scope == api.ScopeAdminApi {
+			schemaDir = "Resources/Schema/AdminApi"
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      int(attributeNode.StartPosition().Row),
+					Character: int(attributeNode.StartPosition().Column),
+				},
+				End: protocol.Position{
+					Line:      int(attributeNode.EndPosition().Row),
+					Character: int(attributeNode.EndPosition().Column),
+				},
+			},
+			Message:  fmt.Sprintf("Route '%s' (%s) has no OpenAPI schema in %s", route.Name, api.SchemaPathOfRoute(route, scope), schemaDir),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "api.schema.missing",
+			Data: map[string]any{
+				"routeName": route.Name,
+				"scope":     scope,
+			},
+		})
+	}
+
+	return diagnostics, nil
+}
@@ -0,0 +1,71 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/api"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+func parseWithLanguage(t *testing.T, language *tree_sitter.Language, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(language))
+	return parser.Parse([]byte(code), nil)
+}
+
+func TestApiDiagnosticsProvider_MissingSchema(t *testing.T) {
+	tempDir := t.TempDir()
+
+	routeIndexer, err := symfony.NewRouteIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = routeIndexer.Close() }()
+
+	schemaIndexer, err := api.NewApiSchemaIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = schemaIndexer.Close() }()
+
+	schema := `{"paths": {"/account/customer": {"post": {"operationId": "readCustomer"}}}}`
+	schemaPath := "/project/src/Resources/Schema/StoreApi/customer.json"
+	schemaTree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_json.Language()), schema)
+	defer schemaTree.Close()
+	require.NoError(t, schemaIndexer.Index(schemaPath, schemaTree.RootNode(), []byte(schema)))
+
+	code := `<?php
+
+namespace Acme\Core;
+
+#[Route(defaults: ['_routeScope' => ['store-api']])]
+class CustomerRoute
+{
+    #[Route(path: '/store-api/account/customer', name: 'store-api.account.customer', methods: ['POST'])]
+    public function load() {}
+
+    #[Route(path: '/store-api/account/logout', name: 'store-api.account.logout', methods: ['POST'])]
+    public function logout() {}
+}
+`
+	routePath := "/project/src/Core/CustomerRoute.php"
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP()), code)
+	defer tree.Close()
+	require.NoError(t, routeIndexer.Index(routePath, tree.RootNode(), []byte(code)))
+
+	provider := &ApiDiagnosticsProvider{
+		schemaIndex: schemaIndexer,
+		routeIndex:  routeIndexer,
+	}
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file://"+routePath, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+
+	assert.Equal(t, "api.schema.missing", diagnostics[0].Code)
+	assert.Equal(t, "Route 'store-api.account.logout' (/account/logout) has no OpenAPI schema in Resources/Schema/StoreApi", diagnostics[0].Message)
+	assert.Equal(t, 10, diagnostics[0].Range.Start.Line)
+}
@@ -0,0 +1,103 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// ScssDiagnosticsProvider reports SCSS variables in plugin stylesheets which are not declared anywhere
+type ScssDiagnosticsProvider struct {
+	scssIndexer  *scss.ScssIndexer
+	themeIndexer *theme.ThemeConfigIndexer
+}
+
+// NewScssDiagnosticsProvider creates a new SCSS diagnostics provider
+func NewScssDiagnosticsProvider(lspServer *lsp.Server) *ScssDiagnosticsProvider {
+	scssIndexer, _ := lspServer.GetIndexer("scss.indexer")
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
+
+	return &ScssDiagnosticsProvider{
+		scssIndexer:  scssIndexer.(*scss.ScssIndexer),
+		themeIndexer: themeIndexer.(*theme.ThemeConfigIndexer),
+	}
+}
+
+// GetDiagnostics returns diagnostics for undefined variables in Storefront SCSS of plugins and apps. Variables
+// declared in the file, theme.json fields and variables injected by the theme compiler are defined as well.
+func (p *ScssDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if strings.ToLower(filepath.Ext(uri)) != ".scss" || !scss.IsPluginFile(uri) || !p.scssIndexer.HasCoreVariables() {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	references := scss.ParseReferences(rootNode, content)
+
+	declared := make(map[string]bool)
+	for _, reference := range references {
+		if reference.Kind == scss.SymbolVariable && reference.Declaration {
+			declared[reference.Name] = true
+		}
+	}
+
+	var diagnostics []protocol.Diagnostic
+	checked := make(map[string]bool)
+
+	for _, reference := range references {
+		if reference.Kind != scss.SymbolVariable || reference.Declaration || declared[reference.Name] || scss.IsCompilerVariable(reference.Name) {
+			continue
+		}
+
+		defined, ok := checked[reference.Name]
+		if !ok {
+			defined = p.isDefined(reference.Name)
+			checked[reference.Name] = defined
+		}
+		if defined {
+			continue
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: int(reference.Start.Row), Character: int(reference.Start.Column)},
+				End:   protocol.Position{Line: int(reference.End.Row), Character: int(reference.End.Column)},
+			},
+			Message:  fmt.Sprintf("SCSS variable '$%s' is not defined", reference.Name),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "scss.variable.undefined",
+			Data: map[string]any{
+				"variable": reference.Name,
+			},
+		})
+	}
+
+	return diagnostics, nil
+}
+
+// isDefined checks if a variable is declared in any indexed stylesheet or is a SCSS field of a theme.json
+func (p *ScssDiagnosticsProvider) isDefined(name string) bool {
+	symbols, err := p.scssIndexer.GetSymbols(scss.SymbolVariable, name)
+	if err != nil || len(symbols) > 0 {
+		return true
+	}
+
+	fields, err := p.themeIndexer.GetThemeConfigField(name)
+	if err != nil {
+		return true
+	}
+
+	for _, field := range fields {
+		if field.Scss {
+			return true
+		}
+	}
+
+	return false
+}
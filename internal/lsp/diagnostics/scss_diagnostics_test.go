@@ -0,0 +1,66 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestScssDiagnosticsProvider_UndefinedVariables(t *testing.T) {
+	scssIndexer, err := scss.NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = scssIndexer.Close() }()
+
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+
+	provider := &ScssDiagnosticsProvider{scssIndexer: scssIndexer, themeIndexer: themeIndexer}
+
+	code := `@mixin spaced($size) {
+  margin: $size;
+}
+
+.cart {
+  $local: 1px;
+  color: $primary;
+  border-color: $sw-color-brand-primary;
+  padding: $local $unknown-spacer;
+  background: url("#{$sw-asset-theme-url}/bg.png");
+}
+`
+	uri := "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+
+	scssLanguage := tree_sitter.NewLanguage(tree_sitter_scss.Language())
+	tree := parseWithLanguage(t, scssLanguage, code)
+	defer tree.Close()
+
+	// Nothing is reported as long as the Storefront variables are not indexed
+	diagnostics, err := provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+
+	variables := "$primary: #0042a0 !default;\n"
+	variablesTree := parseWithLanguage(t, scssLanguage, variables)
+	defer variablesTree.Close()
+	require.NoError(t, scssIndexer.Index("/project/vendor/shopware/storefront/Resources/app/storefront/src/scss/abstract/_variables.scss", variablesTree.RootNode(), []byte(variables)))
+	require.NoError(t, themeIndexer.Index("/project/vendor/shopware/storefront/Resources/theme.json", nil, []byte(`{"config": {"fields": {"sw-color-brand-primary": {"type": "color", "value": "#0042a0"}}}}`)))
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "scss.variable.undefined", diagnostics[0].Code)
+	assert.Equal(t, "SCSS variable '$unknown-spacer' is not defined", diagnostics[0].Message)
+	assert.Equal(t, 8, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 18, diagnostics[0].Range.Start.Character)
+
+	// Storefront stylesheets are not checked
+	diagnostics, err = provider.GetDiagnostics(t.Context(), "file:///project/vendor/shopware/storefront/Resources/app/storefront/src/scss/base.scss", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
@@ -0,0 +1,72 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// ScssImportDiagnosticsProvider reports imports in plugin stylesheets which do not resolve to a file
+type ScssImportDiagnosticsProvider struct {
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewScssImportDiagnosticsProvider creates a new SCSS import diagnostics provider
+func NewScssImportDiagnosticsProvider(projectRoot string, lspServer *lsp.Server) *ScssImportDiagnosticsProvider {
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+
+	return &ScssImportDiagnosticsProvider{
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetDiagnostics returns diagnostics for @import, @use and @forward paths of Storefront SCSS in plugins and apps
+// which do not resolve. Packages of node_modules which are not installed are not reported.
+func (p *ScssImportDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if strings.ToLower(filepath.Ext(uri)) != ".scss" || !scss.IsPluginFile(uri) {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	imports := scss.ParseImports(rootNode, content)
+	if len(imports) == 0 {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	extensions, _ := p.extensionIndexer.GetAll()
+	resolver := scss.NewResolver(p.projectRoot, extensions)
+	filePath := strings.TrimPrefix(uri, "file://")
+
+	var diagnostics []protocol.Diagnostic
+	for _, imp := range imports {
+		resolved, judged := resolver.Resolve(imp.Path, filePath)
+		if !judged || resolved != "" {
+			continue
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: treesitterhelper.PositionAt(content, imp.Offset),
+				End:   treesitterhelper.PositionAt(content, imp.Offset+len(imp.Path)),
+			},
+			Message:  fmt.Sprintf("Cannot resolve import '%s'", imp.Path),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "scss.import.unresolved",
+			Data: map[string]any{
+				"path": imp.Path,
+			},
+		})
+	}
+
+	return diagnostics, nil
+}
@@ -0,0 +1,48 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestScssImportDiagnosticsProvider_UnresolvedImports(t *testing.T) {
+	root := t.TempDir()
+	for _, path := range []string{
+		"vendor/shopware/storefront/Resources/app/storefront/src/scss/_variables.scss",
+		"custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/components/_card.scss",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(""), 0o644))
+	}
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	provider := &ScssImportDiagnosticsProvider{extensionIndexer: extensionIndexer, projectRoot: root}
+
+	code := `@use 'sass:math';
+@import '~scss/variables';
+@import 'components/card', 'components/missing';
+@import '~tiny-slider/src/tiny-slider';
+`
+	uri := "file://" + filepath.Join(root, "custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss")
+
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_scss.Language()), code)
+	defer tree.Close()
+
+	diagnostics, err := provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "scss.import.unresolved", diagnostics[0].Code)
+	assert.Equal(t, "Cannot resolve import 'components/missing'", diagnostics[0].Message)
+	assert.Equal(t, 2, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 28, diagnostics[0].Range.Start.Character)
+}
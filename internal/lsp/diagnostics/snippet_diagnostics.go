@@ -28,7 +28,7 @@ func (s *SnippetDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri str
 	switch strings.ToLower(filepath.Ext(uri)) {
 	case ".twig":
 		return s.twigDiagnostics(ctx, uri, rootNode, content)
-	case ".js", ".ts":
+	case ".js", ".ts", ".tsx", ".mts":
 		return s.jsDiagnostics(ctx, uri, rootNode, content)
 	default:
 		return []protocol.Diagnostic{}, nil
@@ -0,0 +1,209 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+const systemConfigServiceClass = "Shopware\\Core\\System\\SystemConfig\\SystemConfigService"
+
+// systemConfigGetters maps the getters of the SystemConfigService to the value types they accept, get() accepts any
+var systemConfigGetters = map[string][]string{
+	"get":       nil,
+	"getString": {systemconfig.ValueTypeString},
+	"getInt":    {systemconfig.ValueTypeInt},
+	"getFloat":  {systemconfig.ValueTypeInt, systemconfig.ValueTypeFloat},
+	"getBool":   {systemconfig.ValueTypeBool},
+}
+
+// SystemConfigDiagnosticsProvider reports system config keys which are not declared by any config.xml and
+// getters which do not match the type of the field
+type SystemConfigDiagnosticsProvider struct {
+	indexer  *systemconfig.SystemConfigIndexer
+	phpIndex *php.PHPIndex
+}
+
+// NewSystemConfigDiagnosticsProvider creates a new system config diagnostics provider
+func NewSystemConfigDiagnosticsProvider(lspServer *lsp.Server) *SystemConfigDiagnosticsProvider {
+	indexer, _ := lspServer.GetIndexer("systemconfig.indexer")
+	phpIndex, _ := lspServer.GetIndexer("php.index")
+
+	return &SystemConfigDiagnosticsProvider{
+		indexer:  indexer.(*systemconfig.SystemConfigIndexer),
+		phpIndex: phpIndex.(*php.PHPIndex),
+	}
+}
+
+// systemConfigReference is a config key read in a template or by a SystemConfigService getter
+type systemConfigReference struct {
+	key    string
+	method string
+	node   *tree_sitter.Node
+}
+
+// GetDiagnostics returns diagnostics for SystemConfigService getter calls and Twig config() calls. Keys of the
+// core namespace are only checked when the core config is indexed.
+func (p *SystemConfigDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if rootNode == nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	var references []systemConfigReference
+
+	switch strings.ToLower(filepath.Ext(uri)) {
+	case ".twig":
+		for _, match := range treesitterhelper.FindAll(rootNode, treesitterhelper.TwigStringInFunctionPattern("config"), content) {
+			references = append(references, systemConfigReference{
+				key:  treesitterhelper.GetNodeText(match, content),
+				node: match,
+			})
+		}
+	case ".php":
+		references = p.phpReferences(ctx, rootNode, content)
+	}
+
+	if len(references) == 0 {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	keys, err := p.indexer.GetSystemConfigEntries()
+	if err != nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	hasCoreConfig := slices.ContainsFunc(keys, func(key string) bool {
+		return strings.HasPrefix(key, "core.")
+	})
+
+	var diagnostics []protocol.Diagnostic
+
+	for _, reference := range references {
+		if reference.key == "" || strings.Contains(reference.key, "$") {
+			continue
+		}
+
+		if strings.HasPrefix(reference.key, "core.") && !hasCoreConfig {
+			continue
+		}
+
+		rng := protocol.Range{
+			Start: protocol.Position{
+				Line:      int(reference.node.StartPosition().Row),
+				Character: int(reference.node.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(reference.node.EndPosition().Row),
+				Character: int(reference.node.EndPosition().Column),
+			},
+		}
+
+		if !isKnownSystemConfigKey(keys, reference.key) {
+			diagnostics = append(diagnostics, protocol.Diagnostic{
+				Range:    rng,
+				Message:  fmt.Sprintf("System config key '%s' is not declared in any config.xml", reference.key),
+				Source:   "shopware",
+				Severity: protocol.DiagnosticSeverityWarning,
+				Code:     "systemconfig.key.unknown",
+				Data: map[string]any{
+					"key": reference.key,
+				},
+			})
+			continue
+		}
+
+		if fieldType, ok := p.mismatchingFieldType(reference); ok {
+			diagnostics = append(diagnostics, protocol.Diagnostic{
+				Range:    rng,
+				Message:  fmt.Sprintf("System config field '%s' has type '%s' which does not match %s()", reference.key, fieldType, reference.method),
+				Source:   "shopware",
+				Severity: protocol.DiagnosticSeverityWarning,
+				Code:     "systemconfig.type.mismatch",
+				Data: map[string]any{
+					"key":       reference.key,
+					"method":    reference.method,
+					"fieldType": fieldType,
+				},
+			})
+		}
+	}
+
+	return diagnostics, nil
+}
+
+// phpReferences returns the keys passed as string literal to getters of the SystemConfigService
+func (p *SystemConfigDiagnosticsProvider) phpReferences(ctx context.Context, rootNode *tree_sitter.Node, content []byte) []systemConfigReference {
+	var references []systemConfigReference
+
+	for _, call := range treesitterhelper.FindAll(rootNode, treesitterhelper.NodeKind("member_call_expression"), content) {
+		nameNode := call.ChildByFieldName("name")
+		if nameNode == nil {
+			continue
+		}
+
+		method := treesitterhelper.GetNodeText(nameNode, content)
+		if _, ok := systemConfigGetters[method]; !ok {
+			continue
+		}
+
+		key, ok := php.StringArgument(call, 0, content)
+		if !ok {
+			continue
+		}
+
+		if !p.phpIndex.IsMethodCalledOnClass(p.phpIndex.AddContext(ctx, call, content), call, content, systemConfigServiceClass) {
+			continue
+		}
+
+		references = append(references, systemConfigReference{
+			key:    key,
+			method: method,
+			node:   php.ArgumentValue(call, 0),
+		})
+	}
+
+	return references
+}
+
+// mismatchingFieldType returns the type of the field if no declaration of the key has a value type accepted by
+// the getter
+func (p *SystemConfigDiagnosticsProvider) mismatchingFieldType(reference systemConfigReference) (string, bool) {
+	accepted := systemConfigGetters[reference.method]
+	if len(accepted) == 0 {
+		return "", false
+	}
+
+	entries, err := p.indexer.GetSystemConfigEntry(reference.key)
+	if err != nil || len(entries) == 0 {
+		return "", false
+	}
+
+	for _, entry := range entries {
+		valueType := systemconfig.FieldValueType(entry.Type)
+		if entry.Component != "" || valueType == "" || slices.Contains(accepted, valueType) {
+			return "", false
+		}
+	}
+
+	return entries[0].Type, true
+}
+
+// isKnownSystemConfigKey checks if a key is declared or is the domain of declared keys
+func isKnownSystemConfigKey(keys []string, key string) bool {
+	for _, known := range keys {
+		if known == key || strings.HasPrefix(known, key+".") {
+			return true
+		}
+	}
+
+	return false
+}
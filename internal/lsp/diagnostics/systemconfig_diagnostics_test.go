@@ -0,0 +1,123 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_xml "github.com/tree-sitter-grammars/tree-sitter-xml/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+const systemConfigSchemaHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<config xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:noNamespaceSchemaLocation="https://raw.githubusercontent.com/shopware/shopware/trunk/src/Core/System/SystemConfig/Schema/config.xsd">`
+
+func indexSystemConfig(t *testing.T, indexer *systemconfig.SystemConfigIndexer, path, code string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML()), code)
+	defer tree.Close()
+
+	require.NoError(t, indexer.Index(path, tree.RootNode(), []byte(code)))
+}
+
+func TestSystemConfigDiagnosticsProvider(t *testing.T) {
+	tempDir := t.TempDir()
+
+	phpIndex, err := php.NewPHPIndex(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = phpIndex.Close() }()
+
+	indexer, err := systemconfig.NewSystemConfigIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	pluginDir := filepath.Join(tempDir, "custom", "plugins", "SwagExample")
+	require.NoError(t, os.MkdirAll(pluginDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "composer.json"), []byte(`{"extra": {"shopware-plugin-class": "Swag\\Example\\SwagExample"}}`), 0644))
+
+	indexSystemConfig(t, indexer, filepath.Join(pluginDir, "src", "Resources", "config", "config.xml"), systemConfigSchemaHeader+`
+    <card>
+        <input-field type="text"><name>title</name></input-field>
+        <input-field type="bool"><name>enabled</name></input-field>
+        <input-field type="int"><name>limit</name></input-field>
+        <component name="sw-entity-single-select"><name>category</name></component>
+    </card>
+</config>`)
+
+	code := `<?php
+
+namespace Swag\Example;
+
+use Shopware\Core\System\SystemConfig\SystemConfigService;
+
+class Subscriber
+{
+    public function __construct(private SystemConfigService $systemConfigService)
+    {
+    }
+
+    public function handle(): void
+    {
+        $this->systemConfigService->getString('SwagExample.config.title');
+        $this->systemConfigService->getBool('SwagExample.config.title');
+        $this->systemConfigService->getInt('SwagExample.config.enabled');
+        $this->systemConfigService->getFloat('SwagExample.config.limit');
+        $this->systemConfigService->getInt('SwagExample.config.category');
+        $this->systemConfigService->get('SwagExample.config');
+        $this->systemConfigService->get('SwagExample.config.renamed');
+        $this->systemConfigService->get('core.basicInformation.missing');
+        $this->systemConfigService->get($key);
+    }
+}
+`
+	phpPath := filepath.Join(pluginDir, "src", "Subscriber.php")
+	phpTree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP()), code)
+	defer phpTree.Close()
+	require.NoError(t, phpIndex.Index(phpPath, phpTree.RootNode(), []byte(code)))
+
+	provider := &SystemConfigDiagnosticsProvider{indexer: indexer, phpIndex: phpIndex}
+
+	diagnostics, err := provider.GetDiagnostics(t.Context(), "file://"+phpPath, phpTree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 3)
+
+	assert.Equal(t, "systemconfig.type.mismatch", diagnostics[0].Code.(string))
+	assert.Equal(t, "System config field 'SwagExample.config.title' has type 'text' which does not match getBool()", diagnostics[0].Message)
+	assert.Equal(t, 15, diagnostics[0].Range.Start.Line)
+
+	assert.Equal(t, "systemconfig.type.mismatch", diagnostics[1].Code.(string))
+	assert.Equal(t, "System config field 'SwagExample.config.enabled' has type 'bool' which does not match getInt()", diagnostics[1].Message)
+
+	assert.Equal(t, "systemconfig.key.unknown", diagnostics[2].Code.(string))
+	assert.Equal(t, "System config key 'SwagExample.config.renamed' is not declared in any config.xml", diagnostics[2].Message)
+	assert.Equal(t, 20, diagnostics[2].Range.Start.Line)
+
+	// Core keys are checked once the core config is indexed
+	indexSystemConfig(t, indexer, filepath.Join(tempDir, "vendor", "shopware", "core", "System", "Resources", "config", "basicInformation.xml"), systemConfigSchemaHeader+`
+    <card>
+        <input-field><name>shopName</name></input-field>
+    </card>
+</config>`)
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), "file://"+phpPath, phpTree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 4)
+	assert.Equal(t, "System config key 'core.basicInformation.missing' is not declared in any config.xml", diagnostics[3].Message)
+
+	twigCode := `{{ config('core.basicInformation.shopName') }} {{ config('SwagExample.config.missing') }}`
+	twigTree, twigParser := parseTwig(t, twigCode)
+	defer twigTree.Close()
+	defer twigParser.Close()
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), "file:///project/src/Resources/views/storefront/base.html.twig", twigTree.RootNode(), []byte(twigCode))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "systemconfig.key.unknown", diagnostics[0].Code.(string))
+	assert.Equal(t, 57, diagnostics[0].Range.Start.Character)
+}
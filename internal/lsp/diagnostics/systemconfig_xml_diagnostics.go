@@ -0,0 +1,181 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// SystemConfigXMLDiagnosticsProvider validates the cards and fields of system config.xml files
+type SystemConfigXMLDiagnosticsProvider struct {
+	indexer *systemconfig.SystemConfigIndexer
+}
+
+// NewSystemConfigXMLDiagnosticsProvider creates a new config.xml diagnostics provider
+func NewSystemConfigXMLDiagnosticsProvider(lspServer *lsp.Server) *SystemConfigXMLDiagnosticsProvider {
+	indexer, _ := lspServer.GetIndexer("systemconfig.indexer")
+	return &SystemConfigXMLDiagnosticsProvider{
+		indexer: indexer.(*systemconfig.SystemConfigIndexer),
+	}
+}
+
+// GetDiagnostics returns diagnostics for field names declared twice in the namespace, cards and fields without
+// en-GB title or label, options on fields which are no selects and default values not matching the field type
+func (p *SystemConfigXMLDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if rootNode == nil || strings.ToLower(filepath.Ext(uri)) != ".xml" || !systemconfig.IsSystemConfigXML(content) {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	filePath := strings.TrimPrefix(uri, "file://")
+	namespace, err := systemconfig.GetNamespaceFromPath(filePath)
+	if err != nil {
+		namespace = ""
+	}
+
+	var diagnostics []protocol.Diagnostic
+	names := make(map[string]bool)
+
+	for _, element := range treesitterhelper.FindAll(rootNode, treesitterhelper.NodeKind("element"), content) {
+		tagName := xmlTagName(element)
+		if tagName == nil {
+			continue
+		}
+
+		switch systemconfig.GetElementName(element, content) {
+		case "card":
+			if _, ok := systemconfig.GetLocalizedChildTexts(element, content, "title")[systemconfig.DefaultLocale]; !ok {
+				diagnostics = append(diagnostics, protocol.Diagnostic{
+					Range:    nodeRange(tagName),
+					Message:  "The card has no en-GB <title>",
+					Source:   "shopware",
+					Severity: protocol.DiagnosticSeverityWarning,
+					Code:     "systemconfig.title.missing-en-gb",
+				})
+			}
+		case "input-field", "component":
+			diagnostics = append(diagnostics, p.fieldDiagnostics(element, tagName, content, filePath, namespace, names)...)
+		}
+	}
+
+	return diagnostics, nil
+}
+
+// fieldDiagnostics validates an input-field or component element
+func (p *SystemConfigXMLDiagnosticsProvider) fieldDiagnostics(element, tagName *tree_sitter.Node, content []byte, filePath, namespace string, names map[string]bool) []protocol.Diagnostic {
+	var diagnostics []protocol.Diagnostic
+
+	name := systemconfig.GetChildText(element, content, "name")
+	if nameElement := systemconfig.GetChildElement(element, content, "name"); nameElement != nil && name != "" {
+		if names[name] || p.isDeclaredInOtherFile(namespace, name, filePath) {
+			diagnostics = append(diagnostics, protocol.Diagnostic{
+				Range:    nodeRange(nameElement),
+				Message:  fmt.Sprintf("The field '%s' is already declared in the namespace '%s'", name, namespace),
+				Source:   "shopware",
+				Severity: protocol.DiagnosticSeverityWarning,
+				Code:     "systemconfig.name.duplicate",
+				Data: map[string]any{
+					"name":      name,
+					"namespace": namespace,
+				},
+			})
+		}
+		names[name] = true
+	}
+
+	if _, ok := systemconfig.GetLocalizedChildTexts(element, content, "label")[systemconfig.DefaultLocale]; !ok {
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    nodeRange(tagName),
+			Message:  fmt.Sprintf("The field '%s' has no en-GB <label>", name),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "systemconfig.label.missing-en-gb",
+			Data: map[string]any{
+				"name": name,
+			},
+		})
+	}
+
+	// Components receive options and default values as props, only input-fields are validated
+	if systemconfig.GetElementName(element, content) != "input-field" {
+		return diagnostics
+	}
+
+	fieldType := systemconfig.GetSystemConfigFieldType(element, content)
+	if fieldType == "" {
+		fieldType = "text"
+	}
+
+	if options := systemconfig.GetChildElement(element, content, "options"); options != nil && !systemconfig.FieldTypeSupportsOptions(fieldType) {
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    nodeRange(options),
+			Message:  fmt.Sprintf("Fields of type '%s' do not support <options>", fieldType),
+			Source:   "shopware",
+			Severity: protocol.DiagnosticSeverityWarning,
+			Code:     "systemconfig.options.unsupported",
+			Data: map[string]any{
+				"name": name,
+				"type": fieldType,
+			},
+		})
+	}
+
+	if defaultValue := systemconfig.GetChildElement(element, content, "defaultValue"); defaultValue != nil {
+		value := systemconfig.GetChildText(element, content, "defaultValue")
+		if !systemconfig.IsValidDefaultValue(fieldType, value, systemconfig.GetSystemConfigOptions(element, content)) {
+			diagnostics = append(diagnostics, protocol.Diagnostic{
+				Range:    nodeRange(defaultValue),
+				Message:  fmt.Sprintf("The default value '%s' is not valid for a field of type '%s'", value, fieldType),
+				Source:   "shopware",
+				Severity: protocol.DiagnosticSeverityWarning,
+				Code:     "systemconfig.default.invalid",
+				Data: map[string]any{
+					"name":  name,
+					"type":  fieldType,
+					"value": value,
+				},
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+// isDeclaredInOtherFile checks if another config.xml of the namespace declares a field with the same name
+func (p *SystemConfigXMLDiagnosticsProvider) isDeclaredInOtherFile(namespace, name, filePath string) bool {
+	if namespace == "" {
+		return false
+	}
+
+	entries, err := p.indexer.GetSystemConfigEntry(namespace + "." + name)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if entry.FilePath != filePath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// xmlTagName returns the name node of the start tag of an element
+func xmlTagName(element *tree_sitter.Node) *tree_sitter.Node {
+	tag := treesitterhelper.GetFirstNodeOfKind(element, "STag")
+	if tag == nil {
+		tag = treesitterhelper.GetFirstNodeOfKind(element, "EmptyElemTag")
+	}
+	if tag == nil {
+		return nil
+	}
+
+	return treesitterhelper.GetFirstNodeOfKind(tag, "Name")
+}
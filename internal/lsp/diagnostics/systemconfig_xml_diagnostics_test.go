@@ -0,0 +1,101 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_xml "github.com/tree-sitter-grammars/tree-sitter-xml/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestSystemConfigXMLDiagnosticsProvider(t *testing.T) {
+	tempDir := t.TempDir()
+
+	indexer, err := systemconfig.NewSystemConfigIndexer(tempDir)
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	pluginDir := filepath.Join(tempDir, "custom", "plugins", "SwagExample")
+	require.NoError(t, os.MkdirAll(pluginDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "composer.json"), []byte(`{"extra": {"shopware-plugin-class": "Swag\\Example\\SwagExample"}}`), 0644))
+
+	indexSystemConfig(t, indexer, filepath.Join(pluginDir, "src", "Resources", "config", "other.xml"), systemConfigSchemaHeader+`
+    <card>
+        <title>Other</title>
+        <input-field><name>shared</name><label>Shared</label></input-field>
+    </card>
+</config>`)
+
+	code := systemConfigSchemaHeader + `
+    <card>
+        <title lang="de-DE">Einstellungen</title>
+        <input-field type="int">
+            <name>limit</name>
+            <label>Limit</label>
+            <defaultValue>ten</defaultValue>
+        </input-field>
+        <input-field type="text">
+            <name>limit</name>
+            <label lang="de-DE">Limit</label>
+            <options>
+                <option><id>a</id></option>
+            </options>
+        </input-field>
+        <input-field type="single-select">
+            <name>mode</name>
+            <label>Mode</label>
+            <defaultValue>popup</defaultValue>
+            <options>
+                <option><id>inline</id></option>
+                <option><id>modal</id></option>
+            </options>
+        </input-field>
+        <input-field type="bool">
+            <name>shared</name>
+            <label>Shared</label>
+            <defaultValue>true</defaultValue>
+        </input-field>
+        <component name="sw-entity-single-select">
+            <name>category</name>
+            <label>Category</label>
+        </component>
+    </card>
+</config>`
+	configPath := filepath.Join(pluginDir, "src", "Resources", "config", "config.xml")
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML()), code)
+	defer tree.Close()
+
+	provider := &SystemConfigXMLDiagnosticsProvider{indexer: indexer}
+
+	diagnostics, err := provider.GetDiagnostics(t.Context(), "file://"+configPath, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+
+	var codes []string
+	for _, diagnostic := range diagnostics {
+		codes = append(codes, diagnostic.Code.(string))
+	}
+	assert.Equal(t, []string{
+		"systemconfig.title.missing-en-gb",
+		"systemconfig.default.invalid",
+		"systemconfig.name.duplicate",
+		"systemconfig.label.missing-en-gb",
+		"systemconfig.options.unsupported",
+		"systemconfig.default.invalid",
+		"systemconfig.name.duplicate",
+	}, codes)
+
+	assert.Equal(t, "The default value 'ten' is not valid for a field of type 'int'", diagnostics[1].Message)
+	assert.Equal(t, "The field 'limit' is already declared in the namespace 'SwagExample.config'", diagnostics[2].Message)
+	assert.Equal(t, 10, diagnostics[2].Range.Start.Line)
+	assert.Equal(t, "Fields of type 'text' do not support <options>", diagnostics[4].Message)
+	assert.Equal(t, "The default value 'popup' is not valid for a field of type 'single-select'", diagnostics[5].Message)
+	assert.Equal(t, "The field 'shared' is already declared in the namespace 'SwagExample.config'", diagnostics[6].Message)
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), "file:///project/config/services.xml", tree.RootNode(), []byte(`<container/>`))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
@@ -0,0 +1,160 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// ThemeJSONDiagnosticsProvider validates the config of theme.json files
+type ThemeJSONDiagnosticsProvider struct {
+	themeIndexer *theme.ThemeConfigIndexer
+	projectRoot  string
+}
+
+// NewThemeJSONDiagnosticsProvider creates a new theme.json diagnostics provider
+func NewThemeJSONDiagnosticsProvider(projectRoot string, lspServer *lsp.Server) *ThemeJSONDiagnosticsProvider {
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
+	return &ThemeJSONDiagnosticsProvider{
+		themeIndexer: themeIndexer.(*theme.ThemeConfigIndexer),
+		projectRoot:  projectRoot,
+	}
+}
+
+// GetDiagnostics returns diagnostics for unknown field types, fields referencing blocks, sections or tabs which
+// are not declared and labels without an en-GB translation. Groups may also be declared by the Storefront theme,
+// so references are only checked when it is installed and indexed.
+func (p *ThemeJSONDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if filepath.Base(uri) != "theme.json" || rootNode == nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	root := rootNode
+	if root.Kind() == "document" && root.NamedChildCount() > 0 {
+		root = root.NamedChild(0)
+	}
+
+	config := theme.ObjectMember(root, "config", content)
+	if config == nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	var diagnostics []protocol.Diagnostic
+
+	groups := theme.ParseConfigGroups(rootNode, content)
+	for _, property := range []string{"block", "section", "tab"} {
+		for _, group := range groups[property] {
+			if len(group.Label) > 0 {
+				if _, ok := group.Label["en-GB"]; !ok {
+					diagnostics = append(diagnostics, missingLabelDiagnostic(group.Node, property, group.Name))
+				}
+			}
+		}
+	}
+
+	declared, checkGroups := p.declaredGroups(groups)
+
+	for _, field := range theme.ObjectPairs(theme.ObjectMember(config, "fields", content)) {
+		fieldName := theme.StringContent(field[0], content)
+
+		for _, property := range theme.ObjectPairs(field[1]) {
+			key := theme.StringContent(property[0], content)
+			value := property[1]
+
+			switch {
+			case key == "type" && value.Kind() == "string":
+				fieldType := theme.StringContent(value, content)
+				if !slices.Contains(theme.FieldTypes, fieldType) {
+					diagnostics = append(diagnostics, protocol.Diagnostic{
+						Range:    nodeRange(value),
+						Message:  fmt.Sprintf("Unknown theme config field type '%s'", fieldType),
+						Source:   "shopware",
+						Severity: protocol.DiagnosticSeverityWarning,
+						Code:     "theme.field.type.unknown",
+						Data: map[string]any{
+							"field": fieldName,
+							"type":  fieldType,
+						},
+					})
+				}
+			case theme.GroupProperties[key] != "" && value.Kind() == "string" && checkGroups:
+				name := theme.StringContent(value, content)
+				if !slices.Contains(declared[key], name) {
+					diagnostics = append(diagnostics, protocol.Diagnostic{
+						Range:    nodeRange(value),
+						Message:  fmt.Sprintf("The %s '%s' is not declared in config.%s", key, name, theme.GroupProperties[key]),
+						Source:   "shopware",
+						Severity: protocol.DiagnosticSeverityWarning,
+						Code:     "theme.group.undeclared",
+						Data: map[string]any{
+							"field":    fieldName,
+							"property": key,
+							"name":     name,
+						},
+					})
+				}
+			case key == "label" && value.Kind() == "object":
+				if theme.ObjectMember(value, "en-GB", content) == nil {
+					diagnostics = append(diagnostics, missingLabelDiagnostic(property[0], "field", fieldName))
+				}
+			}
+		}
+	}
+
+	if diagnostics == nil {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	return diagnostics, nil
+}
+
+// declaredGroups returns the groups declared in the document and the Storefront theme, and false if the
+// Storefront theme is not installed or not indexed yet
+func (p *ThemeJSONDiagnosticsProvider) declaredGroups(groups map[string][]theme.ConfigGroup) (map[string][]string, bool) {
+	storefrontGroups, ok := p.themeIndexer.GetStorefrontGroups(p.projectRoot)
+	if !ok {
+		return nil, false
+	}
+
+	declared := make(map[string][]string, len(storefrontGroups))
+	for property, names := range storefrontGroups {
+		declared[property] = slices.Clone(names)
+	}
+
+	for property, list := range groups {
+		for _, group := range list {
+			declared[property] = append(declared[property], group.Name)
+		}
+	}
+
+	return declared, true
+}
+
+// missingLabelDiagnostic reports a label without en-GB translation, which is the fallback of the Administration
+func missingLabelDiagnostic(node *tree_sitter.Node, kind, name string) protocol.Diagnostic {
+	return protocol.Diagnostic{
+		Range:    nodeRange(node),
+		Message:  fmt.Sprintf("The label of %s '%s' has no en-GB translation", kind, name),
+		Source:   "shopware",
+		Severity: protocol.DiagnosticSeverityWarning,
+		Code:     "theme.label.missing-en-gb",
+		Data: map[string]any{
+			"kind": kind,
+			"name": name,
+		},
+	}
+}
+
+// nodeRange returns the range of a node
+func nodeRange(node *tree_sitter.Node) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{Line: int(node.StartPosition().Row), Character: int(node.StartPosition().Column)},
+		End:   protocol.Position{Line: int(node.EndPosition().Row), Character: int(node.EndPosition().Column)},
+	}
+}
@@ -0,0 +1,70 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+func TestThemeJSONDiagnosticsProvider(t *testing.T) {
+	root := t.TempDir()
+	storefrontTheme := filepath.Join(root, "vendor/shopware/storefront/Resources/theme.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(storefrontTheme), 0o755))
+	storefrontContent := []byte(`{"config": {"blocks": {"themeColors": {}}}}`)
+	require.NoError(t, os.WriteFile(storefrontTheme, storefrontContent, 0o644))
+
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+
+	code := `{
+  "config": {
+    "blocks": {"myBlock": {"label": {"de-DE": "Mein Block"}}},
+    "fields": {
+      "sw-color-brand": {"type": "color", "block": "themeColors", "label": {"en-GB": "Brand", "de-DE": "Marke"}},
+      "sw-color-custom": {"type": "colour", "block": "myBlock"},
+      "sw-logo": {"type": "media", "block": "logos", "label": {"de-DE": "Logo"}}
+    }
+  }
+}`
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_json.Language()), code)
+	defer tree.Close()
+
+	provider := &ThemeJSONDiagnosticsProvider{themeIndexer: themeIndexer, projectRoot: root}
+	uri := "file://" + filepath.Join(root, "custom/plugins/MyTheme/src/Resources/theme.json")
+
+	// Until the Storefront theme is indexed the groups it declares are unknown
+	diagnostics, err := provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Len(t, diagnostics, 3)
+
+	require.NoError(t, themeIndexer.Index(storefrontTheme, nil, storefrontContent))
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+
+	var codes []string
+	for _, diagnostic := range diagnostics {
+		codes = append(codes, diagnostic.Code.(string))
+	}
+	assert.Equal(t, []string{"theme.label.missing-en-gb", "theme.field.type.unknown", "theme.group.undeclared", "theme.label.missing-en-gb"}, codes)
+	assert.Equal(t, "Unknown theme config field type 'colour'", diagnostics[1].Message)
+	assert.Equal(t, 5, diagnostics[1].Range.Start.Line)
+	assert.Equal(t, "The block 'logos' is not declared in config.blocks", diagnostics[2].Message)
+
+	// Without the Storefront the groups it declares are unknown, so references are not checked
+	provider.projectRoot = t.TempDir()
+	diagnostics, err = provider.GetDiagnostics(t.Context(), uri, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Len(t, diagnostics, 3)
+
+	diagnostics, err = provider.GetDiagnostics(t.Context(), "file:///project/composer.json", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
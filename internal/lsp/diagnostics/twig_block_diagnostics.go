@@ -0,0 +1,91 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type TwigBlockDiagnosticsProvider struct {
+	twigIndexer *twig.TwigIndexer
+}
+
+func NewTwigBlockDiagnosticsProvider(lspServer *lsp.Server) *TwigBlockDiagnosticsProvider {
+	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
+
+	return &TwigBlockDiagnosticsProvider{
+		twigIndexer: twigIndexer.(*twig.TwigIndexer),
+	}
+}
+
+func (p *TwigBlockDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if filepath.Ext(uri) != ".twig" || strings.Contains(uri, "Resources/app/administration") {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	filePath := strings.TrimPrefix(uri, "file://")
+
+	currentFile, err := twig.ParseTwig(filePath, rootNode, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentFile.ExtendsFile == "" {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	parentBlocks, found := p.twigIndexer.GetParentBlocks(currentFile.ExtendsFile, filePath)
+	if !found {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	parentBlockNames := make([]string, 0, len(parentBlocks))
+	for name := range parentBlocks {
+		parentBlockNames = append(parentBlockNames, name)
+	}
+
+	var diagnostics []protocol.Diagnostic
+
+	for _, nameNode := range twig.TopLevelBlockNames(rootNode) {
+		blockName := string(nameNode.Utf8Text(content))
+		if _, ok := parentBlocks[blockName]; ok {
+			continue
+		}
+
+		message := fmt.Sprintf("Block '%s' does not exist in the parent template '%s' and will never be rendered", blockName, currentFile.ExtendsFile)
+
+		suggestions := twig.SuggestBlockNames(blockName, parentBlockNames)
+		if len(suggestions) > 0 {
+			message += fmt.Sprintf(". Did you mean '%s'?", strings.Join(suggestions, "', '"))
+		}
+
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      int(nameNode.StartPosition().Row),
+					Character: int(nameNode.StartPosition().Column),
+				},
+				End: protocol.Position{
+					Line:      int(nameNode.EndPosition().Row),
+					Character: int(nameNode.EndPosition().Column),
+				},
+			},
+			Severity: protocol.DiagnosticSeverityWarning,
+			Source:   "shopware-lsp",
+			Code:     "twig.block.unknown",
+			Message:  message,
+			Data: map[string]any{
+				"blockName":   blockName,
+				"suggestions": suggestions,
+			},
+		})
+	}
+
+	return diagnostics, nil
+}
@@ -0,0 +1,68 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestTwigBlockDiagnosticsProvider_UnknownBlock(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	language := tree_sitter.NewLanguage(tree_sitter_twig.Language())
+
+	index := func(path, code string) {
+		tree := parseWithLanguage(t, language, code)
+		defer tree.Close()
+		require.NoError(t, twigIndexer.Index(path, tree.RootNode(), []byte(code)))
+	}
+
+	index("/project/vendor/shopware/storefront/Resources/views/storefront/base.html.twig",
+		`{% block base_body %}{% block base_header %}{% endblock %}{% endblock %}`)
+	index("/project/vendor/shopware/storefront/Resources/views/storefront/page/product-detail/index.html.twig",
+		`{% sw_extends '@Storefront/storefront/base.html.twig' %}{% block page_product_detail %}{% endblock %}`)
+
+	provider := &TwigBlockDiagnosticsProvider{twigIndexer: twigIndexer}
+
+	path := "/project/custom/plugins/Acme/src/Resources/views/storefront/page/product-detail/index.html.twig"
+	code := `{% sw_extends '@Storefront/storefront/page/product-detail/index.html.twig' %}
+{% block page_product_detail %}{% block acme_new_block %}{% endblock %}{% endblock %}
+{% block base_header %}{% endblock %}
+{% block base_haeder %}{% endblock %}`
+
+	tree := parseWithLanguage(t, language, code)
+	defer tree.Close()
+	index(path, code)
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file://"+path, tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+
+	assert.Equal(t, "twig.block.unknown", diagnostics[0].Code)
+	assert.Equal(t, "Block 'base_haeder' does not exist in the parent template '@Storefront/storefront/page/product-detail/index.html.twig' and will never be rendered. Did you mean 'base_header'?", diagnostics[0].Message)
+	assert.Equal(t, 3, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 9, diagnostics[0].Range.Start.Character)
+}
+
+func TestTwigBlockDiagnosticsProvider_ParentNotIndexed(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	provider := &TwigBlockDiagnosticsProvider{twigIndexer: twigIndexer}
+
+	code := `{% sw_extends '@Storefront/storefront/base.html.twig' %}{% block foo %}{% endblock %}`
+	tree := parseWithLanguage(t, tree_sitter.NewLanguage(tree_sitter_twig.Language()), code)
+	defer tree.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file:///project/Resources/views/storefront/base.html.twig", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	assert.Empty(t, diagnostics)
+}
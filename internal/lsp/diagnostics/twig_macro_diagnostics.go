@@ -0,0 +1,110 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type TwigMacroDiagnosticsProvider struct {
+	twigIndexer *twig.TwigIndexer
+}
+
+func NewTwigMacroDiagnosticsProvider(lspServer *lsp.Server) *TwigMacroDiagnosticsProvider {
+	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
+
+	return &TwigMacroDiagnosticsProvider{
+		twigIndexer: twigIndexer.(*twig.TwigIndexer),
+	}
+}
+
+func (p *TwigMacroDiagnosticsProvider) GetDiagnostics(ctx context.Context, uri string, rootNode *tree_sitter.Node, content []byte) ([]protocol.Diagnostic, error) {
+	if filepath.Ext(uri) != ".twig" || strings.Contains(uri, "Resources/app/administration") {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	currentTemplate := twig.ConvertToRelativePath(strings.TrimPrefix(uri, "file://"))
+	imports := twig.ParseTwigMacroImports(rootNode, content, currentTemplate)
+	if len(imports) == 0 {
+		return []protocol.Diagnostic{}, nil
+	}
+
+	var diagnostics []protocol.Diagnostic
+
+	// Macros imported with from are checked at the import, calls of them would only repeat the warning
+	for _, macroImport := range imports {
+		for localName, nameNode := range macroImport.MacroNodes {
+			if diagnostic := p.checkMacro(macroImport.TemplatePath, macroImport.Macros[localName], nameNode); diagnostic != nil {
+				diagnostics = append(diagnostics, *diagnostic)
+			}
+		}
+	}
+
+	p.checkMacroCalls(rootNode, content, imports, &diagnostics)
+
+	return diagnostics, nil
+}
+
+func (p *TwigMacroDiagnosticsProvider) checkMacroCalls(node *tree_sitter.Node, content []byte, imports []twig.TwigMacroImport, diagnostics *[]protocol.Diagnostic) {
+	if node.Kind() == "call_expression" {
+		nameNode := node.ChildByFieldName("name")
+
+		if nameNode != nil && nameNode.Kind() == "member_expression" {
+			objectNode := nameNode.ChildByFieldName("object")
+			propertyNode := nameNode.ChildByFieldName("property")
+
+			if objectNode != nil && propertyNode != nil && objectNode.Kind() == "variable" {
+				callee := string(objectNode.Utf8Text(content)) + "." + string(propertyNode.Utf8Text(content))
+
+				if templatePath, macroName, ok := twig.ResolveMacroCallee(imports, callee); ok {
+					if diagnostic := p.checkMacro(templatePath, macroName, propertyNode); diagnostic != nil {
+						*diagnostics = append(*diagnostics, *diagnostic)
+					}
+				}
+			}
+		}
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		p.checkMacroCalls(node.NamedChild(i), content, imports, diagnostics)
+	}
+}
+
+// checkMacro reports a missing macro, templates which are not indexed are skipped as we cannot know their macros
+func (p *TwigMacroDiagnosticsProvider) checkMacro(templatePath, macroName string, node *tree_sitter.Node) *protocol.Diagnostic {
+	if !p.twigIndexer.HasTemplate(templatePath) {
+		return nil
+	}
+
+	macros, err := p.twigIndexer.GetMacros(templatePath)
+	if err != nil {
+		return nil
+	}
+
+	if _, ok := macros[macroName]; ok {
+		return nil
+	}
+
+	return &protocol.Diagnostic{
+		Range: protocol.Range{
+			Start: protocol.Position{
+				Line:      int(node.StartPosition().Row),
+				Character: int(node.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(node.EndPosition().Row),
+				Character: int(node.EndPosition().Column),
+			},
+		},
+		Severity: protocol.DiagnosticSeverityWarning,
+		Source:   "shopware-lsp",
+		Code:     "twig.macro.unknown",
+		Message:  fmt.Sprintf("Macro '%s' is not defined in '%s'", macroName, templatePath),
+	}
+}
@@ -0,0 +1,48 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestTwigMacroDiagnosticsProvider_UnknownMacro(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	language := tree_sitter.NewLanguage(tree_sitter_twig.Language())
+
+	macros := `{% macro input(name, type = 'text') %}{% endmacro %}`
+	macroTree := parseWithLanguage(t, language, macros)
+	defer macroTree.Close()
+	require.NoError(t, twigIndexer.Index("/project/custom/plugins/Acme/src/Resources/views/storefront/macros/forms.html.twig", macroTree.RootNode(), []byte(macros)))
+
+	provider := &TwigMacroDiagnosticsProvider{twigIndexer: twigIndexer}
+
+	code := `{% import '@Acme/storefront/macros/forms.html.twig' as forms %}
+{% from '@Storefront/storefront/macros/forms.html.twig' import input, textarea %}
+{% import '@Storefront/storefront/macros/missing.html.twig' as missing %}
+{{ forms.input('email') }}{{ forms.select('country') }}{{ missing.anything() }}`
+
+	tree := parseWithLanguage(t, language, code)
+	defer tree.Close()
+
+	diagnostics, err := provider.GetDiagnostics(context.Background(), "file:///project/custom/plugins/Acme/src/Resources/views/storefront/page/index.html.twig", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 2)
+
+	assert.Equal(t, "twig.macro.unknown", diagnostics[0].Code)
+	assert.Equal(t, "Macro 'textarea' is not defined in '@Storefront/storefront/macros/forms.html.twig'", diagnostics[0].Message)
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 70, diagnostics[0].Range.Start.Character)
+
+	assert.Equal(t, "Macro 'select' is not defined in '@Storefront/storefront/macros/forms.html.twig'", diagnostics[1].Message)
+	assert.Equal(t, 3, diagnostics[1].Range.Start.Line)
+	assert.Equal(t, 35, diagnostics[1].Range.Start.Character)
+}
@@ -0,0 +1,43 @@
+package lsp
+
+import (
+	"context"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+)
+
+// documentColor handles textDocument/documentColor requests
+func (s *Server) documentColor(ctx context.Context, params *protocol.DocumentColorParams) []protocol.ColorInformation {
+	content, ok := s.documentManager.GetDocumentText(params.TextDocument.URI)
+	if !ok {
+		return []protocol.ColorInformation{}
+	}
+	params.DocumentContent = content
+	params.RootNode = s.documentManager.GetRootNode(params.TextDocument.URI)
+
+	// Collect colors from all providers
+	colors := []protocol.ColorInformation{}
+	for _, provider := range s.documentColorProviders {
+		colors = append(colors, provider.GetDocumentColors(ctx, params)...)
+	}
+
+	return colors
+}
+
+// colorPresentation handles textDocument/colorPresentation requests
+func (s *Server) colorPresentation(ctx context.Context, params *protocol.ColorPresentationParams) []protocol.ColorPresentation {
+	content, ok := s.documentManager.GetDocumentText(params.TextDocument.URI)
+	if !ok {
+		return []protocol.ColorPresentation{}
+	}
+	params.DocumentContent = content
+
+	// The first provider handling the document decides how the color is written
+	for _, provider := range s.documentColorProviders {
+		if presentations := provider.GetColorPresentations(ctx, params); len(presentations) > 0 {
+			return presentations
+		}
+	}
+
+	return []protocol.ColorPresentation{}
+}
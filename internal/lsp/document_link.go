@@ -0,0 +1,25 @@
+package lsp
+
+import (
+	"context"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+)
+
+// documentLink handles textDocument/documentLink requests
+func (s *Server) documentLink(ctx context.Context, params *protocol.DocumentLinkParams) []protocol.DocumentLink {
+	content, ok := s.documentManager.GetDocumentText(params.TextDocument.URI)
+	if !ok {
+		return []protocol.DocumentLink{}
+	}
+	params.DocumentContent = content
+	params.RootNode = s.documentManager.GetRootNode(params.TextDocument.URI)
+
+	// Collect document links from all providers
+	links := []protocol.DocumentLink{}
+	for _, provider := range s.documentLinkProviders {
+		links = append(links, provider.GetDocumentLinks(ctx, params)...)
+	}
+
+	return links
+}
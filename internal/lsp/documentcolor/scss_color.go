@@ -0,0 +1,68 @@
+package documentcolor
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// ScssColorProvider provides the color literals of SCSS files
+type ScssColorProvider struct{}
+
+// NewScssColorProvider creates a new SCSS color provider
+func NewScssColorProvider() *ScssColorProvider {
+	return &ScssColorProvider{}
+}
+
+// GetDocumentColors returns the hex colors and rgb(), rgba(), hsl() and hsla() calls with literal arguments
+func (p *ScssColorProvider) GetDocumentColors(ctx context.Context, params *protocol.DocumentColorParams) []protocol.ColorInformation {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.ColorInformation{}
+	}
+
+	colors := []protocol.ColorInformation{}
+	for _, literal := range scss.ParseColors(params.RootNode, params.DocumentContent) {
+		colors = append(colors, protocol.ColorInformation{
+			Range: protocol.Range{
+				Start: treesitterhelper.PositionAt(params.DocumentContent, literal.Offset),
+				End:   treesitterhelper.PositionAt(params.DocumentContent, literal.Offset+literal.Length),
+			},
+			Color: toProtocolColor(literal.Color),
+		})
+	}
+
+	return colors
+}
+
+// GetColorPresentations returns the picked color as hex and rgb() value
+func (p *ScssColorProvider) GetColorPresentations(ctx context.Context, params *protocol.ColorPresentationParams) []protocol.ColorPresentation {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.ColorPresentation{}
+	}
+
+	return colorPresentations(params.Color, params.Range)
+}
+
+// colorPresentations returns the hex and rgb() notation of a color replacing the given range
+func colorPresentations(color protocol.Color, replace protocol.Range) []protocol.ColorPresentation {
+	value := scss.Color{Red: color.Red, Green: color.Green, Blue: color.Blue, Alpha: color.Alpha}
+
+	var presentations []protocol.ColorPresentation
+	for _, label := range []string{value.Hex(), value.RGB()} {
+		presentations = append(presentations, protocol.ColorPresentation{
+			Label:    label,
+			TextEdit: &protocol.TextEdit{Range: replace, NewText: label},
+		})
+	}
+
+	return presentations
+}
+
+// toProtocolColor converts a parsed color to its LSP representation
+func toProtocolColor(color scss.Color) protocol.Color {
+	return protocol.Color{Red: color.Red, Green: color.Green, Blue: color.Blue, Alpha: color.Alpha}
+}
@@ -0,0 +1,47 @@
+package documentcolor
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestScssColorProvider(t *testing.T) {
+	provider := NewScssColorProvider()
+
+	code := ".btn {\n  color: #fff;\n  background: rgba(0, 0, 0, .5);\n}\n"
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())))
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	params := &protocol.DocumentColorParams{DocumentContent: []byte(code), RootNode: tree.RootNode()}
+	params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+
+	colors := provider.GetDocumentColors(t.Context(), params)
+	require.Len(t, colors, 2)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 1, Character: 9}, End: protocol.Position{Line: 1, Character: 13}}, colors[0].Range)
+	assert.Equal(t, protocol.Color{Red: 1, Green: 1, Blue: 1, Alpha: 1}, colors[0].Color)
+	assert.Equal(t, 2, colors[1].Range.Start.Line)
+	assert.InDelta(t, 0.5, colors[1].Color.Alpha, 0.001)
+
+	presentationParams := &protocol.ColorPresentationParams{
+		Color: protocol.Color{Red: 1, Alpha: 0.5},
+		Range: colors[0].Range,
+	}
+	presentationParams.TextDocument.URI = params.TextDocument.URI
+
+	presentations := provider.GetColorPresentations(t.Context(), presentationParams)
+	require.Len(t, presentations, 2)
+	assert.Equal(t, "#ff000080", presentations[0].Label)
+	assert.Equal(t, "rgba(255, 0, 0, 0.5)", presentations[1].TextEdit.NewText)
+	assert.Equal(t, colors[0].Range, presentations[1].TextEdit.Range)
+
+	params.TextDocument.URI = "file:///project/base.css"
+	assert.Empty(t, provider.GetDocumentColors(t.Context(), params))
+}
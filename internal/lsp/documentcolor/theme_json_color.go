@@ -0,0 +1,70 @@
+package documentcolor
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/shopware/shopware-lsp/internal/theme"
+)
+
+// ThemeJSONColorProvider provides the values of color fields in theme.json files
+type ThemeJSONColorProvider struct{}
+
+// NewThemeJSONColorProvider creates a new theme.json color provider
+func NewThemeJSONColorProvider() *ThemeJSONColorProvider {
+	return &ThemeJSONColorProvider{}
+}
+
+// GetDocumentColors returns the literal values of config fields with type color. Values referencing
+// other SCSS variables have no color of their own.
+func (p *ThemeJSONColorProvider) GetDocumentColors(ctx context.Context, params *protocol.DocumentColorParams) []protocol.ColorInformation {
+	if filepath.Base(params.TextDocument.URI) != "theme.json" || params.RootNode == nil {
+		return []protocol.ColorInformation{}
+	}
+
+	root := params.RootNode
+	if root.Kind() == "document" && root.NamedChildCount() > 0 {
+		root = root.NamedChild(0)
+	}
+
+	fields := theme.ObjectMember(theme.ObjectMember(root, "config", params.DocumentContent), "fields", params.DocumentContent)
+
+	colors := []protocol.ColorInformation{}
+	for _, field := range theme.ObjectPairs(fields) {
+		if theme.StringContent(theme.ObjectMember(field[1], "type", params.DocumentContent), params.DocumentContent) != "color" {
+			continue
+		}
+
+		value := theme.ObjectMember(field[1], "value", params.DocumentContent)
+		if value == nil || value.Kind() != "string" {
+			continue
+		}
+
+		color, ok := scss.ParseColor(theme.StringContent(value, params.DocumentContent))
+		if !ok {
+			continue
+		}
+
+		// The color is the content of the string without the quotes
+		colors = append(colors, protocol.ColorInformation{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: int(value.StartPosition().Row), Character: int(value.StartPosition().Column) + 1},
+				End:   protocol.Position{Line: int(value.EndPosition().Row), Character: int(value.EndPosition().Column) - 1},
+			},
+			Color: toProtocolColor(color),
+		})
+	}
+
+	return colors
+}
+
+// GetColorPresentations returns the picked color as hex and rgb() value
+func (p *ThemeJSONColorProvider) GetColorPresentations(ctx context.Context, params *protocol.ColorPresentationParams) []protocol.ColorPresentation {
+	if filepath.Base(params.TextDocument.URI) != "theme.json" {
+		return []protocol.ColorPresentation{}
+	}
+
+	return colorPresentations(params.Color, params.Range)
+}
@@ -0,0 +1,48 @@
+package documentcolor
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+func TestThemeJSONColorProvider(t *testing.T) {
+	code := `{
+  "config": {
+    "fields": {
+      "sw-color-brand-primary": {"type": "color", "value": "#008490"},
+      "sw-color-buy-button": {"type": "color", "value": "$sw-color-brand-primary"},
+      "sw-font-family-base": {"type": "fontFamily", "value": "#fff"},
+      "sw-logo-desktop": {"value": "#fff"}
+    }
+  }
+}`
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language())))
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	provider := NewThemeJSONColorProvider()
+
+	params := &protocol.DocumentColorParams{DocumentContent: []byte(code), RootNode: tree.RootNode()}
+	params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/theme.json"
+
+	colors := provider.GetDocumentColors(t.Context(), params)
+	require.Len(t, colors, 1)
+	assert.Equal(t, protocol.Range{Start: protocol.Position{Line: 3, Character: 60}, End: protocol.Position{Line: 3, Character: 67}}, colors[0].Range)
+	assert.InDelta(t, 132.0/255, colors[0].Color.Green, 0.001)
+
+	presentationParams := &protocol.ColorPresentationParams{Color: protocol.Color{Blue: 1, Alpha: 1}}
+	presentationParams.TextDocument.URI = params.TextDocument.URI
+	presentations := provider.GetColorPresentations(t.Context(), presentationParams)
+	require.NotEmpty(t, presentations)
+	assert.Equal(t, "#0000ff", presentations[0].Label)
+
+	presentationParams.TextDocument.URI = "file:///project/composer.json"
+	assert.Empty(t, provider.GetColorPresentations(t.Context(), presentationParams))
+}
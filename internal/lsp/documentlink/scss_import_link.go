@@ -0,0 +1,64 @@
+package documentlink
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// ScssImportLinkProvider links the import paths of SCSS files to the imported stylesheets
+type ScssImportLinkProvider struct {
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewScssImportLinkProvider creates a new SCSS import link provider
+func NewScssImportLinkProvider(projectRoot string, lspServer *lsp.Server) *ScssImportLinkProvider {
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	return &ScssImportLinkProvider{
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetDocumentLinks returns a link for every @import, @use and @forward path which resolves to a file
+func (p *ScssImportLinkProvider) GetDocumentLinks(ctx context.Context, params *protocol.DocumentLinkParams) []protocol.DocumentLink {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return []protocol.DocumentLink{}
+	}
+
+	imports := scss.ParseImports(params.RootNode, params.DocumentContent)
+	if len(imports) == 0 {
+		return []protocol.DocumentLink{}
+	}
+
+	extensions, _ := p.extensionIndexer.GetAll()
+	resolver := scss.NewResolver(p.projectRoot, extensions)
+	filePath := strings.TrimPrefix(params.TextDocument.URI, "file://")
+
+	links := []protocol.DocumentLink{}
+	for _, imp := range imports {
+		resolved, _ := resolver.Resolve(imp.Path, filePath)
+		if resolved == "" {
+			continue
+		}
+
+		links = append(links, protocol.DocumentLink{
+			Range: protocol.Range{
+				Start: treesitterhelper.PositionAt(params.DocumentContent, imp.Offset),
+				End:   treesitterhelper.PositionAt(params.DocumentContent, imp.Offset+len(imp.Path)),
+			},
+			Target:  fmt.Sprintf("file://%s", resolved),
+			Tooltip: resolved,
+		})
+	}
+
+	return links
+}
@@ -0,0 +1,43 @@
+package documentlink
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestScssImportLinkProvider(t *testing.T) {
+	root := t.TempDir()
+	variables := filepath.Join(root, "vendor/shopware/storefront/Resources/app/storefront/src/scss/_variables.scss")
+	require.NoError(t, os.MkdirAll(filepath.Dir(variables), 0o755))
+	require.NoError(t, os.WriteFile(variables, []byte(""), 0o644))
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	provider := &ScssImportLinkProvider{extensionIndexer: extensionIndexer, projectRoot: root}
+
+	code := "@import 'missing';\n@use \"~scss/variables\";\n"
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())))
+	tree := parser.Parse([]byte(code), nil)
+	defer tree.Close()
+
+	params := &protocol.DocumentLinkParams{DocumentContent: []byte(code), RootNode: tree.RootNode()}
+	params.TextDocument.URI = "file://" + filepath.Join(root, "custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss")
+
+	links := provider.GetDocumentLinks(t.Context(), params)
+	require.Len(t, links, 1)
+	assert.Equal(t, "file://"+variables, links[0].Target)
+	assert.Equal(t, protocol.Position{Line: 1, Character: 6}, links[0].Range.Start)
+	assert.Equal(t, protocol.Position{Line: 1, Character: 21}, links[0].Range.End)
+}
@@ -38,7 +38,7 @@ func (p *AdminHoverProvider) GetHover(ctx context.Context, params *protocol.Hove
 	ext := strings.ToLower(filepath.Ext(params.TextDocument.URI))
 
 	// Handle JS/TS files
-	if ext == ".js" || ext == ".ts" {
+	if admin.IsScriptFile(ext) {
 		return p.jsHover(ctx, params)
 	}
 
@@ -104,6 +104,11 @@ func (p *AdminHoverProvider) jsHover(_ context.Context, params *protocol.HoverPa
 		return nil, nil
 	}
 
+	// Check if we're on a mixin name in Mixin.getByName('...') or a mixins array
+	if reference := admin.MixinReferenceAt(node, content); reference != nil {
+		return p.mixinHover(reference)
+	}
+
 	// Check if this string is in a Component.extend or Component.register call
 	if !p.isInComponentCall(node, content) {
 		return nil, nil
@@ -142,6 +147,82 @@ func (p *AdminHoverProvider) jsHover(_ context.Context, params *protocol.HoverPa
 	}, nil
 }
 
+// mixinHover shows the members a mixin adds to a component
+func (p *AdminHoverProvider) mixinHover(reference *admin.MixinReference) (*protocol.Hover, error) {
+	mixins, err := p.adminIndexer.GetMixin(reference.Name)
+	if err != nil || len(mixins) == 0 {
+		return nil, nil
+	}
+
+	members, err := p.adminIndexer.GetMixinMembers(reference.Name)
+	if err != nil {
+		return nil, nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## `%s` *(mixin)*\n\n", reference.Name)
+
+	sections := []struct {
+		title string
+		kind  admin.ComponentMemberKind
+	}{
+		{"Props", admin.MemberProp},
+		{"Data", admin.MemberData},
+		{"Computed", admin.MemberComputed},
+		{"Methods", admin.MemberMethod},
+		{"Inject", admin.MemberInject},
+	}
+
+	for _, section := range sections {
+		var lines []string
+		for _, member := range members {
+			if member.Kind != section.kind {
+				continue
+			}
+
+			line := fmt.Sprintf("- `%s`", member.Name)
+			if member.Kind == admin.MemberMethod {
+				line = fmt.Sprintf("- `%s()`", member.Name)
+			}
+			if member.Origin != reference.Name+" (mixin)" {
+				line += fmt.Sprintf(" *(from %s)*", member.Origin)
+			}
+			lines = append(lines, line)
+		}
+
+		if len(lines) > 0 {
+			fmt.Fprintf(&sb, "### %s\n\n%s\n\n", section.title, strings.Join(lines, "\n"))
+		}
+	}
+
+	if emits := mixins[0].Definition.Emits; len(emits) > 0 {
+		sb.WriteString("### Events\n\n")
+		for _, emit := range emits {
+			fmt.Fprintf(&sb, "- `%s`\n", emit)
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "*Registered in*: `%s`\n", p.makeRelativePath(mixins[0].FilePath))
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: sb.String(),
+		},
+		Range: &protocol.Range{
+			Start: protocol.Position{
+				Line:      int(reference.Node.StartPosition().Row),
+				Character: int(reference.Node.StartPosition().Column),
+			},
+			End: protocol.Position{
+				Line:      int(reference.Node.EndPosition().Row),
+				Character: int(reference.Node.EndPosition().Column),
+			},
+		},
+	}, nil
+}
+
 // buildHoverContent creates the markdown content for the hover popup
 func (p *AdminHoverProvider) buildHoverContent(components []admin.VueComponent) string {
 	var sb strings.Builder
@@ -159,13 +240,18 @@ func (p *AdminHoverProvider) buildHoverContent(components []admin.VueComponent)
 			fmt.Fprintf(&sb, "**Extends**: `%s`\n\n", comp.ExtendsComponent)
 		}
 
+		// Show the used mixins, their props and events are listed with the component's own
+		if len(comp.Mixins) > 0 {
+			fmt.Fprintf(&sb, "**Mixins**: `%s`\n\n", strings.Join(comp.Mixins, "`, `"))
+		}
+
 		// Props section
 		if len(comp.Props) > 0 {
 			sb.WriteString("### Props\n\n")
 			for _, prop := range comp.Props {
 				propLine := fmt.Sprintf("- `%s`", prop.Name)
-				if prop.Type != "" {
-					propLine += fmt.Sprintf(": **%s**", prop.Type)
+				if prop.DisplayType() != "" {
+					propLine += fmt.Sprintf(": **%s**", prop.DisplayType())
 				}
 				if prop.Required {
 					propLine += " *(required)*"
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
 )
@@ -309,3 +311,51 @@ func TestBuildHoverContent(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminHoverMixin(t *testing.T) {
+	indexer, err := admin.NewAdminComponentIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	require.NoError(t, indexer.SaveMixin(admin.VueMixin{
+		Name:     "notification",
+		FilePath: "/project/src/app/mixin/notification.mixin.js",
+		Line:     3,
+		Definition: admin.ComponentDefinition{
+			Methods: []string{"createNotificationSuccess"},
+			Data:    []string{"notificationId"},
+			Mixins:  []string{"base"},
+		},
+	}))
+	require.NoError(t, indexer.SaveMixin(admin.VueMixin{
+		Name:     "base",
+		FilePath: "/project/src/app/mixin/base.mixin.js",
+		Line:     3,
+		Definition: admin.ComponentDefinition{
+			Computed: []string{"isLoading"},
+		},
+	}))
+
+	provider := &AdminHoverProvider{adminIndexer: indexer, projectRoot: "/project"}
+
+	code := "Component.register('sw-foo', { mixins: [Mixin.getByName('notification')] });"
+	tree, parser := parseJS(t, code)
+	defer tree.Close()
+	defer parser.Close()
+
+	params := &protocol.HoverParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/src/Resources/app/administration/src/component/sw-foo/index.js"
+	params.Position.Character = 60
+	params.Node = findNodeAtPosition(tree.RootNode(), 0, 60)
+
+	hover, err := provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	require.NotNil(t, hover)
+
+	assert.Contains(t, hover.Contents.Value, "## `notification` *(mixin)*")
+	assert.Contains(t, hover.Contents.Value, "### Methods\n\n- `createNotificationSuccess()`")
+	assert.Contains(t, hover.Contents.Value, "### Data\n\n- `notificationId`")
+	assert.Contains(t, hover.Contents.Value, "- `isLoading` *(from base (mixin))*")
+	assert.Contains(t, hover.Contents.Value, "*Registered in*: `src/app/mixin/notification.mixin.js`")
+	assert.Equal(t, 56, hover.Range.Start.Character)
+}
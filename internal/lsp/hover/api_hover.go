@@ -0,0 +1,119 @@
+package hover
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/api"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/symfony"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	"github.com/tidwall/pretty"
+)
+
+type ApiHoverProvider struct {
+	schemaIndex *api.ApiSchemaIndexer
+	routeIndex  *symfony.RouteIndexer
+	projectRoot string
+}
+
+func NewApiHoverProvider(projectRoot string, lspServer *lsp.Server) *ApiHoverProvider {
+	schemaIndex, _ := lspServer.GetIndexer("api.schema")
+	routeIndex, _ := lspServer.GetIndexer("symfony.route")
+
+	return &ApiHoverProvider{
+		schemaIndex: schemaIndex.(*api.ApiSchemaIndexer),
+		routeIndex:  routeIndex.(*symfony.RouteIndexer),
+		projectRoot: projectRoot,
+	}
+}
+
+func (p *ApiHoverProvider) GetHover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	if params.Node == nil || strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".php" {
+		return nil, nil
+	}
+
+	attributeNode := treesitterhelper.GetPHPRouteAttribute(params.Node, params.DocumentContent)
+	if attributeNode == nil {
+		return nil, nil
+	}
+
+	routes, err := p.routeIndex.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	line := int(attributeNode.StartPosition().Row) + 1
+
+	for _, route := range routes.GetByFilePath(strings.TrimPrefix(params.TextDocument.URI, "file://")) {
+		if route.Line != line {
+			continue
+		}
+
+		scope := api.ScopeOfRoute(route)
+		if scope == "" {
+			return nil, nil
+		}
+
+		return &protocol.Hover{
+			Contents: protocol.MarkupContent{
+				Kind:  protocol.Markdown,
+				Value: p.formatRoute(route, scope, p.schemaIndex.GetOperationsForRoute(route)),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (p *ApiHoverProvider) formatRoute(route symfony.Route, scope string, operations []api.ApiOperation) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("**%s** `%s`\n\n", route.Name, route.Path))
+	sb.WriteString(fmt.Sprintf("**Scope**: `%s`\n\n", scope))
+
+	if len(operations) == 0 {
+		sb.WriteString("*No OpenAPI schema found for this route*\n")
+		return sb.String()
+	}
+
+	for _, operation := range operations {
+		sb.WriteString("---\n\n")
+		sb.WriteString(fmt.Sprintf("**%s** `%s`", operation.Method, operation.Path))
+		if operation.OperationID != "" {
+			sb.WriteString(fmt.Sprintf(" (`%s`)", operation.OperationID))
+		}
+		sb.WriteString("\n\n")
+
+		if operation.Summary != "" {
+			sb.WriteString(operation.Summary + "\n\n")
+		}
+
+		if operation.Description != "" && operation.Description != operation.Summary {
+			sb.WriteString(operation.Description + "\n\n")
+		}
+
+		if operation.RequestSchema != "" {
+			sb.WriteString("**Request**:\n\n```json\n")
+			sb.Write(pretty.Pretty([]byte(operation.RequestSchema)))
+			sb.WriteString("```\n\n")
+		}
+
+		if operation.ResponseSchema != "" {
+			sb.WriteString("**Response**:\n\n```json\n")
+			sb.Write(pretty.Pretty([]byte(operation.ResponseSchema)))
+			sb.WriteString("```\n\n")
+		}
+
+		displayPath, err := filepath.Rel(p.projectRoot, operation.FilePath)
+		if err != nil {
+			displayPath = operation.FilePath
+		}
+		sb.WriteString(fmt.Sprintf("**Schema**: `%s:%d`\n\n", displayPath, operation.Line))
+	}
+
+	return sb.String()
+}
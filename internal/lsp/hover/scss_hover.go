@@ -0,0 +1,165 @@
+package hover
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/shopware/shopware-lsp/internal/theme"
+)
+
+// maxThemeValueDepth limits how many variable references are followed to resolve a theme value
+const maxThemeValueDepth = 5
+
+// ScssHoverProvider provides hover information for SCSS variables, mixins, functions and placeholders
+type ScssHoverProvider struct {
+	scssIndexer      *scss.ScssIndexer
+	themeIndexer     *theme.ThemeConfigIndexer
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewScssHoverProvider creates a new SCSS hover provider
+func NewScssHoverProvider(projectRoot string, lspServer *lsp.Server) *ScssHoverProvider {
+	scssIndexer, _ := lspServer.GetIndexer("scss.indexer")
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	return &ScssHoverProvider{
+		scssIndexer:      scssIndexer.(*scss.ScssIndexer),
+		themeIndexer:     themeIndexer.(*theme.ThemeConfigIndexer),
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetHover returns the declarations of the symbol at the cursor with their values and !default flag. Variables
+// exposed by theme config fields are listed with a swatch of their resolved color and their effective value in
+// every theme.
+func (p *ScssHoverProvider) GetHover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".scss" {
+		return nil, nil
+	}
+
+	if params.Node == nil {
+		return nil, nil
+	}
+
+	reference := scss.ReferenceAt(params.Node, params.DocumentContent)
+	if reference == nil {
+		return nil, nil
+	}
+
+	symbols, _ := p.scssIndexer.GetSymbols(reference.Kind, reference.Name)
+
+	var fields []theme.ThemeConfigField
+	if reference.Kind == scss.SymbolVariable {
+		fields = p.themeFields(reference.Name)
+	}
+
+	if len(symbols) == 0 && len(fields) == 0 {
+		return nil, nil
+	}
+
+	var markdownContent strings.Builder
+	fmt.Fprintf(&markdownContent, "**SCSS %s**: `%s%s`\n\n", reference.Kind, scss.Sigil(reference.Kind), reference.Name)
+
+	for _, field := range fields {
+		if color, ok := p.resolveThemeColor(field.Value, 0); ok {
+			fmt.Fprintf(&markdownContent, "%s `%s`\n\n", colorSwatch(color), color.Hex())
+		}
+		fmt.Fprintf(&markdownContent, "```scss\n$%s: %s; // theme config (%s)\n```\n", field.Key, field.Value, field.Type)
+	}
+
+	if len(fields) > 0 {
+		definitions, _ := p.themeIndexer.GetThemeDefinitions()
+		extensions, _ := p.extensionIndexer.GetAll()
+		writeEffectiveThemeValues(&markdownContent, theme.NewGraph(definitions, extensions), fields, p.projectRoot)
+	}
+
+	for _, symbol := range symbols {
+		displayPath, err := filepath.Rel(p.projectRoot, symbol.FilePath)
+		if err != nil {
+			displayPath = symbol.FilePath
+		}
+
+		switch symbol.Kind {
+		case scss.SymbolVariable:
+			flag := ""
+			if symbol.Default {
+				flag = " !default"
+			}
+			fmt.Fprintf(&markdownContent, "```scss\n%s: %s%s;\n```\n", symbol.Label(), symbol.Value, flag)
+		case scss.SymbolMixin, scss.SymbolFunction:
+			fmt.Fprintf(&markdownContent, "```scss\n@%s %s%s\n```\n", symbol.Kind, symbol.Name, symbol.Value)
+		}
+
+		fmt.Fprintf(&markdownContent, "<small>%s:%d</small>\n\n", displayPath, symbol.Line)
+	}
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: markdownContent.String(),
+		},
+		Range: &protocol.Range{
+			Start: protocol.Position{Line: int(reference.Start.Row), Character: int(reference.Start.Column)},
+			End:   protocol.Position{Line: int(reference.End.Row), Character: int(reference.End.Column)},
+		},
+	}, nil
+}
+
+// themeFields returns the theme config fields exposed as SCSS variable with the given name
+func (p *ScssHoverProvider) themeFields(name string) []theme.ThemeConfigField {
+	fields, err := p.themeIndexer.GetThemeConfigField(name)
+	if err != nil {
+		return nil
+	}
+
+	var result []theme.ThemeConfigField
+	for _, field := range fields {
+		if field.Scss {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
+
+// resolveThemeColor parses a theme value as color, following references to other theme fields and SCSS variables
+func (p *ScssHoverProvider) resolveThemeColor(value string, depth int) (scss.Color, bool) {
+	if color, ok := scss.ParseColor(value); ok {
+		return color, true
+	}
+
+	name, ok := strings.CutPrefix(strings.TrimSpace(value), "$")
+	if !ok || depth >= maxThemeValueDepth {
+		return scss.Color{}, false
+	}
+
+	for _, field := range p.themeFields(name) {
+		if color, ok := p.resolveThemeColor(field.Value, depth+1); ok {
+			return color, true
+		}
+	}
+
+	symbols, _ := p.scssIndexer.GetSymbols(scss.SymbolVariable, name)
+	for _, symbol := range symbols {
+		if color, ok := p.resolveThemeColor(symbol.Value, depth+1); ok {
+			return color, true
+		}
+	}
+
+	return scss.Color{}, false
+}
+
+// colorSwatch returns a markdown image of a square filled with the color
+func colorSwatch(color scss.Color) string {
+	svg := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="16" height="16"><rect width="16" height="16" fill="%s" stroke="#888"/></svg>`, color.RGB())
+	return fmt.Sprintf("![%s](data:image/svg+xml;base64,%s)", color.Hex(), base64.StdEncoding.EncodeToString([]byte(svg)))
+}
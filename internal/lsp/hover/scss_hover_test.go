@@ -0,0 +1,119 @@
+package hover
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/scss"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseScss(t *testing.T, code string) (*tree_sitter.Tree, *tree_sitter.Parser) {
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())); err != nil {
+		t.Fatal(err)
+	}
+	tree := parser.Parse([]byte(code), nil)
+	return tree, parser
+}
+
+func indexScss(t *testing.T, indexer *scss.ScssIndexer, path, code string) {
+	tree, parser := parseScss(t, code)
+	defer parser.Close()
+	defer tree.Close()
+	require.NoError(t, indexer.Index(path, tree.RootNode(), []byte(code)))
+}
+
+func TestScssHoverProvider(t *testing.T) {
+	indexer, err := scss.NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	indexScss(t, indexer, "/project/vendor/shopware/storefront/Resources/app/storefront/src/scss/abstract/_variables.scss", "$primary: #0042a0 !default;\n")
+	indexScss(t, indexer, "/project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/overrides.scss", "$primary: red;\n")
+
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	provider := &ScssHoverProvider{scssIndexer: indexer, themeIndexer: themeIndexer, extensionIndexer: extensionIndexer, projectRoot: "/project"}
+
+	code := ".btn {\n  color: $primary;\n}\n"
+	tree, parser := parseScss(t, code)
+	defer parser.Close()
+	defer tree.Close()
+
+	params := &protocol.HoverParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+	params.Position.Line = 1
+	params.Position.Character = 12
+	params.Node = findNodeAtPosition(tree.RootNode(), 1, 12)
+
+	result, err := provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Contains(t, result.Contents.Value, "$primary: #0042a0 !default;")
+	assert.Contains(t, result.Contents.Value, "$primary: red;")
+	assert.Contains(t, result.Contents.Value, "vendor/shopware/storefront/Resources/app/storefront/src/scss/abstract/_variables.scss:1")
+	assert.Equal(t, 9, result.Range.Start.Character)
+	assert.Equal(t, 17, result.Range.End.Character)
+
+	params.Position.Character = 3
+	params.Node = findNodeAtPosition(tree.RootNode(), 1, 3)
+	result, err = provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestScssHoverProvider_ThemeVariable(t *testing.T) {
+	indexer, err := scss.NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+
+	themeJSON := `{"config": {"fields": {
+  "sw-color-brand-primary": {"type": "color", "value": "#0042a0"},
+  "sw-color-buy-button": {"type": "color", "value": "$sw-color-brand-primary"}
+}}}`
+	require.NoError(t, themeIndexer.Index("/project/vendor/shopware/storefront/Resources/theme.json", nil, []byte(themeJSON)))
+	require.NoError(t, themeIndexer.Index("/project/custom/plugins/MyTheme/src/Resources/theme.json", nil, []byte(`{"config": {"fields": {"sw-color-buy-button": {"type": "color", "value": "#ff0000"}}}}`)))
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	provider := &ScssHoverProvider{scssIndexer: indexer, themeIndexer: themeIndexer, extensionIndexer: extensionIndexer, projectRoot: "/project"}
+
+	code := ".btn-buy {\n  background: $sw-color-buy-button;\n}\n"
+	tree, parser := parseScss(t, code)
+	defer parser.Close()
+	defer tree.Close()
+
+	params := &protocol.HoverParams{DocumentContent: []byte(code)}
+	params.TextDocument.URI = "file:///project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/base.scss"
+	params.Position.Line = 1
+	params.Position.Character = 18
+	params.Node = findNodeAtPosition(tree.RootNode(), 1, 18)
+
+	result, err := provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Contains(t, result.Contents.Value, "**SCSS variable**: `$sw-color-buy-button`")
+	assert.Contains(t, result.Contents.Value, "![#0042a0](data:image/svg+xml;base64,")
+	assert.Contains(t, result.Contents.Value, "$sw-color-buy-button: $sw-color-brand-primary; // theme config (color)")
+	assert.Contains(t, result.Contents.Value, "vendor/shopware/storefront/Resources/theme.json:3")
+	assert.Contains(t, result.Contents.Value, "| Storefront | `$sw-color-brand-primary` | Storefront |")
+	assert.Contains(t, result.Contents.Value, "| MyTheme | `#ff0000` | MyTheme (overrides Storefront) |")
+}
@@ -37,7 +37,7 @@ func (p *SnippetHoverProvider) GetHover(ctx context.Context, params *protocol.Ho
 		return p.twigHover(ctx, params)
 	case ".php":
 		return p.phpHover(ctx, params)
-	case ".js", ".ts":
+	case ".js", ".ts", ".tsx", ".mts":
 		return p.jsHover(ctx, params)
 	default:
 		return nil, nil
@@ -0,0 +1,158 @@
+package hover
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// SystemConfigHoverProvider provides hover information for system config keys
+type SystemConfigHoverProvider struct {
+	indexer     *systemconfig.SystemConfigIndexer
+	phpIndex    *php.PHPIndex
+	projectRoot string
+}
+
+// NewSystemConfigHoverProvider creates a new system config hover provider
+func NewSystemConfigHoverProvider(projectRoot string, lspServer *lsp.Server) *SystemConfigHoverProvider {
+	indexer, _ := lspServer.GetIndexer("systemconfig.indexer")
+	phpIndex, _ := lspServer.GetIndexer("php.index")
+	return &SystemConfigHoverProvider{
+		indexer:     indexer.(*systemconfig.SystemConfigIndexer),
+		phpIndex:    phpIndex.(*php.PHPIndex),
+		projectRoot: projectRoot,
+	}
+}
+
+// GetHover returns the labels, type, default value, help text and options of the system config key in
+// SystemConfigService calls and the Twig config() function
+func (p *SystemConfigHoverProvider) GetHover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	if params.Node == nil {
+		return nil, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(params.TextDocument.URI)) {
+	case ".twig":
+		if !treesitterhelper.TwigStringInFunctionPattern("config").Matches(params.Node, params.DocumentContent) {
+			return nil, nil
+		}
+	case ".php":
+		if !p.isConfigKeyArgument(ctx, params) {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	key := strings.Trim(treesitterhelper.GetNodeText(params.Node, params.DocumentContent), "'\"")
+
+	entries, err := p.indexer.GetSystemConfigEntry(key)
+	if err != nil || len(entries) == 0 {
+		return nil, nil
+	}
+
+	var markdownContent strings.Builder
+	fmt.Fprintf(&markdownContent, "**System config**: `%s`\n\n", key)
+
+	for _, entry := range entries {
+		switch {
+		case entry.Component != "":
+			fmt.Fprintf(&markdownContent, "**Component**: `%s`\n\n", entry.Component)
+		case entry.Type != "":
+			fmt.Fprintf(&markdownContent, "**Type**: `%s`\n\n", entry.Type)
+		}
+
+		if entry.DefaultValue != "" {
+			fmt.Fprintf(&markdownContent, "**Default**: `%s`\n\n", entry.DefaultValue)
+		}
+
+		if len(entry.Labels) > 0 {
+			markdownContent.WriteString("**Label**:\n")
+			for _, locale := range sortedLocales(entry.Labels) {
+				fmt.Fprintf(&markdownContent, "- **%s**: %s\n", locale, entry.Labels[locale])
+			}
+			markdownContent.WriteString("\n")
+		}
+
+		if helpText := localizedText(entry.HelpTexts); helpText != "" {
+			fmt.Fprintf(&markdownContent, "**Help**: %s\n\n", helpText)
+		}
+
+		if len(entry.Options) > 0 {
+			markdownContent.WriteString("**Options**:\n")
+			for _, option := range entry.Options {
+				if name := localizedText(option.Names); name != "" {
+					fmt.Fprintf(&markdownContent, "- `%s`: %s\n", option.ID, name)
+				} else {
+					fmt.Fprintf(&markdownContent, "- `%s`\n", option.ID)
+				}
+			}
+			markdownContent.WriteString("\n")
+		}
+
+		displayPath, err := filepath.Rel(p.projectRoot, entry.FilePath)
+		if err != nil {
+			displayPath = entry.FilePath
+		}
+		fmt.Fprintf(&markdownContent, "<small>%s:%d</small>\n\n", displayPath, entry.Line)
+	}
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: markdownContent.String(),
+		},
+		Range: &protocol.Range{
+			Start: protocol.Position{Line: int(params.Node.StartPosition().Row), Character: int(params.Node.StartPosition().Column)},
+			End:   protocol.Position{Line: int(params.Node.EndPosition().Row), Character: int(params.Node.EndPosition().Column)},
+		},
+	}, nil
+}
+
+// isConfigKeyArgument checks if the node is the key argument of a SystemConfigService getter or set()
+func (p *SystemConfigHoverProvider) isConfigKeyArgument(ctx context.Context, params *protocol.HoverParams) bool {
+	if _, index := php.MethodCallArgument(params.Node); index != 0 {
+		return false
+	}
+
+	return p.phpIndex.IsMethodCalledOnClass(ctx, params.Node, params.DocumentContent, "Shopware\\Core\\System\\SystemConfig\\SystemConfigService") &&
+		p.phpIndex.IsMethodCalledName(ctx, params.Node, params.DocumentContent, "get", "getInt", "getString", "getFloat", "getBool", "set")
+}
+
+// sortedLocales returns the locales of localized texts, en-GB first
+func sortedLocales(texts map[string]string) []string {
+	locales := make([]string, 0, len(texts))
+	for locale := range texts {
+		locales = append(locales, locale)
+	}
+
+	sort.Slice(locales, func(i, j int) bool {
+		if (locales[i] == systemconfig.DefaultLocale) != (locales[j] == systemconfig.DefaultLocale) {
+			return locales[i] == systemconfig.DefaultLocale
+		}
+		return locales[i] < locales[j]
+	})
+
+	return locales
+}
+
+// localizedText returns the en-GB text, or the text of the first locale
+func localizedText(texts map[string]string) string {
+	if text, ok := texts[systemconfig.DefaultLocale]; ok {
+		return text
+	}
+
+	if locales := sortedLocales(texts); len(locales) > 0 {
+		return texts[locales[0]]
+	}
+
+	return ""
+}
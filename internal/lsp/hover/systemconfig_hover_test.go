@@ -0,0 +1,89 @@
+package hover
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/systemconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_xml "github.com/tree-sitter-grammars/tree-sitter-xml/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+const systemConfigXML = `<?xml version="1.0" encoding="UTF-8"?>
+<config xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:noNamespaceSchemaLocation="https://raw.githubusercontent.com/shopware/shopware/trunk/src/Core/System/SystemConfig/Schema/config.xsd">
+    <card>
+        <title>Settings</title>
+        <input-field type="single-select">
+            <name>mode</name>
+            <label>Mode</label>
+            <label lang="de-DE">Modus</label>
+            <helpText>Controls the mode</helpText>
+            <defaultValue>fast</defaultValue>
+            <options>
+                <option>
+                    <id>fast</id>
+                    <name>Fast</name>
+                </option>
+                <option>
+                    <id>safe</id>
+                    <name>Safe</name>
+                </option>
+            </options>
+        </input-field>
+    </card>
+</config>`
+
+func newSystemConfigIndexer(t *testing.T) *systemconfig.SystemConfigIndexer {
+	indexer, err := systemconfig.NewSystemConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = indexer.Close() })
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML())))
+
+	tree := parser.Parse([]byte(systemConfigXML), nil)
+	defer tree.Close()
+
+	require.NoError(t, indexer.Index(filepath.Join(t.TempDir(), "basicInformation.xml"), tree.RootNode(), []byte(systemConfigXML)))
+
+	return indexer
+}
+
+func TestSystemConfigHoverProvider(t *testing.T) {
+	provider := &SystemConfigHoverProvider{indexer: newSystemConfigIndexer(t), projectRoot: "/project"}
+
+	code := `{{ config('core.basicInformation.mode') }}`
+	tree, parser := parseTwig(t, code)
+	defer parser.Close()
+	defer tree.Close()
+
+	params := &protocol.HoverParams{DocumentContent: []byte(code), Node: findStringNode(tree.RootNode(), []byte(code))}
+	params.TextDocument.URI = "file:///project/templates/index.html.twig"
+	require.NotNil(t, params.Node)
+
+	result, err := provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Contains(t, result.Contents.Value, "**System config**: `core.basicInformation.mode`")
+	assert.Contains(t, result.Contents.Value, "**Type**: `single-select`")
+	assert.Contains(t, result.Contents.Value, "**Default**: `fast`")
+	assert.Contains(t, result.Contents.Value, "- **en-GB**: Mode\n- **de-DE**: Modus")
+	assert.Contains(t, result.Contents.Value, "**Help**: Controls the mode")
+	assert.Contains(t, result.Contents.Value, "- `fast`: Fast\n- `safe`: Safe")
+	assert.Contains(t, result.Contents.Value, "basicInformation.xml:5")
+
+	code = `{{ config('core.basicInformation.unknown') }}`
+	tree2, parser2 := parseTwig(t, code)
+	defer parser2.Close()
+	defer tree2.Close()
+
+	params = &protocol.HoverParams{DocumentContent: []byte(code), Node: findStringNode(tree2.RootNode(), []byte(code))}
+	params.TextDocument.URI = "file:///project/templates/index.html.twig"
+	result, err = provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
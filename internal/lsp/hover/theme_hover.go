@@ -0,0 +1,106 @@
+package hover
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+)
+
+// ThemeConfigHoverProvider provides hover information for theme_config() keys in Twig templates
+type ThemeConfigHoverProvider struct {
+	themeIndexer     *theme.ThemeConfigIndexer
+	extensionIndexer *extension.ExtensionIndexer
+	projectRoot      string
+}
+
+// NewThemeConfigHoverProvider creates a new theme config hover provider
+func NewThemeConfigHoverProvider(projectRoot string, lspServer *lsp.Server) *ThemeConfigHoverProvider {
+	themeIndexer, _ := lspServer.GetIndexer("theme.indexer")
+	extensionIndexer, _ := lspServer.GetIndexer("extension.indexer")
+	return &ThemeConfigHoverProvider{
+		themeIndexer:     themeIndexer.(*theme.ThemeConfigIndexer),
+		extensionIndexer: extensionIndexer.(*extension.ExtensionIndexer),
+		projectRoot:      projectRoot,
+	}
+}
+
+// GetHover returns the type and label of a config field and its effective value in every theme
+func (p *ThemeConfigHoverProvider) GetHover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	if params.Node == nil || strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".twig" {
+		return nil, nil
+	}
+
+	if !treesitterhelper.TwigStringInFunctionPattern("theme_config").Matches(params.Node, params.DocumentContent) {
+		return nil, nil
+	}
+
+	key := treesitterhelper.GetNodeText(params.Node, params.DocumentContent)
+
+	fields, err := p.themeIndexer.GetThemeConfigField(key)
+	if err != nil || len(fields) == 0 {
+		return nil, nil
+	}
+
+	var markdownContent strings.Builder
+	fmt.Fprintf(&markdownContent, "**Theme config**: `%s`\n\n", key)
+
+	field := fields[0]
+	if field.Type != "" {
+		fmt.Fprintf(&markdownContent, "**Type**: `%s`\n\n", field.Type)
+	}
+	if label := field.Label["en-GB"]; label != "" {
+		fmt.Fprintf(&markdownContent, "**Label**: %s\n\n", label)
+	}
+
+	writeEffectiveThemeValues(&markdownContent, p.graph(), fields, p.projectRoot)
+
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: markdownContent.String(),
+		},
+		Range: &protocol.Range{
+			Start: protocol.Position{Line: int(params.Node.StartPosition().Row), Character: int(params.Node.StartPosition().Column)},
+			End:   protocol.Position{Line: int(params.Node.EndPosition().Row), Character: int(params.Node.EndPosition().Column)},
+		},
+	}, nil
+}
+
+// graph returns the inheritance graph of the indexed themes
+func (p *ThemeConfigHoverProvider) graph() *theme.Graph {
+	definitions, _ := p.themeIndexer.GetThemeDefinitions()
+	extensions, _ := p.extensionIndexer.GetAll()
+	return theme.NewGraph(definitions, extensions)
+}
+
+// writeEffectiveThemeValues writes a table with the value every theme uses for a config field and the theme
+// it is inherited from or overridden by, followed by the declarations
+func writeEffectiveThemeValues(markdownContent *strings.Builder, graph *theme.Graph, fields []theme.ThemeConfigField, projectRoot string) {
+	values := graph.EffectiveValues(fields)
+	if len(values) > 0 {
+		markdownContent.WriteString("| Theme | Value | Source |\n|---|---|---|\n")
+		for _, value := range values {
+			source := value.Source
+			if value.Overrides != "" {
+				source += " (overrides " + value.Overrides + ")"
+			}
+			fmt.Fprintf(markdownContent, "| %s | `%s` | %s |\n", value.Theme, strings.ReplaceAll(value.Field.Value, "|", "\\|"), source)
+		}
+		markdownContent.WriteString("\n")
+	}
+
+	for _, field := range fields {
+		displayPath, err := filepath.Rel(projectRoot, field.Path)
+		if err != nil {
+			displayPath = field.Path
+		}
+		fmt.Fprintf(markdownContent, "<small>%s:%d</small>\n\n", displayPath, field.Line)
+	}
+}
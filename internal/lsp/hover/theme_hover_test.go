@@ -0,0 +1,55 @@
+package hover
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemeConfigHoverProvider(t *testing.T) {
+	themeIndexer, err := theme.NewThemeConfigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = themeIndexer.Close() }()
+
+	extensionIndexer, err := extension.NewExtensionIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = extensionIndexer.Close() }()
+
+	storefrontJSON := `{"config": {"fields": {"sw-logo-desktop": {"label": {"en-GB": "Desktop"}, "type": "media", "value": "app/storefront/dist/assets/logo/demostore-logo.png"}}}}`
+	childJSON := `{"configInheritance": ["@Storefront", "@BaseTheme"], "config": {"fields": {}}}`
+	baseJSON := `{"config": {"fields": {"sw-logo-desktop": {"type": "media", "value": "app/storefront/dist/assets/base-logo.png"}}}}`
+
+	require.NoError(t, themeIndexer.Index("/project/vendor/shopware/storefront/Resources/theme.json", nil, []byte(storefrontJSON)))
+	require.NoError(t, themeIndexer.Index("/project/custom/plugins/BaseTheme/src/Resources/theme.json", nil, []byte(baseJSON)))
+	require.NoError(t, themeIndexer.Index("/project/custom/plugins/ChildTheme/src/Resources/theme.json", nil, []byte(childJSON)))
+
+	provider := &ThemeConfigHoverProvider{themeIndexer: themeIndexer, extensionIndexer: extensionIndexer, projectRoot: "/project"}
+
+	code := `{{ theme_config('sw-logo-desktop') }}`
+	tree, parser := parseTwig(t, code)
+	defer parser.Close()
+	defer tree.Close()
+
+	params := &protocol.HoverParams{DocumentContent: []byte(code), Node: findStringNode(tree.RootNode(), []byte(code))}
+	params.TextDocument.URI = "file:///project/custom/plugins/ChildTheme/src/Resources/views/storefront/layout/header/logo.html.twig"
+	require.NotNil(t, params.Node)
+
+	result, err := provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Contains(t, result.Contents.Value, "**Theme config**: `sw-logo-desktop`")
+	assert.Contains(t, result.Contents.Value, "**Type**: `media`")
+	assert.Contains(t, result.Contents.Value, "| Storefront | `app/storefront/dist/assets/logo/demostore-logo.png` | Storefront |")
+	assert.Contains(t, result.Contents.Value, "| BaseTheme | `app/storefront/dist/assets/base-logo.png` | BaseTheme (overrides Storefront) |")
+	assert.Contains(t, result.Contents.Value, "| ChildTheme | `app/storefront/dist/assets/base-logo.png` | BaseTheme (overrides Storefront) |")
+	assert.Contains(t, result.Contents.Value, "custom/plugins/BaseTheme/src/Resources/theme.json:1")
+
+	params.TextDocument.URI = "file:///project/base.scss"
+	result, err = provider.GetHover(t.Context(), params)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,55 @@
+package protocol
+
+import tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+// DocumentColorParams represents the parameters for a document color request
+type DocumentColorParams struct {
+	// The document to provide colors for
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+
+	// Custom fields for internal use (not part of LSP spec)
+	// These fields are used to pass document content and syntax tree to color providers
+	DocumentContent []byte            `json:"-"`
+	RootNode        *tree_sitter.Node `json:"-"`
+}
+
+// Color represents a color in RGBA space with components between 0 and 1
+type Color struct {
+	Red   float64 `json:"red"`
+	Green float64 `json:"green"`
+	Blue  float64 `json:"blue"`
+	Alpha float64 `json:"alpha"`
+}
+
+// ColorInformation represents a color range in a document
+type ColorInformation struct {
+	// The range in the document where this color appears
+	Range Range `json:"range"`
+	// The actual color value for this color range
+	Color Color `json:"color"`
+}
+
+// ColorPresentationParams represents the parameters for a color presentation request
+type ColorPresentationParams struct {
+	// The document the color belongs to
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+	// The color to request presentations for
+	Color Color `json:"color"`
+	// The range where the color would be inserted
+	Range Range `json:"range"`
+
+	// Custom fields for internal use (not part of LSP spec)
+	DocumentContent []byte `json:"-"`
+}
+
+// ColorPresentation represents a way to write a color
+type ColorPresentation struct {
+	// The label of this color presentation, also inserted when no text edit is given
+	Label string `json:"label"`
+	// The edit applied to the document when selecting this presentation
+	TextEdit *TextEdit `json:"textEdit,omitempty"`
+}
@@ -0,0 +1,26 @@
+package protocol
+
+import tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+// DocumentLinkParams represents the parameters for a document link request
+type DocumentLinkParams struct {
+	// The document to provide document links for
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+
+	// Custom fields for internal use (not part of LSP spec)
+	// These fields are used to pass document content and syntax tree to document link providers
+	DocumentContent []byte            `json:"-"`
+	RootNode        *tree_sitter.Node `json:"-"`
+}
+
+// DocumentLink represents a range in a text document that links to another document
+type DocumentLink struct {
+	// The range this link applies to
+	Range Range `json:"range"`
+	// The uri this link points to
+	Target string `json:"target,omitempty"`
+	// The tooltip text when you hover over this link
+	Tooltip string `json:"tooltip,omitempty"`
+}
@@ -0,0 +1,38 @@
+package protocol
+
+import tree_sitter "github.com/tree-sitter/go-tree-sitter"
+
+// SignatureHelpParams represents the parameters for a signature help request
+type SignatureHelpParams struct {
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+	Position struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	} `json:"position"`
+
+	// Custom fields for internal use (not part of LSP spec)
+	// These fields are used to pass document content to signature help providers
+	DocumentContent []byte            `json:"-"`
+	Node            *tree_sitter.Node `json:"-"`
+}
+
+// SignatureHelp represents the signature of something callable
+type SignatureHelp struct {
+	Signatures      []SignatureInformation `json:"signatures"`
+	ActiveSignature int                    `json:"activeSignature"`
+	ActiveParameter int                    `json:"activeParameter"`
+}
+
+// SignatureInformation represents the signature of a callable with its parameters
+type SignatureInformation struct {
+	Label         string                 `json:"label"`
+	Documentation *MarkupContent         `json:"documentation,omitempty"`
+	Parameters    []ParameterInformation `json:"parameters,omitempty"`
+}
+
+// ParameterInformation represents a parameter of a callable signature
+type ParameterInformation struct {
+	Label string `json:"label"`
+}
@@ -19,42 +19,48 @@ import (
 
 // Server represents the LSP server
 type Server struct {
-	rootPath             string
-	conn                 *jsonrpc2.Conn
-	completionProviders  []CompletionProvider
-	definitionProviders  []GotoDefinitionProvider
-	referencesProviders  []ReferencesProvider
-	codeLensProviders    []CodeLensProvider
-	diagnosticsProviders []DiagnosticsProvider
-	codeActionProviders  []CodeActionProvider
-	hoverProviders       []HoverProvider
-	commandProviders     []CommandProvider
-	indexers             map[string]indexer.Indexer
-	commandMap           map[string]CommandFunc
-	indexerMu            sync.RWMutex
-	documentManager      *DocumentManager
-	fileScanner          *indexer.FileScanner
-	cacheDir             string
-	version              string
+	rootPath               string
+	conn                   *jsonrpc2.Conn
+	completionProviders    []CompletionProvider
+	definitionProviders    []GotoDefinitionProvider
+	referencesProviders    []ReferencesProvider
+	codeLensProviders      []CodeLensProvider
+	documentLinkProviders  []DocumentLinkProvider
+	documentColorProviders []DocumentColorProvider
+	diagnosticsProviders   []DiagnosticsProvider
+	codeActionProviders    []CodeActionProvider
+	hoverProviders         []HoverProvider
+	signatureHelpProviders []SignatureHelpProvider
+	commandProviders       []CommandProvider
+	indexers               map[string]indexer.Indexer
+	commandMap             map[string]CommandFunc
+	indexerMu              sync.RWMutex
+	documentManager        *DocumentManager
+	fileScanner            *indexer.FileScanner
+	cacheDir               string
+	version                string
 }
 
 // NewServer creates a new LSP server
 func NewServer(filescanner *indexer.FileScanner, cacheDir, version string) *Server {
 	s := &Server{
-		completionProviders:  make([]CompletionProvider, 0),
-		definitionProviders:  make([]GotoDefinitionProvider, 0),
-		referencesProviders:  make([]ReferencesProvider, 0),
-		codeLensProviders:    make([]CodeLensProvider, 0),
-		diagnosticsProviders: make([]DiagnosticsProvider, 0),
-		codeActionProviders:  make([]CodeActionProvider, 0),
-		hoverProviders:       make([]HoverProvider, 0),
-		commandProviders:     make([]CommandProvider, 0),
-		indexers:             make(map[string]indexer.Indexer),
-		commandMap:           make(map[string]CommandFunc),
-		documentManager:      NewDocumentManager(),
-		fileScanner:          filescanner,
-		cacheDir:             cacheDir,
-		version:              version,
+		completionProviders:    make([]CompletionProvider, 0),
+		definitionProviders:    make([]GotoDefinitionProvider, 0),
+		referencesProviders:    make([]ReferencesProvider, 0),
+		codeLensProviders:      make([]CodeLensProvider, 0),
+		documentLinkProviders:  make([]DocumentLinkProvider, 0),
+		documentColorProviders: make([]DocumentColorProvider, 0),
+		diagnosticsProviders:   make([]DiagnosticsProvider, 0),
+		codeActionProviders:    make([]CodeActionProvider, 0),
+		hoverProviders:         make([]HoverProvider, 0),
+		signatureHelpProviders: make([]SignatureHelpProvider, 0),
+		commandProviders:       make([]CommandProvider, 0),
+		indexers:               make(map[string]indexer.Indexer),
+		commandMap:             make(map[string]CommandFunc),
+		documentManager:        NewDocumentManager(),
+		fileScanner:            filescanner,
+		cacheDir:               cacheDir,
+		version:                version,
 	}
 
 	// Set the update callback to publish diagnostics
@@ -86,6 +92,16 @@ func (s *Server) RegisterCodeLensProvider(provider CodeLensProvider) {
 	s.codeLensProviders = append(s.codeLensProviders, provider)
 }
 
+// RegisterDocumentLinkProvider registers a document link provider with the server
+func (s *Server) RegisterDocumentLinkProvider(provider DocumentLinkProvider) {
+	s.documentLinkProviders = append(s.documentLinkProviders, provider)
+}
+
+// RegisterDocumentColorProvider registers a document color provider with the server
+func (s *Server) RegisterDocumentColorProvider(provider DocumentColorProvider) {
+	s.documentColorProviders = append(s.documentColorProviders, provider)
+}
+
 // RegisterCodeActionProvider registers a code action provider with the server
 func (s *Server) RegisterCodeActionProvider(provider CodeActionProvider) {
 	s.codeActionProviders = append(s.codeActionProviders, provider)
@@ -96,6 +112,11 @@ func (s *Server) RegisterHoverProvider(provider HoverProvider) {
 	s.hoverProviders = append(s.hoverProviders, provider)
 }
 
+// RegisterSignatureHelpProvider registers a signature help provider with the server
+func (s *Server) RegisterSignatureHelpProvider(provider SignatureHelpProvider) {
+	s.signatureHelpProviders = append(s.signatureHelpProviders, provider)
+}
+
 // RegisterCommandProvider registers a command provider with the server
 func (s *Server) RegisterCommandProvider(provider CommandProvider) {
 	s.commandProviders = append(s.commandProviders, provider)
@@ -370,6 +391,27 @@ func (s *Server) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.
 		}
 		return s.codeLens(ctx, &params), nil
 
+	case "textDocument/documentLink":
+		var params protocol.DocumentLinkParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.documentLink(ctx, &params), nil
+
+	case "textDocument/documentColor":
+		var params protocol.DocumentColorParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.documentColor(ctx, &params), nil
+
+	case "textDocument/colorPresentation":
+		var params protocol.ColorPresentationParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.colorPresentation(ctx, &params), nil
+
 	case "textDocument/hover":
 		var params protocol.HoverParams
 		if err := json.Unmarshal(*req.Params, &params); err != nil {
@@ -377,6 +419,13 @@ func (s *Server) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.
 		}
 		return s.hover(ctx, &params)
 
+	case "textDocument/signatureHelp":
+		var params protocol.SignatureHelpParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.signatureHelp(ctx, &params)
+
 	case "textDocument/diagnostic":
 		var params protocol.DiagnosticParams
 		if err := json.Unmarshal(*req.Params, &params); err != nil {
@@ -534,6 +583,7 @@ func (s *Server) initialize(ctx context.Context, params *protocol.InitializePara
 
 	// Collect all trigger characters from providers
 	triggerChars := s.collectTriggerCharacters()
+	signatureTriggerChars := s.collectSignatureHelpTriggerCharacters()
 
 	// Collect all code action kinds from providers
 	codeActionKinds := s.collectCodeActionKinds()
@@ -555,9 +605,16 @@ func (s *Server) initialize(ctx context.Context, params *protocol.InitializePara
 			"definitionProvider": true,
 			"referencesProvider": true,
 			"hoverProvider":      true,
+			"signatureHelpProvider": map[string]interface{}{
+				"triggerCharacters": signatureTriggerChars,
+			},
 			"codeLensProvider": map[string]interface{}{
 				"resolveProvider": true,
 			},
+			"documentLinkProvider": map[string]interface{}{
+				"resolveProvider": false,
+			},
+			"colorProvider": true,
 			"codeActionProvider": map[string]interface{}{
 				"codeActionKinds": codeActionKinds,
 			},
@@ -633,6 +690,24 @@ func (s *Server) collectTriggerCharacters() []string {
 	return triggerChars
 }
 
+// collectSignatureHelpTriggerCharacters collects all trigger characters from registered signature help providers
+func (s *Server) collectSignatureHelpTriggerCharacters() []string {
+	triggerCharsMap := make(map[string]bool)
+
+	for _, provider := range s.signatureHelpProviders {
+		for _, char := range provider.GetTriggerCharacters() {
+			triggerCharsMap[char] = true
+		}
+	}
+
+	triggerChars := make([]string, 0, len(triggerCharsMap))
+	for char := range triggerCharsMap {
+		triggerChars = append(triggerChars, char)
+	}
+
+	return triggerChars
+}
+
 // collectCodeActionKinds collects all code action kinds from registered providers
 func (s *Server) collectCodeActionKinds() []protocol.CodeActionKind {
 	// Use a map to deduplicate code action kinds
@@ -0,0 +1,29 @@
+package lsp
+
+import (
+	"context"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+)
+
+// signatureHelp handles textDocument/signatureHelp requests
+func (s *Server) signatureHelp(ctx context.Context, params *protocol.SignatureHelpParams) (*protocol.SignatureHelp, error) {
+	node, docText, ok := s.documentManager.GetNodeAtPosition(params.TextDocument.URI, params.Position.Line, params.Position.Character)
+	if ok {
+		params.Node = node
+		params.DocumentContent = docText.Text
+	}
+
+	// Try each signature help provider until one returns a result
+	for _, provider := range s.signatureHelpProviders {
+		signatureHelp, err := provider.GetSignatureHelp(ctx, params)
+		if err != nil {
+			continue
+		}
+		if signatureHelp != nil {
+			return signatureHelp, nil
+		}
+	}
+
+	return nil, nil
+}
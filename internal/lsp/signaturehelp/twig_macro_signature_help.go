@@ -0,0 +1,82 @@
+package signaturehelp
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/lsp"
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	"github.com/shopware/shopware-lsp/internal/twig"
+)
+
+type TwigMacroSignatureHelpProvider struct {
+	twigIndexer *twig.TwigIndexer
+}
+
+func NewTwigMacroSignatureHelpProvider(lspServer *lsp.Server) *TwigMacroSignatureHelpProvider {
+	twigIndexer, _ := lspServer.GetIndexer("twig.indexer")
+
+	return &TwigMacroSignatureHelpProvider{
+		twigIndexer: twigIndexer.(*twig.TwigIndexer),
+	}
+}
+
+func (p *TwigMacroSignatureHelpProvider) GetSignatureHelp(ctx context.Context, params *protocol.SignatureHelpParams) (*protocol.SignatureHelp, error) {
+	if strings.ToLower(filepath.Ext(params.TextDocument.URI)) != ".twig" || params.DocumentContent == nil || params.Node == nil {
+		return nil, nil
+	}
+
+	root := params.Node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	offset := treesitterhelper.OffsetAt(params.DocumentContent, params.Position.Line, params.Position.Character)
+
+	callee, activeParameter, ok := twig.MacroCallAt(root, params.DocumentContent, offset)
+	if !ok {
+		return nil, nil
+	}
+
+	currentTemplate := twig.ConvertToRelativePath(strings.TrimPrefix(params.TextDocument.URI, "file://"))
+	templatePath, macroName, ok := twig.ResolveMacroCallee(twig.ParseTwigMacroImports(root, params.DocumentContent, currentTemplate), callee)
+	if !ok {
+		return nil, nil
+	}
+
+	macros, err := p.twigIndexer.GetMacros(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	macro, found := macros[macroName]
+	if !found {
+		return nil, nil
+	}
+
+	signature := protocol.SignatureInformation{
+		Label: macro.Signature(),
+		Documentation: &protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: fmt.Sprintf("Macro defined in `%s`", templatePath),
+		},
+		Parameters: make([]protocol.ParameterInformation, 0, len(macro.Arguments)),
+	}
+
+	for _, argument := range macro.Arguments {
+		signature.Parameters = append(signature.Parameters, protocol.ParameterInformation{Label: argument.String()})
+	}
+
+	return &protocol.SignatureHelp{
+		Signatures:      []protocol.SignatureInformation{signature},
+		ActiveSignature: 0,
+		ActiveParameter: activeParameter,
+	}, nil
+}
+
+func (p *TwigMacroSignatureHelpProvider) GetTriggerCharacters() []string {
+	return []string{"(", ","}
+}
@@ -0,0 +1,62 @@
+package signaturehelp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/shopware/shopware-lsp/internal/twig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseTwig(t *testing.T, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language())))
+	defer parser.Close()
+
+	return parser.Parse([]byte(code), nil)
+}
+
+func TestTwigMacroSignatureHelp(t *testing.T) {
+	twigIndexer, err := twig.NewTwigIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = twigIndexer.Close() }()
+
+	macros := `{% macro input(name, type = 'text') %}{% endmacro %}`
+	macroTree := parseTwig(t, macros)
+	defer macroTree.Close()
+	require.NoError(t, twigIndexer.Index("/project/Resources/views/storefront/macros/forms.html.twig", macroTree.RootNode(), []byte(macros)))
+
+	provider := &TwigMacroSignatureHelpProvider{twigIndexer: twigIndexer}
+
+	getSignatureHelp := func(content string) *protocol.SignatureHelp {
+		tree := parseTwig(t, content)
+		defer tree.Close()
+
+		params := &protocol.SignatureHelpParams{}
+		params.TextDocument.URI = "file:///project/Resources/views/storefront/page/index.html.twig"
+		params.Position.Character = len(content)
+		params.DocumentContent = []byte(content)
+		params.Node = tree.RootNode()
+
+		signatureHelp, err := provider.GetSignatureHelp(context.Background(), params)
+		require.NoError(t, err)
+		return signatureHelp
+	}
+
+	const imports = "{% from 'storefront/macros/forms.html.twig' import input as field %}"
+
+	assert.Nil(t, getSignatureHelp(imports+"{{ field('email') }} "))
+	assert.Nil(t, getSignatureHelp(imports+"{{ other('email', "))
+
+	signatureHelp := getSignatureHelp(imports + "{{ field('email', ")
+	require.NotNil(t, signatureHelp)
+	require.Len(t, signatureHelp.Signatures, 1)
+
+	assert.Equal(t, "input(name, type = 'text')", signatureHelp.Signatures[0].Label)
+	assert.Equal(t, []protocol.ParameterInformation{{Label: "name"}, {Label: "type = 'text'"}}, signatureHelp.Signatures[0].Parameters)
+	assert.Equal(t, 1, signatureHelp.ActiveParameter)
+}
@@ -21,6 +21,14 @@ type HoverProvider interface {
 	GetHover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error)
 }
 
+// SignatureHelpProvider is an interface for providing signature help
+type SignatureHelpProvider interface {
+	// GetSignatureHelp returns the signature of the call at the given position
+	GetSignatureHelp(ctx context.Context, params *protocol.SignatureHelpParams) (*protocol.SignatureHelp, error)
+	// GetTriggerCharacters returns the characters that trigger this signature help provider
+	GetTriggerCharacters() []string
+}
+
 // CodeLensProvider is an interface for providing code lenses
 type CodeLensProvider interface {
 	// GetCodeLenses returns code lenses for the given document
@@ -29,6 +37,20 @@ type CodeLensProvider interface {
 	ResolveCodeLens(ctx context.Context, codeLens *protocol.CodeLens) (*protocol.CodeLens, error)
 }
 
+// DocumentLinkProvider is an interface for providing document links
+type DocumentLinkProvider interface {
+	// GetDocumentLinks returns the links of the given document
+	GetDocumentLinks(ctx context.Context, params *protocol.DocumentLinkParams) []protocol.DocumentLink
+}
+
+// DocumentColorProvider is an interface for providing colors and color pickers
+type DocumentColorProvider interface {
+	// GetDocumentColors returns the colors of the given document
+	GetDocumentColors(ctx context.Context, params *protocol.DocumentColorParams) []protocol.ColorInformation
+	// GetColorPresentations returns the ways to write a picked color, or nothing if the provider does not handle the document
+	GetColorPresentations(ctx context.Context, params *protocol.ColorPresentationParams) []protocol.ColorPresentation
+}
+
 // IndexerProvider is an interface for indexers that can be registered with the server
 type IndexerProvider interface {
 	// ID returns a unique identifier for this indexer
@@ -45,3 +45,45 @@ func (c *PHPIndex) GetMethod(className string, name string) *PHPMethod {
 
 	return &method
 }
+
+// GetPublicMembers returns the public methods and properties of a class including inherited ones.
+// Members declared in the class itself take precedence over inherited members.
+func (c *PHPIndex) GetPublicMembers(className string) (map[string]PHPMethod, map[string]PHPProperty) {
+	methods := make(map[string]PHPMethod)
+	properties := make(map[string]PHPProperty)
+	visited := make(map[string]bool)
+
+	var collect func(name string)
+	collect = func(name string) {
+		if name == "" || visited[name] {
+			return
+		}
+		visited[name] = true
+
+		class := c.GetClass(name)
+		if class == nil {
+			return
+		}
+
+		for methodName, method := range class.Methods {
+			if _, ok := methods[methodName]; !ok && method.Visibility == Public {
+				methods[methodName] = method
+			}
+		}
+
+		for propertyName, property := range class.Properties {
+			if _, ok := properties[propertyName]; !ok && property.Visibility == Public {
+				properties[propertyName] = property
+			}
+		}
+
+		collect(class.Parent)
+		for _, interfaceName := range class.Interfaces {
+			collect(interfaceName)
+		}
+	}
+
+	collect(className)
+
+	return methods, properties
+}
@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 
 	"github.com/shopware/shopware-lsp/internal/indexer"
-	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
 	"github.com/vmihailenco/msgpack/v5"
@@ -313,8 +312,10 @@ func (idx *PHPIndex) GetClasses() map[string]PHPClass {
 // GetTypeOfNode determines the PHP type of a given AST node.
 // This is used for type inference in PHP code to provide accurate completions.
 // Currently supports:
-// - $this->method() expressions
-// - $this->property expressions
+// - $this and local variables (parameters and assignments)
+// - method call and property access chains like $this->loader->load()
+// - new expressions
+// The inference itself lives in typeinference.go.
 func (idx *PHPIndex) GetTypeOfNode(ctx context.Context, node *tree_sitter.Node, fileContent []byte) PHPType {
 	if node == nil {
 		return nil
@@ -327,48 +328,15 @@ func (idx *PHPIndex) GetTypeOfNode(ctx context.Context, node *tree_sitter.Node,
 		return NewMixedType()
 	}
 
-	nodeKind := node.Kind()
-
-	// Handle member call expression: $this->method()
-	if nodeKind == "member_call_expression" {
-		return idx.handleMemberCallExpression(node, fileContent, phpCtx.InsideClass.Name)
+	switch node.Kind() {
+	case "variable_name", "member_call_expression", "nullsafe_member_call_expression", "member_access_expression", "nullsafe_member_access_expression", "object_creation_expression", "parenthesized_expression":
+		return idx.ResolveTypeChain(ExpressionTypeChain(node, fileContent, phpCtx.InsideClass.Name))
 	}
 
 	// Default to mixed type if we can't determine a specific type
 	return NewMixedType()
 }
 
-// handleMemberCallExpression processes $this->method() calls and returns the return type of that method
-func (idx *PHPIndex) handleMemberCallExpression(node *tree_sitter.Node, fileContent []byte, currentClass string) PHPType {
-	// Extract the object part of the expression (should be $this)
-	memberAccessExpression := treesitterhelper.GetFirstNodeOfKind(node, "member_access_expression")
-
-	if memberAccessExpression == nil {
-		return NewPHPType("mixed")
-	}
-
-	variableName := treesitterhelper.GetFirstNodeOfKind(memberAccessExpression, "variable_name")
-
-	if variableName == nil {
-		return NewPHPType("mixed")
-	}
-
-	propertyName := string(treesitterhelper.GetFirstNodeOfKind(memberAccessExpression, "name").Utf8Text(fileContent))
-
-	// Not a $this call
-	if string(treesitterhelper.GetFirstNodeOfKind(variableName, "name").Utf8Text(fileContent)) != "this" {
-		return NewPHPType("mixed")
-	}
-
-	property := idx.GetProperty(currentClass, propertyName)
-	if property != nil {
-		return property.Type
-	}
-
-	// Default to mixed if we couldn't determine the type
-	return NewPHPType("mixed")
-}
-
 func (idx *PHPIndex) RemovedFiles(paths []string) error {
 	return idx.dataIndexer.BatchDeleteByFilePaths(paths)
 }
@@ -505,6 +505,15 @@ func resolveTypeFromDeclaration(node *tree_sitter.Node, fileContent []byte, alia
 		}
 	}
 
+	// ?Foo is stored as union of the inner type and null
+	optionalTypeNode := findDirectChildOfKind(node, "optional_type")
+	if optionalTypeNode != nil {
+		innerType := resolveTypeFromDeclaration(optionalTypeNode, fileContent, aliasResolver, typeCache, nil)
+		if innerType != nil {
+			return NewPHPType("?" + innerType.Name())
+		}
+	}
+
 	primitiveTypeNode := findDirectChildOfKind(node, "primitive_type")
 	if primitiveTypeNode != nil {
 		typeString := string(primitiveTypeNode.Utf8Text(fileContent))
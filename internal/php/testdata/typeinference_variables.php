@@ -0,0 +1,28 @@
+<?php
+
+namespace App\Controller;
+
+use App\Controller\ProductPageLoader as Loader;
+
+class ProductController
+{
+    public function __construct(private readonly Loader $loader)
+    {
+    }
+
+    public function index(Request $request, ?ProductPage $fallback): Response
+    {
+        $page = $this->loader->load($request);
+        $copy = $page;
+        $created = new ProductPage();
+
+        return $this->render('index.html.twig', ['page' => $page, 'copy' => $copy, 'created' => $created, 'fallback' => $fallback, 'loaded' => $this->loader->load($request)]);
+    }
+}
+
+class ProductPageLoader
+{
+    public function load(Request $request): ProductPage
+    {
+    }
+}
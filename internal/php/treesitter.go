@@ -3,6 +3,7 @@ package php
 import (
 	"context"
 	"slices"
+	"strings"
 
 	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
@@ -43,10 +44,76 @@ func (s *PHPIndex) IsMethodCalledOnClass(ctx context.Context, node *tree_sitter.
 		return false
 	}
 
-	nodeType := s.GetTypeOfNode(ctx, current, content)
+	nodeType := s.GetTypeOfNode(ctx, current.ChildByFieldName("object"), content)
 	if nodeType == nil {
 		return false
 	}
 
 	return nodeType.Matches(NewPHPType(className))
 }
+
+// MethodCallArgument returns the member call whose arguments contain node and the position of the argument,
+// or nil and -1 if node is not inside a method call argument
+func MethodCallArgument(node *tree_sitter.Node) (*tree_sitter.Node, int) {
+	for current := node; current != nil; current = current.Parent() {
+		if current.Kind() != "argument" {
+			continue
+		}
+
+		arguments := current.Parent()
+		if arguments == nil || arguments.Kind() != "arguments" {
+			return nil, -1
+		}
+
+		call := arguments.Parent()
+		if call == nil || call.Kind() != "member_call_expression" {
+			return nil, -1
+		}
+
+		index := 0
+		for i := uint(0); i < arguments.NamedChildCount(); i++ {
+			argument := arguments.NamedChild(i)
+			if argument.Id() == current.Id() {
+				return call, index
+			}
+			if argument.Kind() == "argument" {
+				index++
+			}
+		}
+	}
+
+	return nil, -1
+}
+
+// ArgumentValue returns the value node of an argument of a call, or nil if the call has no such argument
+func ArgumentValue(call *tree_sitter.Node, index int) *tree_sitter.Node {
+	arguments := call.ChildByFieldName("arguments")
+	if arguments == nil {
+		return nil
+	}
+
+	position := 0
+	for i := uint(0); i < arguments.NamedChildCount(); i++ {
+		argument := arguments.NamedChild(i)
+		if argument.Kind() != "argument" {
+			continue
+		}
+
+		if position == index {
+			return argument.NamedChild(argument.NamedChildCount() - 1)
+		}
+		position++
+	}
+
+	return nil
+}
+
+// StringArgument returns the value of a string literal argument of a call
+func StringArgument(call *tree_sitter.Node, index int, content []byte) (string, bool) {
+	value := ArgumentValue(call, index)
+	if value == nil || (value.Kind() != "string" && value.Kind() != "encapsed_string") {
+		return "", false
+	}
+
+	return strings.Trim(string(value.Utf8Text(content)), "'\""), true
+}
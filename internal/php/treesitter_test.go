@@ -0,0 +1,93 @@
+package php
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+const methodCalledOnClassCode = `<?php
+
+namespace App\Service;
+
+use Shopware\Core\System\SystemConfig\SystemConfigService;
+
+class ConfigReader
+{
+    public function __construct(private readonly SystemConfigService $systemConfigService, private readonly Other $other)
+    {
+    }
+
+    public function read(SystemConfigService $parameter): void
+    {
+        $local = $this->systemConfigService;
+
+        $this->systemConfigService->get('property');
+        $parameter->get('parameter');
+        $local->get('local');
+        $this->other->get('other');
+        $this->getService()->get('returned');
+        $this->getOther()->get('returnedOther');
+        $unknown->get('unknown');
+    }
+
+    public function getService(): SystemConfigService
+    {
+    }
+
+    public function getOther(): Other
+    {
+    }
+}
+
+class Other
+{
+}
+`
+
+func TestIsMethodCalledOnClass(t *testing.T) {
+	idx, err := NewPHPIndex(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	content := []byte(methodCalledOnClassCode)
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	require.NoError(t, idx.Index("ConfigReader.php", tree.RootNode(), content))
+
+	expected := map[string]bool{
+		"property":      true,
+		"parameter":     true,
+		"local":         true,
+		"other":         false,
+		"returned":      true,
+		"returnedOther": false,
+		// Unknown types are mixed, which matches every class
+		"unknown": true,
+	}
+
+	stringNodes := treesitterhelper.FindAll(tree.RootNode(), treesitterhelper.NodeKind("string"), content)
+	require.Len(t, stringNodes, len(expected))
+
+	for _, stringNode := range stringNodes {
+		key := strings.Trim(string(stringNode.Utf8Text(content)), "'")
+
+		ctx := idx.AddContext(context.Background(), stringNode, content)
+		assert.Equal(t, expected[key], idx.IsMethodCalledOnClass(ctx, stringNode, content, "Shopware\\Core\\System\\SystemConfig\\SystemConfigService"), key)
+	}
+
+	// Without a PHP context the class of the call cannot be determined
+	assert.False(t, idx.IsMethodCalledOnClass(context.Background(), stringNodes[0], content, "Shopware\\Core\\System\\SystemConfig\\SystemConfigService"))
+}
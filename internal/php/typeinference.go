@@ -1,10 +1,294 @@
 // Package php provides PHP language support for the LSP
 package php
 
+import (
+	"slices"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
 // typeinference.go
-// This file is a placeholder for type inference functionality.
-// The actual implementation for PHP type inference is in indexer.go
-// where the handleMemberCallExpression method now supports:
-// 1. Type inference for $this->method() calls
-// 2. Full class hierarchy traversal (parent classes and interfaces)
-// 3. Method return type resolution through multiple inheritance levels
+// Type inference for local variables and expressions inside a method body.
+// The entry point is GetTypeOfNode in indexer.go, which delegates here for:
+// 1. $this and local variables (parameters and the last assignment before the usage)
+// 2. Property access chains like $this->loader->page
+// 3. Method call chains like $this->loader->load($request), resolved through the class hierarchy
+// 4. new Foo() expressions
+//
+// Inference runs in two steps: ExpressionTypeChain reduces the expression to a TypeChain using
+// only the syntax tree, ResolveTypeChain then looks up the members in the index.
+// Indexers store the TypeChain, as the classes it refers to may not be indexed yet.
+
+// maxInferenceDepth limits recursion when variables are assigned from other variables
+const maxInferenceDepth = 10
+
+// TypeChain is an expression reduced to a statically known type and the members accessed on it.
+// $this->loader->load($request) inside ProductController becomes
+// {Type: "App\\Controller\\ProductController", Members: ["loader", "load()"]}.
+// An empty Type means the expression could not be inferred.
+type TypeChain struct {
+	Type    string
+	Members []string
+}
+
+// ExpressionTypeChain reduces an expression to a TypeChain without using the index
+func ExpressionTypeChain(node *tree_sitter.Node, fileContent []byte, currentClass string) TypeChain {
+	return expressionTypeChain(node, fileContent, currentClass, 0)
+}
+
+// ResolveTypeChain returns the type the expression of the chain evaluates to
+func (idx *PHPIndex) ResolveTypeChain(chain TypeChain) PHPType {
+	if chain.Type == "" {
+		return NewMixedType()
+	}
+
+	current := NewPHPType(chain.Type)
+	for _, member := range chain.Members {
+		className := ClassNameOfType(current)
+		if className == "" {
+			return NewMixedType()
+		}
+
+		if methodName, isCall := strings.CutSuffix(member, "()"); isCall {
+			current = idx.GetMethodReturnType(className, methodName)
+			continue
+		}
+
+		property := idx.GetProperty(className, member)
+		if property == nil || property.Type == nil {
+			return NewMixedType()
+		}
+		current = property.Type
+	}
+
+	return current
+}
+
+func expressionTypeChain(node *tree_sitter.Node, fileContent []byte, currentClass string, depth int) TypeChain {
+	if node == nil || depth > maxInferenceDepth {
+		return TypeChain{}
+	}
+
+	switch node.Kind() {
+	case "variable_name":
+		return variableTypeChain(node, fileContent, currentClass, depth)
+	case "parenthesized_expression":
+		if node.NamedChildCount() > 0 {
+			return expressionTypeChain(node.NamedChild(0), fileContent, currentClass, depth+1)
+		}
+	case "object_creation_expression":
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			child := node.NamedChild(i)
+			if child.Kind() == "name" || child.Kind() == "qualified_name" {
+				return TypeChain{Type: resolveClassNameInFile(node, fileContent, string(child.Utf8Text(fileContent)))}
+			}
+		}
+	case "member_access_expression", "nullsafe_member_access_expression", "member_call_expression", "nullsafe_member_call_expression":
+		object := expressionTypeChain(node.ChildByFieldName("object"), fileContent, currentClass, depth+1)
+		nameNode := node.ChildByFieldName("name")
+		if nameNode == nil || object.Type == "" {
+			return TypeChain{}
+		}
+
+		member := string(nameNode.Utf8Text(fileContent))
+		if strings.HasSuffix(node.Kind(), "call_expression") {
+			member += "()"
+		}
+
+		return TypeChain{Type: object.Type, Members: append(slices.Clone(object.Members), member)}
+	}
+
+	return TypeChain{}
+}
+
+// variableTypeChain infers the type of a variable from the enclosing method.
+// Assignments take precedence over the parameter declaration, the last assignment before the usage wins.
+func variableTypeChain(node *tree_sitter.Node, fileContent []byte, currentClass string, depth int) TypeChain {
+	variableName := string(node.Utf8Text(fileContent))
+	if variableName == "$this" {
+		return TypeChain{Type: currentClass}
+	}
+
+	method := node.Parent()
+	for method != nil && method.Kind() != "method_declaration" && method.Kind() != "function_definition" {
+		method = method.Parent()
+	}
+
+	if method == nil {
+		return TypeChain{}
+	}
+
+	if body := method.ChildByFieldName("body"); body != nil {
+		if assignment := findLastAssignment(body, fileContent, variableName, node.StartByte()); assignment != nil {
+			return expressionTypeChain(assignment.ChildByFieldName("right"), fileContent, currentClass, depth+1)
+		}
+	}
+
+	parameters := method.ChildByFieldName("parameters")
+	if parameters == nil {
+		return TypeChain{}
+	}
+
+	for i := uint(0); i < parameters.NamedChildCount(); i++ {
+		parameter := parameters.NamedChild(i)
+		nameNode := parameter.ChildByFieldName("name")
+		if nameNode == nil || string(nameNode.Utf8Text(fileContent)) != variableName {
+			continue
+		}
+
+		typeNode := parameter.ChildByFieldName("type")
+		if typeNode == nil {
+			return TypeChain{}
+		}
+
+		// ?Foo is treated like Foo, we only care about the members
+		if typeNode.Kind() == "optional_type" && typeNode.NamedChildCount() > 0 {
+			typeNode = typeNode.NamedChild(0)
+		}
+
+		switch typeNode.Kind() {
+		case "named_type":
+			return TypeChain{Type: resolveClassNameInFile(node, fileContent, string(typeNode.Utf8Text(fileContent)))}
+		case "primitive_type":
+			return TypeChain{Type: string(typeNode.Utf8Text(fileContent))}
+		}
+
+		return TypeChain{}
+	}
+
+	return TypeChain{}
+}
+
+// findLastAssignment returns the last assignment to the variable which ends before the given byte offset
+func findLastAssignment(node *tree_sitter.Node, fileContent []byte, variableName string, before uint) *tree_sitter.Node {
+	var found *tree_sitter.Node
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		if child.StartByte() >= before {
+			break
+		}
+
+		// Closures have their own scope
+		if child.Kind() == "anonymous_function" || child.Kind() == "arrow_function" {
+			continue
+		}
+
+		if child.Kind() == "assignment_expression" && child.EndByte() <= before {
+			left := child.ChildByFieldName("left")
+			if left != nil && left.Kind() == "variable_name" && string(left.Utf8Text(fileContent)) == variableName {
+				found = child
+			}
+		}
+
+		if nested := findLastAssignment(child, fileContent, variableName, before); nested != nil {
+			found = nested
+		}
+	}
+
+	return found
+}
+
+// resolveClassNameInFile resolves a class name using the namespace and use statements of the file containing the node
+func resolveClassNameInFile(node *tree_sitter.Node, fileContent []byte, className string) string {
+	className = strings.TrimPrefix(className, "?")
+	if strings.HasPrefix(className, "\\") {
+		return strings.TrimPrefix(className, "\\")
+	}
+
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	namespace := ""
+	useStatements := make(map[string]string)
+	aliases := make(map[string]string)
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		child := root.NamedChild(i)
+
+		switch child.Kind() {
+		case "namespace_definition":
+			if nameNode := child.ChildByFieldName("name"); nameNode != nil {
+				namespace = string(nameNode.Utf8Text(fileContent))
+			}
+		case "namespace_use_declaration":
+			for j := uint(0); j < child.NamedChildCount(); j++ {
+				useClause := child.NamedChild(j)
+				if useClause.Kind() != "namespace_use_clause" {
+					continue
+				}
+
+				qualifiedName := findChildByKind(useClause, "qualified_name")
+				if qualifiedName == nil {
+					continue
+				}
+
+				fullPath := string(qualifiedName.Utf8Text(fileContent))
+				shortName := fullPath[strings.LastIndex(fullPath, "\\")+1:]
+
+				if aliasNode := findChildByKind(useClause, "name"); aliasNode != nil {
+					aliases[string(aliasNode.Utf8Text(fileContent))] = fullPath
+				} else {
+					useStatements[shortName] = fullPath
+				}
+			}
+		}
+	}
+
+	return NewAliasResolver(namespace, useStatements, aliases).ResolveType(className)
+}
+
+// GetMethodReturnType returns the return type of a method looked up through parents and interfaces.
+// self and static are resolved to the given class.
+func (idx *PHPIndex) GetMethodReturnType(className, methodName string) PHPType {
+	var returnType PHPType
+	if method := idx.GetMethod(className, methodName); method != nil {
+		returnType = method.ReturnType
+	} else {
+		returnType = idx.searchParentClassMethod(className, methodName)
+	}
+
+	if returnType == nil {
+		return NewMixedType()
+	}
+
+	if special, ok := returnType.(*SpecialType); ok && (special.Name() == "self" || special.Name() == "static") {
+		return NewPHPType(className)
+	}
+
+	return returnType
+}
+
+// ClassNameOfType returns the class name of an object type, nullable object types are unwrapped.
+// It returns an empty string for all other types.
+func ClassNameOfType(phpType PHPType) string {
+	switch t := phpType.(type) {
+	case *ObjectType:
+		if t.className == "object" {
+			return ""
+		}
+		return t.className
+	case *UnionType:
+		className := ""
+		for _, member := range t.types {
+			if _, ok := member.(*NullType); ok {
+				continue
+			}
+
+			if className != "" {
+				return ""
+			}
+
+			className = ClassNameOfType(member)
+			if className == "" {
+				return ""
+			}
+		}
+		return className
+	}
+
+	return ""
+}
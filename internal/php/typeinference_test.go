@@ -1,9 +1,16 @@
 package php
 
 import (
+	"context"
+	"os"
+	"strings"
 	"testing"
 
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
 )
 
 func TestTypeInferenceWithInheritance(t *testing.T) {
@@ -228,3 +235,78 @@ func TestTypeInferenceWithInheritance(t *testing.T) {
 		}
 	})
 }
+
+func TestTypeInferenceOfLocalVariables(t *testing.T) {
+	idx, err := NewPHPIndex(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	filePath := "testdata/typeinference_variables.php"
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	require.NoError(t, idx.Index(filePath, tree.RootNode(), content))
+
+	expected := map[string]string{
+		"page":     "App\\Controller\\ProductPage",
+		"copy":     "App\\Controller\\ProductPage",
+		"created":  "App\\Controller\\ProductPage",
+		"fallback": "App\\Controller\\ProductPage",
+		"loaded":   "App\\Controller\\ProductPage",
+	}
+
+	elements := treesitterhelper.FindAll(tree.RootNode(), treesitterhelper.NodeKind("array_element_initializer"), content)
+	require.Len(t, elements, len(expected))
+
+	for _, element := range elements {
+		key := strings.Trim(string(element.NamedChild(0).Utf8Text(content)), "'")
+		value := element.NamedChild(1)
+
+		ctx := idx.AddContext(context.Background(), value, content)
+		assert.Equal(t, expected[key], ClassNameOfType(idx.GetTypeOfNode(ctx, value, content)), key)
+	}
+}
+
+func TestExpressionTypeChain(t *testing.T) {
+	filePath := "testdata/typeinference_variables.php"
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	loadChain := TypeChain{Type: "App\\Controller\\ProductController", Members: []string{"loader", "load()"}}
+	expected := map[string]TypeChain{
+		"page":     loadChain,
+		"copy":     loadChain,
+		"created":  {Type: "App\\Controller\\ProductPage"},
+		"fallback": {Type: "App\\Controller\\ProductPage"},
+		"loaded":   loadChain,
+	}
+
+	for _, element := range treesitterhelper.FindAll(tree.RootNode(), treesitterhelper.NodeKind("array_element_initializer"), content) {
+		key := strings.Trim(string(element.NamedChild(0).Utf8Text(content)), "'")
+		assert.Equal(t, expected[key], ExpressionTypeChain(element.NamedChild(1), content, "App\\Controller\\ProductController"), key)
+	}
+
+	// Chains are resolved against the index, so classes indexed later are picked up
+	idx, err := NewPHPIndex(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	assert.Equal(t, "", ClassNameOfType(idx.ResolveTypeChain(loadChain)))
+	require.NoError(t, idx.Index(filePath, tree.RootNode(), content))
+	assert.Equal(t, "App\\Controller\\ProductPage", ClassNameOfType(idx.ResolveTypeChain(loadChain)))
+	assert.Equal(t, "", ClassNameOfType(idx.ResolveTypeChain(TypeChain{})))
+}
@@ -0,0 +1,225 @@
+package scss
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Color is a RGBA color with components between 0 and 1
+type Color struct {
+	Red   float64
+	Green float64
+	Blue  float64
+	Alpha float64
+}
+
+// ColorLiteral is a color written in a SCSS file
+type ColorLiteral struct {
+	Color  Color
+	Offset int
+	Length int
+}
+
+// colorFunctionRegex matches rgb(), rgba(), hsl() and hsla() calls without nested parentheses
+var colorFunctionRegex = regexp.MustCompile(`\b(rgba?|hsla?)\(([^()]*)\)`)
+
+// hexColorRegex matches hex colors with 3, 4, 6 or 8 digits
+var hexColorRegex = regexp.MustCompile(`#(?:[0-9a-fA-F]{8}|[0-9a-fA-F]{6}|[0-9a-fA-F]{3,4})\b`)
+
+// ParseColor parses a hex color or a rgb(), rgba(), hsl() or hsla() call with literal arguments
+func ParseColor(value string) (Color, bool) {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, "#") {
+		if hexColorRegex.FindString(value) != value {
+			return Color{}, false
+		}
+		return parseHexColor(value[1:]), true
+	}
+
+	match := colorFunctionRegex.FindStringSubmatch(value)
+	if match == nil || match[0] != value {
+		return Color{}, false
+	}
+
+	return parseColorFunction(strings.ToLower(match[1]), match[2])
+}
+
+// ParseColors returns the hex colors and color function calls with literal arguments of a SCSS file. Hex
+// colors in selectors like #main, strings and comments are no color values in the tree.
+func ParseColors(root *tree_sitter.Node, content []byte) []ColorLiteral {
+	var colors []ColorLiteral
+
+	for _, node := range treesitterhelper.FindAll(root, colorPattern, content) {
+		color, ok := ParseColor(node.Utf8Text(content))
+		if !ok {
+			continue
+		}
+
+		colors = append(colors, ColorLiteral{
+			Color:  color,
+			Offset: int(node.StartByte()),
+			Length: int(node.EndByte() - node.StartByte()),
+		})
+	}
+
+	return colors
+}
+
+// colorPattern matches the nodes which can be a color literal, ParseColor decides if they are
+var colorPattern = treesitterhelper.AnyNodeKind("color_value", "call_expression")
+
+// Hex returns the color as #rrggbb, or #rrggbbaa if it is transparent
+func (c Color) Hex() string {
+	hex := fmt.Sprintf("#%02x%02x%02x", colorByte(c.Red), colorByte(c.Green), colorByte(c.Blue))
+	if c.Alpha < 1 {
+		hex += fmt.Sprintf("%02x", colorByte(c.Alpha))
+	}
+	return hex
+}
+
+// RGB returns the color as rgb(), or rgba() if it is transparent
+func (c Color) RGB() string {
+	if c.Alpha < 1 {
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", colorByte(c.Red), colorByte(c.Green), colorByte(c.Blue), strconv.FormatFloat(math.Round(c.Alpha*100)/100, 'f', -1, 64))
+	}
+	return fmt.Sprintf("rgb(%d, %d, %d)", colorByte(c.Red), colorByte(c.Green), colorByte(c.Blue))
+}
+
+// parseHexColor parses the digits of a valid hex color
+func parseHexColor(digits string) Color {
+	if len(digits) <= 4 {
+		var expanded strings.Builder
+		for _, digit := range digits {
+			expanded.WriteRune(digit)
+			expanded.WriteRune(digit)
+		}
+		digits = expanded.String()
+	}
+
+	component := func(i int) float64 {
+		value, _ := strconv.ParseUint(digits[i*2:i*2+2], 16, 8)
+		return float64(value) / 255
+	}
+
+	color := Color{Red: component(0), Green: component(1), Blue: component(2), Alpha: 1}
+	if len(digits) == 8 {
+		color.Alpha = component(3)
+	}
+
+	return color
+}
+
+// parseColorFunction parses the arguments of a color function. Both the comma separated and the space
+// separated syntax with / alpha are accepted.
+func parseColorFunction(name, arguments string) (Color, bool) {
+	arguments = strings.NewReplacer(",", " ", "/", " ").Replace(arguments)
+	values := strings.Fields(arguments)
+	if len(values) != 3 && len(values) != 4 {
+		return Color{}, false
+	}
+
+	alpha := 1.0
+	if len(values) == 4 {
+		var ok bool
+		if alpha, ok = parseColorNumber(values[3], 1); !ok {
+			return Color{}, false
+		}
+	}
+
+	if strings.HasPrefix(name, "hsl") {
+		hue, ok := parseColorNumber(strings.TrimSuffix(values[0], "deg"), 360)
+		if !ok || strings.HasSuffix(values[0], "%") {
+			return Color{}, false
+		}
+		saturation, ok := parseColorNumber(values[1], 100)
+		if !ok {
+			return Color{}, false
+		}
+		lightness, ok := parseColorNumber(values[2], 100)
+		if !ok {
+			return Color{}, false
+		}
+		red, green, blue := hslToRGB(hue, saturation, lightness)
+		return Color{Red: red, Green: green, Blue: blue, Alpha: clampColor(alpha)}, true
+	}
+
+	var components [3]float64
+	for i := range components {
+		value, ok := parseColorNumber(values[i], 255)
+		if !ok {
+			return Color{}, false
+		}
+		components[i] = value
+	}
+
+	return Color{Red: components[0], Green: components[1], Blue: components[2], Alpha: clampColor(alpha)}, true
+}
+
+// parseColorNumber parses a number or percentage and scales it by the given maximum to 0..1
+func parseColorNumber(value string, maximum float64) (float64, bool) {
+	if percentage, ok := strings.CutSuffix(value, "%"); ok {
+		number, err := strconv.ParseFloat(percentage, 64)
+		if err != nil {
+			return 0, false
+		}
+		return clampColor(number / 100), true
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	// Hues wrap around instead of being clamped
+	if maximum == 360 {
+		return math.Mod(math.Mod(number, 360)+360, 360) / 360, true
+	}
+
+	return clampColor(number / maximum), true
+}
+
+// hslToRGB converts a hue, saturation and lightness between 0 and 1 to RGB
+func hslToRGB(hue, saturation, lightness float64) (float64, float64, float64) {
+	if saturation == 0 {
+		return lightness, lightness, lightness
+	}
+
+	q := lightness * (1 + saturation)
+	if lightness >= 0.5 {
+		q = lightness + saturation - lightness*saturation
+	}
+	p := 2*lightness - q
+
+	channel := func(t float64) float64 {
+		t = math.Mod(t+1, 1)
+		switch {
+		case t < 1.0/6:
+			return p + (q-p)*6*t
+		case t < 0.5:
+			return q
+		case t < 2.0/3:
+			return p + (q-p)*(2.0/3-t)*6
+		default:
+			return p
+		}
+	}
+
+	return channel(hue + 1.0/3), channel(hue), channel(hue - 1.0/3)
+}
+
+// clampColor limits a component to 0..1
+func clampColor(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
+
+// colorByte converts a component to 0..255
+func colorByte(value float64) int {
+	return int(math.Round(clampColor(value) * 255))
+}
@@ -0,0 +1,64 @@
+package scss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		value string
+		hex   string
+		ok    bool
+	}{
+		{"#008490", "#008490", true},
+		{"#FFF", "#ffffff", true},
+		{"#00000080", "#00000080", true},
+		{"rgb(255, 0, 0)", "#ff0000", true},
+		{"rgba(0, 0, 0, 0.5)", "#00000080", true},
+		{"rgb(0 128 0 / 50%)", "#00800080", true},
+		{"hsl(120, 100%, 25%)", "#008000", true},
+		{"$sw-color-brand-primary", "", false},
+		{"rgba($black, .5)", "", false},
+		{"#12345", "", false},
+		{"darken(#fff, 10%)", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			color, ok := ParseColor(tt.value)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.hex, color.Hex())
+			}
+		})
+	}
+
+	color, _ := ParseColor("#00000080")
+	assert.Equal(t, "rgba(0, 0, 0, 0.5)", color.RGB())
+	color, _ = ParseColor("#008490")
+	assert.Equal(t, "rgb(0, 132, 144)", color.RGB())
+}
+
+func TestParseColors(t *testing.T) {
+	code := `// #ff0000 in a comment
+#bad .box {
+    color: #fff;
+    background: rgba(0, 0, 0, .25);
+    border-color: rgba($black, .5);
+    content: "#{$name}";
+}
+$brand: #008490 !default;
+`
+	colors := ParseColors(parseScss(t, code), []byte(code))
+	require.Len(t, colors, 3)
+
+	var literals []string
+	for _, color := range colors {
+		literals = append(literals, code[color.Offset:color.Offset+color.Length])
+	}
+	assert.Equal(t, []string{"#fff", "rgba(0, 0, 0, .25)", "#008490"}, literals)
+	assert.InDelta(t, 0.25, colors[1].Color.Alpha, 0.001)
+}
@@ -0,0 +1,289 @@
+package scss
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/shopware/shopware-lsp/internal/theme"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Import is a path imported with @import, @use or @forward
+type Import struct {
+	// Path is the imported path as written, without quotes
+	Path string
+
+	// Directive is the importing directive (import, use or forward)
+	Directive string
+
+	// Offset is the byte offset of the path without the opening quote
+	Offset int
+}
+
+// ParseImports returns the imported paths of a SCSS file. Built-in modules (sass:math), URLs and
+// interpolated paths are skipped.
+func ParseImports(root *tree_sitter.Node, content []byte) []Import {
+	var imports []Import
+
+	for _, node := range treesitterhelper.FindAll(root, treesitterhelper.NodeKind("string_value"), content) {
+		if imp, ok := importOf(node, content); ok {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
+
+// ImportAt returns the import of the path at the cursor, or nil if there is none
+func ImportAt(node *tree_sitter.Node, content []byte) *Import {
+	if node == nil || node.Kind() != "string_value" {
+		return nil
+	}
+
+	if imp, ok := importOf(node, content); ok {
+		return &imp
+	}
+	return nil
+}
+
+// importOf returns the import a string is the path of, or false if the string is no importable path
+func importOf(node *tree_sitter.Node, content []byte) (Import, bool) {
+	text := node.Utf8Text(content)
+	if len(text) < 2 || text[len(text)-1] != text[0] {
+		return Import{}, false
+	}
+
+	path := text[1 : len(text)-1]
+	directive := importDirective(node)
+	if directive == "" || !isImportablePath(path) {
+		return Import{}, false
+	}
+
+	return Import{Path: path, Directive: directive, Offset: int(node.StartByte()) + 1}, true
+}
+
+// importDirective returns the directive (import, use or forward) a string is an argument of. The grammar
+// does not know all arguments of the directives, like the with clause of @use, so the directive can be
+// the first token of an ERROR node. Strings in url() are no imports.
+func importDirective(node *tree_sitter.Node) string {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Kind() {
+		case "import_statement", "use_statement", "forward_statement", "ERROR":
+			if first := parent.Child(0); first != nil {
+				switch first.Kind() {
+				case "@import", "@use", "@forward":
+					return first.Kind()[1:]
+				}
+			}
+			if !parent.IsError() {
+				return ""
+			}
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// isImportablePath checks if an import path refers to a stylesheet which can be resolved
+func isImportablePath(path string) bool {
+	return path != "" &&
+		!strings.HasPrefix(path, "sass:") &&
+		!strings.HasPrefix(path, "http://") &&
+		!strings.HasPrefix(path, "https://") &&
+		!strings.HasPrefix(path, "//") &&
+		!strings.Contains(path, "#{")
+}
+
+// defaultAliases are the ~alias imports the Storefront build resolves, relative to its Resources directory
+var defaultAliases = map[string]string{
+	"scss":   "app/storefront/src/scss",
+	"src":    "app/storefront/src",
+	"vendor": "app/storefront/vendor",
+}
+
+// Resolver resolves the import paths of Storefront stylesheets to files
+type Resolver struct {
+	// storefrontResources is the Resources directory of the Storefront bundle, empty if it is not installed
+	storefrontResources string
+
+	// bundles maps bundle and app names to their Resources directory
+	bundles map[string]string
+}
+
+// NewResolver creates a resolver for the Storefront of the project and the given bundles and apps
+func NewResolver(projectRoot string, extensions []extension.ShopwareExtension) *Resolver {
+	r := &Resolver{
+		storefrontResources: theme.StorefrontResources(projectRoot),
+		bundles:             make(map[string]string),
+	}
+
+	if r.storefrontResources != "" {
+		r.bundles["Storefront"] = r.storefrontResources
+	}
+
+	for _, ext := range extensions {
+		r.bundles[ext.Name] = ext.GetResourcesPath()
+	}
+
+	return r
+}
+
+// Resolve returns the file an import of the given file refers to. Partials (_name.scss) and index files are
+// resolved like Sass does. The second result is false if the import can not be judged at all, like an
+// import of a package from node_modules which is not installed.
+func (r *Resolver) Resolve(importPath, fromFile string) (string, bool) {
+	base, ok := r.base(importPath, fromFile)
+	if !ok {
+		return "", false
+	}
+
+	return resolveFile(base), true
+}
+
+// ResolveDirectory returns the directory an import path ending with a / refers to, used for completion
+func (r *Resolver) ResolveDirectory(importPath, fromFile string) (string, bool) {
+	if importPath == "" {
+		return filepath.Dir(fromFile), true
+	}
+
+	base, ok := r.base(strings.TrimSuffix(importPath, "/"), fromFile)
+	if !ok || !isDirectory(base) {
+		return "", false
+	}
+
+	return base, true
+}
+
+// Aliases returns the import prefixes available in the given file: the ~aliases of the theme and Storefront
+// and an @Name prefix per bundle
+func (r *Resolver) Aliases(fromFile string) []string {
+	seen := make(map[string]bool)
+	for alias := range r.aliases(fromFile) {
+		seen["~"+alias+"/"] = true
+	}
+	for name := range r.bundles {
+		seen["@"+name+"/"] = true
+	}
+
+	aliases := make([]string, 0, len(seen))
+	for alias := range seen {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	return aliases
+}
+
+// base returns the path an import refers to before partial and extension resolution
+func (r *Resolver) base(importPath, fromFile string) (string, bool) {
+	switch {
+	case strings.HasPrefix(importPath, "@"):
+		name, rest, _ := strings.Cut(importPath[1:], "/")
+		resources, ok := r.bundles[name]
+		if !ok {
+			// Unknown bundles can only be judged once bundles are indexed
+			return "", len(r.bundles) > 0
+		}
+		return filepath.Join(resources, "app", "storefront", "src", rest), true
+	case strings.HasPrefix(importPath, "~"):
+		alias, rest, _ := strings.Cut(importPath[1:], "/")
+		if dir, ok := r.aliases(fromFile)[alias]; ok {
+			return filepath.Join(dir, rest), true
+		}
+
+		// Packages are resolved from node_modules of the plugin or the Storefront, if installed
+		for _, resources := range []string{resourcesDirectory(fromFile), r.storefrontResources} {
+			if resources == "" {
+				continue
+			}
+			modules := filepath.Join(resources, "app", "storefront", "node_modules", alias)
+			if isDirectory(modules) {
+				return filepath.Join(modules, rest), true
+			}
+		}
+		return "", false
+	case filepath.IsAbs(importPath):
+		return importPath, true
+	}
+
+	return filepath.Join(filepath.Dir(fromFile), importPath), true
+}
+
+// aliases returns the ~alias directories of a file: the resolve mappings in the style array of its own
+// theme.json take precedence over the ones of the Storefront and the Storefront build defaults
+func (r *Resolver) aliases(fromFile string) map[string]string {
+	aliases := make(map[string]string)
+
+	if r.storefrontResources != "" {
+		for alias, dir := range defaultAliases {
+			aliases[alias] = filepath.Join(r.storefrontResources, dir)
+		}
+	}
+
+	for _, resources := range []string{r.storefrontResources, resourcesDirectory(fromFile)} {
+		if resources == "" {
+			continue
+		}
+
+		entries, err := theme.ReadStyleEntries(filepath.Join(resources, "theme.json"))
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			for alias, dir := range entry.Resolve {
+				aliases[alias] = filepath.Join(resources, dir)
+			}
+		}
+	}
+
+	return aliases
+}
+
+// resourcesDirectory returns the Resources directory a file is located in, or an empty string
+func resourcesDirectory(path string) string {
+	index := strings.LastIndex(path, string(filepath.Separator)+"Resources"+string(filepath.Separator))
+	if index == -1 {
+		return ""
+	}
+	return path[:index+len("/Resources")]
+}
+
+// resolveFile returns the stylesheet for an import path: the file itself, the file with .scss or .css
+// extension, its partial or the index file of a directory. It returns an empty string if none exists.
+func resolveFile(path string) string {
+	dir, name := filepath.Split(path)
+
+	var candidates []string
+	switch filepath.Ext(name) {
+	case ".scss", ".sass", ".css":
+		candidates = []string{path, filepath.Join(dir, "_"+name)}
+	default:
+		candidates = []string{
+			path + ".scss",
+			filepath.Join(dir, "_"+name+".scss"),
+			path + ".css",
+			filepath.Join(path, "_index.scss"),
+			filepath.Join(path, "index.scss"),
+		}
+	}
+
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+	}
+
+	return ""
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,111 @@
+package scss
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for path, content := range files {
+		fullPath := filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+		require.NoError(t, os.WriteFile(fullPath, []byte(content), 0o644))
+	}
+}
+
+func TestParseImports(t *testing.T) {
+	code := `@use 'sass:math';
+@use "~scss/variables" as vars;
+// @import 'commented';
+@import 'abstract/functions', "abstract/mixins";
+@import url("https://fonts.example/font.css");
+@import url("print.css");
+@forward '@MyTheme/scss/base';
+@use "~scss/config" with ($primary: red);
+.card { @import "components/card"; }
+`
+	root := parseScss(t, code)
+	imports := ParseImports(root, []byte(code))
+
+	var paths []string
+	for _, imp := range imports {
+		paths = append(paths, imp.Directive+":"+imp.Path)
+	}
+	assert.Equal(t, []string{"use:~scss/variables", "import:abstract/functions", "import:abstract/mixins", "forward:@MyTheme/scss/base", "use:~scss/config", "import:components/card"}, paths)
+	assert.Equal(t, "abstract/functions", code[imports[1].Offset:imports[1].Offset+len(imports[1].Path)])
+
+	offset := uint(strings.Index(code, "abstract/mixins"))
+	imp := ImportAt(root.NamedDescendantForByteRange(offset, offset), []byte(code))
+	require.NotNil(t, imp)
+	assert.Equal(t, "abstract/mixins", imp.Path)
+
+	offset = uint(strings.Index(code, "print.css"))
+	assert.Nil(t, ImportAt(root.NamedDescendantForByteRange(offset, offset), []byte(code)))
+}
+
+func TestResolver(t *testing.T) {
+	root := t.TempDir()
+	storefront := "vendor/shopware/storefront/Resources/"
+	plugin := "custom/plugins/MyTheme/src/Resources/"
+
+	writeFiles(t, root, map[string]string{
+		storefront + "theme.json":                                      `{"style": [{"app/storefront/src/scss/base.scss": {"resolve": {"vendor": "app/storefront/vendor"}}}, "@Plugins"]}`,
+		storefront + "app/storefront/src/scss/_variables.scss":         "",
+		storefront + "app/storefront/src/scss/abstract/_index.scss":    "",
+		storefront + "app/storefront/vendor/bootstrap/scss/_grid.scss": "",
+		plugin + "theme.json":                                          `{"style": [{"app/storefront/src/scss/base.scss": {"resolve": {"theme-vendor": "app/storefront/vendor"}}}]}`,
+		plugin + "app/storefront/src/scss/base.scss":                   "",
+		plugin + "app/storefront/src/scss/components/_card.scss":       "",
+		plugin + "app/storefront/src/scss/print.css":                   "",
+		plugin + "app/storefront/vendor/slider/slider.scss":            "",
+	})
+
+	resolver := NewResolver(root, []extension.ShopwareExtension{
+		{Name: "MyTheme", Type: extension.ShopwareExtensionTypeBundle, Path: filepath.Join(root, "custom/plugins/MyTheme/src/MyTheme.php")},
+	})
+
+	fromFile := filepath.Join(root, plugin, "app/storefront/src/scss/base.scss")
+
+	tests := []struct {
+		importPath string
+		expected   string
+		judged     bool
+	}{
+		{"components/card", plugin + "app/storefront/src/scss/components/_card.scss", true},
+		{"./components/_card.scss", plugin + "app/storefront/src/scss/components/_card.scss", true},
+		{"print", plugin + "app/storefront/src/scss/print.css", true},
+		{"~scss/variables", storefront + "app/storefront/src/scss/_variables.scss", true},
+		{"~scss/abstract", storefront + "app/storefront/src/scss/abstract/_index.scss", true},
+		{"~vendor/bootstrap/scss/grid", storefront + "app/storefront/vendor/bootstrap/scss/_grid.scss", true},
+		{"~theme-vendor/slider/slider", plugin + "app/storefront/vendor/slider/slider.scss", true},
+		{"@Storefront/scss/variables", storefront + "app/storefront/src/scss/_variables.scss", true},
+		{"@MyTheme/scss/base", plugin + "app/storefront/src/scss/base.scss", true},
+		{"components/missing", "", true},
+		{"@UnknownBundle/scss/base", "", true},
+		{"~tiny-slider/src/tiny-slider", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			resolved, judged := resolver.Resolve(tt.importPath, fromFile)
+			assert.Equal(t, tt.judged, judged)
+			if tt.expected == "" {
+				assert.Empty(t, resolved)
+			} else {
+				assert.Equal(t, filepath.Join(root, tt.expected), resolved)
+			}
+		})
+	}
+
+	dir, ok := resolver.ResolveDirectory("~scss/", fromFile)
+	require.True(t, ok)
+	assert.Equal(t, filepath.Join(root, storefront, "app/storefront/src/scss"), dir)
+
+	assert.Equal(t, []string{"@MyTheme/", "@Storefront/", "~scss/", "~src/", "~theme-vendor/", "~vendor/"}, resolver.Aliases(fromFile))
+}
@@ -0,0 +1,205 @@
+package scss
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/indexer"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// StorefrontPath is the path segment of Storefront sources, including the Bootstrap copy in its vendor folder
+const StorefrontPath = "Resources/app/storefront/"
+
+// coreVariablesKey is the key of Storefront files declaring variables in the core index
+const coreVariablesKey = "variables"
+
+type ScssIndexer struct {
+	dataIndexer *indexer.DataIndexer[Symbol]
+
+	// coreIndex stores the files of the Storefront bundle declaring variables
+	coreIndex *indexer.DataIndexer[string]
+}
+
+func NewScssIndexer(configDir string) (*ScssIndexer, error) {
+	dataIndexer, err := indexer.NewDataIndexer[Symbol](filepath.Join(configDir, "scss_symbol.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	coreIndex, err := indexer.NewDataIndexer[string](filepath.Join(configDir, "scss_core.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScssIndexer{
+		dataIndexer: dataIndexer,
+		coreIndex:   coreIndex,
+	}, nil
+}
+
+func (i *ScssIndexer) ID() string {
+	return "scss.indexer"
+}
+
+func (i *ScssIndexer) Index(path string, node *tree_sitter.Node, fileContent []byte) error {
+	if filepath.Ext(path) != ".scss" || !strings.Contains(path, StorefrontPath) {
+		return nil
+	}
+
+	// Always save, so symbols removed from the file are removed from the index
+	batch := map[string]map[string]Symbol{
+		path: make(map[string]Symbol),
+	}
+	hasVariables := false
+	for _, symbol := range ParseSymbols(node, fileContent, path) {
+		key := symbolKey(symbol.Kind, symbol.Name)
+		if _, exists := batch[path][key]; !exists {
+			batch[path][key] = symbol
+		}
+		hasVariables = hasVariables || symbol.Kind == SymbolVariable
+	}
+
+	if err := i.dataIndexer.BatchSaveItems(batch); err != nil {
+		return fmt.Errorf("saving scss symbols: %w", err)
+	}
+
+	if isStorefrontFile(path) {
+		return i.indexCoreFile(path, hasVariables)
+	}
+
+	return nil
+}
+
+// indexCoreFile stores if a file of the Storefront bundle declares variables,
+// so checking for them doesn't need to load all symbols
+func (i *ScssIndexer) indexCoreFile(path string, hasVariables bool) error {
+	batch := map[string]map[string]string{
+		path: make(map[string]string),
+	}
+	if hasVariables {
+		batch[path][coreVariablesKey] = path
+	}
+
+	if err := i.coreIndex.BatchSaveItems(batch); err != nil {
+		return fmt.Errorf("saving scss core file: %w", err)
+	}
+
+	return nil
+}
+
+func (i *ScssIndexer) RemovedFiles(paths []string) error {
+	if err := i.dataIndexer.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+	return i.coreIndex.BatchDeleteByFilePaths(paths)
+}
+
+func (i *ScssIndexer) Close() error {
+	if err := i.dataIndexer.Close(); err != nil {
+		return err
+	}
+	return i.coreIndex.Close()
+}
+
+func (i *ScssIndexer) Clear() error {
+	if err := i.dataIndexer.Clear(); err != nil {
+		return err
+	}
+	return i.coreIndex.Clear()
+}
+
+// GetSymbols returns all declarations of a symbol, Storefront and Bootstrap declarations first
+func (i *ScssIndexer) GetSymbols(kind SymbolKind, name string) ([]Symbol, error) {
+	symbols, err := i.dataIndexer.GetValues(symbolKey(kind, name))
+	if err != nil {
+		return nil, err
+	}
+
+	sortSymbols(symbols)
+
+	return symbols, nil
+}
+
+// GetAllSymbols returns all declarations of the given kind sorted by name
+func (i *ScssIndexer) GetAllSymbols(kind SymbolKind) ([]Symbol, error) {
+	values, err := i.dataIndexer.GetAllValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var symbols []Symbol
+	for _, symbol := range values {
+		if symbol.Kind == kind {
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	sortSymbols(symbols)
+
+	return symbols, nil
+}
+
+// HasCoreVariables checks if the variables of the Storefront bundle itself are indexed. Without them most
+// variables used by plugins are unknown, so diagnostics should not report them.
+func (i *ScssIndexer) HasCoreVariables() bool {
+	files, err := i.coreIndex.GetValues(coreVariablesKey)
+	return err == nil && len(files) > 0
+}
+
+// SaveSymbol saves a symbol (primarily for testing)
+func (i *ScssIndexer) SaveSymbol(symbol Symbol) error {
+	if err := i.dataIndexer.BatchSaveItems(map[string]map[string]Symbol{
+		symbol.FilePath: {symbolKey(symbol.Kind, symbol.Name): symbol},
+	}); err != nil {
+		return err
+	}
+
+	if !isStorefrontFile(symbol.FilePath) {
+		return nil
+	}
+	return i.indexCoreFile(symbol.FilePath, symbol.Kind == SymbolVariable)
+}
+
+// IsPluginFile checks if a SCSS file belongs to a plugin or app instead of the Storefront bundle itself
+func IsPluginFile(path string) bool {
+	return strings.Contains(path, StorefrontPath) && !isStorefrontFile(path)
+}
+
+// isStorefrontFile checks if a file belongs to the Storefront bundle or its Bootstrap copy
+func isStorefrontFile(path string) bool {
+	return strings.Contains(path, "src/Storefront/"+StorefrontPath) ||
+		strings.Contains(path, "vendor/shopware/storefront/"+StorefrontPath)
+}
+
+func symbolKey(kind SymbolKind, name string) string {
+	return string(kind) + ":" + name
+}
+
+// sortSymbols sorts symbols by name, Storefront declarations before plugin overrides
+func sortSymbols(symbols []Symbol) {
+	sort.SliceStable(symbols, func(a, b int) bool {
+		if symbols[a].Name != symbols[b].Name {
+			return symbols[a].Name < symbols[b].Name
+		}
+		if storefrontA, storefrontB := isStorefrontFile(symbols[a].FilePath), isStorefrontFile(symbols[b].FilePath); storefrontA != storefrontB {
+			return storefrontA
+		}
+		return symbols[a].FilePath < symbols[b].FilePath
+	})
+}
+
+// compilerVariables are injected by the Shopware theme compiler in addition to the theme.json fields
+var compilerVariables = map[string]bool{
+	"sw-asset-public-url":        true,
+	"sw-asset-theme-url":         true,
+	"sw-asset-asset-url":         true,
+	"sw-asset-sales-channel-url": true,
+}
+
+// IsCompilerVariable checks if a variable is injected by the theme compiler
+func IsCompilerVariable(name string) bool {
+	return compilerVariables[name]
+}
@@ -0,0 +1,179 @@
+package scss
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter_scss "github.com/tree-sitter-grammars/tree-sitter-scss/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseScss(t *testing.T, content string) *tree_sitter.Node {
+	parser := tree_sitter.NewParser()
+	t.Cleanup(func() { parser.Close() })
+
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_scss.Language())))
+
+	tree := parser.Parse([]byte(content), nil)
+	t.Cleanup(func() { tree.Close() })
+
+	return tree.RootNode()
+}
+
+const bootstrapVariables = `// Variables
+$white:    #fff !default;
+$gray-100: #f8f9fa !default; // comment
+$grid-breakpoints: (
+  xs: 0,
+  sm: 576px
+) !default;
+$enable-shadows: false;
+
+/* block
+   $commented: 1; */
+
+@mixin button-variant($background, $border: darken($background, 5%), $hover-background: null) {
+  color: $background;
+  $local: 1px;
+  border-width: $local;
+}
+
+@function tint-color($color, $weight) {
+  @return mix($white, $color, $weight);
+}
+
+%btn-base {
+  display: inline-block;
+}
+
+.btn {
+  width: 100%;
+  $scoped: 2px !global;
+  @include button-variant($white, $border: $gray-100);
+  @extend %btn-base;
+  padding: #{$spacer * .5};
+  &:hover { color: math.div($a, 2); }
+
+  @each $breakpoint, $size in $grid-breakpoints {
+    margin: $size;
+  }
+}
+`
+
+func TestParseSymbols(t *testing.T) {
+	symbols := ParseSymbols(parseScss(t, bootstrapVariables), []byte(bootstrapVariables), "/bootstrap/_variables.scss")
+
+	byLabel := make(map[string]Symbol)
+	for _, symbol := range symbols {
+		byLabel[string(symbol.Kind)+":"+symbol.Label()] = symbol
+	}
+
+	require.Contains(t, byLabel, "variable:$white")
+	assert.Equal(t, "#fff", byLabel["variable:$white"].Value)
+	assert.True(t, byLabel["variable:$white"].Default)
+	assert.Equal(t, 2, byLabel["variable:$white"].Line)
+
+	assert.Equal(t, "#f8f9fa", byLabel["variable:$gray-100"].Value)
+	assert.Equal(t, "(\n  xs: 0,\n  sm: 576px\n)", byLabel["variable:$grid-breakpoints"].Value)
+	assert.False(t, byLabel["variable:$enable-shadows"].Default)
+	assert.Contains(t, byLabel, "variable:$scoped")
+	assert.NotContains(t, byLabel, "variable:$commented")
+	assert.NotContains(t, byLabel, "variable:$local")
+	assert.NotContains(t, byLabel, "variable:$border")
+
+	require.Contains(t, byLabel, "mixin:button-variant")
+	assert.Equal(t, "($background, $border: darken($background, 5%), $hover-background: null)", byLabel["mixin:button-variant"].Value)
+	assert.Equal(t, 13, byLabel["mixin:button-variant"].Line)
+	assert.Equal(t, "($color, $weight)", byLabel["function:tint-color"].Value)
+	assert.Equal(t, 23, byLabel["placeholder:%btn-base"].Line)
+	assert.Len(t, symbols, 8)
+}
+
+func TestParseReferences(t *testing.T) {
+	root := parseScss(t, bootstrapVariables)
+	references := ParseReferences(root, []byte(bootstrapVariables))
+
+	var used, declared []string
+	for _, reference := range references {
+		label := Sigil(reference.Kind) + reference.Name
+		if reference.Declaration {
+			declared = append(declared, label)
+		} else {
+			used = append(used, label)
+		}
+	}
+
+	assert.Contains(t, used, "$spacer")
+	assert.Contains(t, used, "$local")
+	assert.Contains(t, used, "$grid-breakpoints")
+	assert.Contains(t, used, "button-variant")
+	assert.Contains(t, used, "%btn-base")
+	assert.Contains(t, used, "darken")
+	assert.Contains(t, used, "$a", "arguments of namespaced functions are still references")
+	assert.NotContains(t, used, "div")
+	assert.NotContains(t, used, "$commented")
+
+	for _, name := range []string{"$background", "$border", "$hover-background", "$local", "$color", "$breakpoint", "$size", "button-variant", "%btn-base"} {
+		assert.Contains(t, declared, name)
+	}
+
+	offset := uint(strings.Index(bootstrapVariables, "$spacer") + 3)
+	reference := ReferenceAt(root.NamedDescendantForByteRange(offset, offset), []byte(bootstrapVariables))
+	require.NotNil(t, reference)
+	assert.Equal(t, "spacer", reference.Name)
+	assert.Equal(t, SymbolVariable, reference.Kind)
+
+	spacerStart := strings.Index(bootstrapVariables, "$spacer")
+	spacerLine := uint(strings.Count(bootstrapVariables[:spacerStart], "\n"))
+	spacerColumn := uint(spacerStart - strings.LastIndex(bootstrapVariables[:spacerStart], "\n") - 1)
+	assert.Equal(t, tree_sitter.Point{Row: spacerLine, Column: spacerColumn}, reference.Start)
+	assert.Equal(t, tree_sitter.Point{Row: spacerLine, Column: spacerColumn + uint(len("$spacer"))}, reference.End)
+
+	offset = uint(strings.Index(bootstrapVariables, "@extend %btn") + 10)
+	reference = ReferenceAt(root.NamedDescendantForByteRange(offset, offset), []byte(bootstrapVariables))
+	require.NotNil(t, reference)
+	assert.Equal(t, SymbolPlaceholder, reference.Kind)
+}
+
+func TestScssIndexer(t *testing.T) {
+	indexer, err := NewScssIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	corePath := "/project/vendor/shopware/storefront/Resources/app/storefront/vendor/bootstrap/scss/_variables.scss"
+	pluginPath := "/project/custom/plugins/MyTheme/src/Resources/app/storefront/src/scss/overrides.scss"
+
+	index := func(path, content string) {
+		require.NoError(t, indexer.Index(path, parseScss(t, content), []byte(content)))
+	}
+
+	index(corePath, bootstrapVariables)
+	index(pluginPath, "$white: #fefefe;\n")
+	index("/project/custom/plugins/MyTheme/src/Resources/app/administration/src/main.scss", "$admin: 1;")
+
+	symbols, err := indexer.GetSymbols(SymbolVariable, "white")
+	require.NoError(t, err)
+	require.Len(t, symbols, 2)
+	assert.Equal(t, corePath, symbols[0].FilePath)
+	assert.Equal(t, pluginPath, symbols[1].FilePath)
+
+	admin, err := indexer.GetSymbols(SymbolVariable, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, admin)
+
+	mixins, err := indexer.GetAllSymbols(SymbolMixin)
+	require.NoError(t, err)
+	require.Len(t, mixins, 1)
+	assert.True(t, indexer.HasCoreVariables())
+
+	assert.True(t, IsPluginFile(pluginPath))
+	assert.False(t, IsPluginFile(corePath))
+
+	// Reindexing a file without declarations removes its symbols
+	index(pluginPath, "")
+	symbols, err = indexer.GetSymbols(SymbolVariable, "white")
+	require.NoError(t, err)
+	assert.Len(t, symbols, 1)
+}
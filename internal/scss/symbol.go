@@ -0,0 +1,301 @@
+package scss
+
+import (
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// SymbolKind is the kind of a SCSS symbol
+type SymbolKind string
+
+const (
+	SymbolVariable    SymbolKind = "variable"
+	SymbolMixin       SymbolKind = "mixin"
+	SymbolFunction    SymbolKind = "function"
+	SymbolPlaceholder SymbolKind = "placeholder"
+)
+
+// Symbol is a SCSS variable, mixin, function or placeholder selector declaration
+type Symbol struct {
+	// Name is the symbol name without the $ or % sigil
+	Name string
+
+	// Kind is the symbol kind
+	Kind SymbolKind
+
+	// Value is the assigned value of a variable without flags, or the parameter list of a mixin or function
+	Value string
+
+	// Default indicates a variable declared with !default
+	Default bool
+
+	// FilePath is the absolute path to the declaring file
+	FilePath string
+
+	// Line is the line number of the declaration (1-based)
+	Line int
+}
+
+// Label returns the name as written in SCSS ($name for variables, %name for placeholders)
+func (s Symbol) Label() string {
+	return Sigil(s.Kind) + s.Name
+}
+
+// Sigil returns the prefix of a symbol kind in SCSS source
+func Sigil(kind SymbolKind) string {
+	switch kind {
+	case SymbolVariable:
+		return "$"
+	case SymbolPlaceholder:
+		return "%"
+	}
+	return ""
+}
+
+// Reference is a usage or declaration of a SCSS symbol in a file
+type Reference struct {
+	// Name is the symbol name without sigil
+	Name string
+
+	// Kind is the kind of the referenced symbol
+	Kind SymbolKind
+
+	// Start and End are the points of the reference including the sigil
+	Start tree_sitter.Point
+	End   tree_sitter.Point
+
+	// Declaration indicates the reference declares the symbol, including local variables, parameters and loop variables
+	Declaration bool
+}
+
+// ParseSymbols returns the global symbols declared in a SCSS file: top-level and !global variables,
+// mixins, functions and placeholder selectors
+func ParseSymbols(root *tree_sitter.Node, content []byte, filePath string) []Symbol {
+	var symbols []Symbol
+
+	for _, node := range treesitterhelper.FindAll(root, symbolPattern, content) {
+		line := int(node.StartPosition().Row) + 1
+
+		switch node.Kind() {
+		case "declaration":
+			value, isDefault, isGlobal := variableValue(node, content)
+			if node.Parent().Kind() != "stylesheet" && !isGlobal {
+				continue
+			}
+
+			symbols = append(symbols, Symbol{
+				Name:     strings.TrimPrefix(node.NamedChild(0).Utf8Text(content), "$"),
+				Kind:     SymbolVariable,
+				Value:    value,
+				Default:  isDefault,
+				FilePath: filePath,
+				Line:     line,
+			})
+		case "mixin_statement", "function_statement":
+			name := node.ChildByFieldName("name")
+			if name == nil {
+				continue
+			}
+
+			symbol := Symbol{Name: name.Utf8Text(content), Kind: SymbolMixin, FilePath: filePath, Line: int(name.StartPosition().Row) + 1}
+			if node.Kind() == "function_statement" {
+				symbol.Kind = SymbolFunction
+			}
+			if parameters := firstChildOfKind(node, "parameters"); parameters != nil {
+				symbol.Value = parameters.Utf8Text(content)
+			}
+
+			symbols = append(symbols, symbol)
+		case "placeholder":
+			if name := firstChildOfKind(node, "identifier"); name != nil {
+				symbols = append(symbols, Symbol{Name: name.Utf8Text(content), Kind: SymbolPlaceholder, FilePath: filePath, Line: line})
+			}
+		}
+	}
+
+	return symbols
+}
+
+// ParseReferences returns all references and declarations of SCSS symbols in a file. Namespaced
+// references of @use modules (math.div, colors.$primary) are skipped.
+func ParseReferences(root *tree_sitter.Node, content []byte) []Reference {
+	var references []Reference
+
+	for _, node := range treesitterhelper.FindAll(root, referencePattern, content) {
+		if reference, ok := referenceOf(node, content); ok {
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
+
+// ReferenceAt returns the reference of the node at the cursor, or nil if there is none
+func ReferenceAt(node *tree_sitter.Node, content []byte) *Reference {
+	// The cursor node is the reference itself or the identifier of a placeholder
+	for i := 0; i < 2 && node != nil; i++ {
+		if reference, ok := referenceOf(node, content); ok {
+			return &reference
+		}
+		node = node.Parent()
+	}
+
+	return nil
+}
+
+var (
+	// symbolPattern matches the declarations of variables, mixins, functions and placeholder selectors
+	symbolPattern = treesitterhelper.Or(
+		treesitterhelper.FuncPattern(isVariableDeclaration),
+		treesitterhelper.AnyNodeKind("mixin_statement", "function_statement"),
+		treesitterhelper.And(treesitterhelper.NodeKind("placeholder"), treesitterhelper.FuncPattern(isPlaceholderDeclaration)),
+	)
+
+	// referencePattern matches the nodes referencing or declaring a symbol
+	referencePattern = treesitterhelper.AnyNodeKind("variable", "property_name", "placeholder", "identifier", "function_name")
+)
+
+// referenceOf returns the reference a node is, or false if the node does not reference a symbol
+func referenceOf(node *tree_sitter.Node, content []byte) (Reference, bool) {
+	parent := node.Parent()
+	if parent == nil {
+		return Reference{}, false
+	}
+
+	text := node.Utf8Text(content)
+	start, end := node.StartPosition(), node.EndPosition()
+
+	switch node.Kind() {
+	case "variable":
+		// Keyword arguments name a parameter of the called mixin or function
+		if parent.Kind() == "argument" && isField(parent, "name", node) {
+			return Reference{}, false
+		}
+		return Reference{Name: strings.TrimPrefix(text, "$"), Kind: SymbolVariable, Start: start, End: end, Declaration: declaresVariable(node)}, true
+	case "property_name":
+		if !strings.HasPrefix(text, "$") {
+			return Reference{}, false
+		}
+		return Reference{Name: text[1:], Kind: SymbolVariable, Start: start, End: end, Declaration: true}, true
+	case "placeholder":
+		name := firstChildOfKind(node, "identifier")
+		if name == nil {
+			return Reference{}, false
+		}
+		return Reference{Name: name.Utf8Text(content), Kind: SymbolPlaceholder, Start: start, End: end, Declaration: isPlaceholderDeclaration(node, content)}, true
+	case "identifier":
+		switch parent.Kind() {
+		case "mixin_statement", "function_statement":
+			if !isField(parent, "name", node) {
+				return Reference{}, false
+			}
+			kind := SymbolMixin
+			if parent.Kind() == "function_statement" {
+				kind = SymbolFunction
+			}
+			return Reference{Name: text, Kind: kind, Start: start, End: end, Declaration: true}, true
+		case "include_statement":
+			// The module of a namespaced include like bs.button is followed by the mixin name
+			if end := int(node.EndByte()); end < len(content) && content[end] == '.' {
+				return Reference{}, false
+			}
+			return Reference{Name: text, Kind: SymbolMixin, Start: start, End: end}, true
+		}
+	case "function_name":
+		if strings.Contains(text, ".") {
+			return Reference{}, false
+		}
+		return Reference{Name: text, Kind: SymbolFunction, Start: start, End: end}, true
+	}
+
+	return Reference{}, false
+}
+
+// declaresVariable checks if a variable node declares a parameter or loop variable
+func declaresVariable(node *tree_sitter.Node) bool {
+	parent := node.Parent()
+
+	switch parent.Kind() {
+	case "parameter":
+		return !isField(parent, "default", node)
+	case "each_statement":
+		return isField(parent, "key", node) || isField(parent, "value", node)
+	case "for_statement":
+		return !isField(parent, "from", node) && !isField(parent, "through", node) && !isField(parent, "to", node)
+	}
+
+	return false
+}
+
+// isVariableDeclaration checks if a node is the declaration of a variable
+func isVariableDeclaration(node *tree_sitter.Node, content []byte) bool {
+	if node.Kind() != "declaration" {
+		return false
+	}
+
+	property := node.NamedChild(0)
+	return property != nil && property.Kind() == "property_name" && strings.HasPrefix(property.Utf8Text(content), "$")
+}
+
+// isPlaceholderDeclaration checks if a placeholder is part of the selectors of a rule set, other placeholders
+// are the targets of @extend
+func isPlaceholderDeclaration(node *tree_sitter.Node, content []byte) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Kind() {
+		case "selectors":
+			return true
+		case "block", "stylesheet", "extend_statement":
+			return false
+		}
+	}
+
+	return false
+}
+
+// variableValue returns the value of a variable declaration and its flags. The grammar does not know the
+// !default and !global flags, so they end up in an ERROR node after the value.
+func variableValue(declaration *tree_sitter.Node, content []byte) (value string, isDefault bool, isGlobal bool) {
+	start, end := -1, -1
+
+	for i := uint(1); i < declaration.NamedChildCount(); i++ {
+		child := declaration.NamedChild(i)
+		text := child.Utf8Text(content)
+
+		switch {
+		case child.IsError() && strings.HasPrefix(text, "!"):
+			isDefault = isDefault || strings.Contains(text, "!default")
+			isGlobal = isGlobal || strings.Contains(text, "!global")
+		case child.Kind() == "comment" || child.Kind() == "js_comment":
+		default:
+			if start == -1 {
+				start = int(child.StartByte())
+			}
+			end = int(child.EndByte())
+		}
+	}
+
+	if start == -1 {
+		return "", isDefault, isGlobal
+	}
+
+	return string(content[start:end]), isDefault, isGlobal
+}
+
+// isField checks if the child is the given field of the node
+func isField(node *tree_sitter.Node, field string, child *tree_sitter.Node) bool {
+	value := node.ChildByFieldName(field)
+	return value != nil && value.Id() == child.Id()
+}
+
+// firstChildOfKind returns the first named child of the given kind
+func firstChildOfKind(node *tree_sitter.Node, kind string) *tree_sitter.Node {
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if child := node.NamedChild(i); child.Kind() == kind {
+			return child
+		}
+	}
+
+	return nil
+}
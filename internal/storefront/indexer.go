@@ -0,0 +1,191 @@
+package storefront
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/indexer"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type StorefrontPluginIndexer struct {
+	pluginIndex *indexer.DataIndexer[StorefrontPlugin]
+	classIndex  *indexer.DataIndexer[PluginClass]
+}
+
+func NewStorefrontPluginIndexer(configDir string) (*StorefrontPluginIndexer, error) {
+	pluginIndex, err := indexer.NewDataIndexer[StorefrontPlugin](filepath.Join(configDir, "storefront_plugin.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	classIndex, err := indexer.NewDataIndexer[PluginClass](filepath.Join(configDir, "storefront_plugin_class.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorefrontPluginIndexer{
+		pluginIndex: pluginIndex,
+		classIndex:  classIndex,
+	}, nil
+}
+
+func (i *StorefrontPluginIndexer) ID() string {
+	return "storefront.plugin.indexer"
+}
+
+func (i *StorefrontPluginIndexer) Index(path string, node *tree_sitter.Node, fileContent []byte) error {
+	if !admin.IsScriptFile(filepath.Ext(path)) || !strings.Contains(path, "Resources/app/storefront/") {
+		return nil
+	}
+
+	var plugins []StorefrontPlugin
+	if strings.Contains(string(fileContent), "PluginManager") {
+		plugins = parsePluginRegistrations(node, fileContent, path)
+	}
+
+	var classes []PluginClass
+	if strings.Contains(string(fileContent), "options") {
+		classes = parsePluginClasses(node, fileContent, path)
+	}
+
+	// Always save, so registrations and classes removed from the file are removed from the index
+	pluginBatch := map[string]map[string]StorefrontPlugin{
+		path: make(map[string]StorefrontPlugin),
+	}
+	for _, plugin := range plugins {
+		if _, exists := pluginBatch[path][plugin.Name]; !exists {
+			pluginBatch[path][plugin.Name] = plugin
+		}
+	}
+
+	if err := i.pluginIndex.BatchSaveItems(pluginBatch); err != nil {
+		return fmt.Errorf("saving storefront plugins: %w", err)
+	}
+
+	// Classes are looked up by the import path of the register call, the first class of a file is its export
+	classBatch := map[string]map[string]PluginClass{
+		path: make(map[string]PluginClass),
+	}
+	if len(classes) > 0 {
+		classBatch[path][normalizePath(path)] = classes[0]
+	}
+
+	if err := i.classIndex.BatchSaveItems(classBatch); err != nil {
+		return fmt.Errorf("saving storefront plugin classes: %w", err)
+	}
+
+	return nil
+}
+
+func (i *StorefrontPluginIndexer) RemovedFiles(paths []string) error {
+	if err := i.pluginIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return fmt.Errorf("removing storefront plugins: %w", err)
+	}
+
+	if err := i.classIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return fmt.Errorf("removing storefront plugin classes: %w", err)
+	}
+
+	return nil
+}
+
+func (i *StorefrontPluginIndexer) Close() error {
+	if err := i.pluginIndex.Close(); err != nil {
+		return err
+	}
+	return i.classIndex.Close()
+}
+
+func (i *StorefrontPluginIndexer) Clear() error {
+	if err := i.pluginIndex.Clear(); err != nil {
+		return err
+	}
+	return i.classIndex.Clear()
+}
+
+// GetPlugin returns all registrations and overrides of a plugin
+func (i *StorefrontPluginIndexer) GetPlugin(name string) ([]StorefrontPlugin, error) {
+	return i.pluginIndex.GetValues(name)
+}
+
+// GetAllPlugins returns all plugin registrations and overrides
+func (i *StorefrontPluginIndexer) GetAllPlugins() ([]StorefrontPlugin, error) {
+	return i.pluginIndex.GetAllValues()
+}
+
+// GetPluginClass returns the class of a plugin registration, or nil if it is not indexed
+func (i *StorefrontPluginIndexer) GetPluginClass(plugin StorefrontPlugin) *PluginClass {
+	if plugin.ClassPath == "" {
+		return nil
+	}
+
+	classes, err := i.classIndex.GetValues(plugin.ClassPath)
+	if err != nil || len(classes) == 0 {
+		return nil
+	}
+
+	return &classes[0]
+}
+
+// GetPluginOptions returns the options of all classes registered for a plugin, overrides first
+func (i *StorefrontPluginIndexer) GetPluginOptions(name string) ([]PluginOption, error) {
+	plugins, err := i.GetPlugin(name)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(plugins, func(a, b StorefrontPlugin) int {
+		if a.Override == b.Override {
+			return 0
+		}
+		if a.Override {
+			return -1
+		}
+		return 1
+	})
+
+	var options []PluginOption
+	for _, plugin := range plugins {
+		class := i.GetPluginClass(plugin)
+		if class == nil {
+			continue
+		}
+
+		for _, option := range class.Options {
+			if !slices.ContainsFunc(options, func(o PluginOption) bool { return o.Name == option.Name }) {
+				options = append(options, option)
+			}
+		}
+	}
+
+	return options, nil
+}
+
+// GetPluginsByAttribute returns the plugins using the data attribute in their selector or reading their
+// options from it
+func (i *StorefrontPluginIndexer) GetPluginsByAttribute(attribute string) ([]StorefrontPlugin, error) {
+	plugins, err := i.pluginIndex.GetAllValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []StorefrontPlugin
+	for _, plugin := range plugins {
+		if slices.Contains(plugin.Attributes(), attribute) {
+			result = append(result, plugin)
+		}
+	}
+
+	return result, nil
+}
+
+// SavePlugin saves a plugin registration (primarily for testing)
+func (i *StorefrontPluginIndexer) SavePlugin(plugin StorefrontPlugin) error {
+	return i.pluginIndex.BatchSaveItems(map[string]map[string]StorefrontPlugin{
+		plugin.FilePath: {plugin.Name: plugin},
+	})
+}
@@ -0,0 +1,195 @@
+package storefront
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_javascript "github.com/tree-sitter/tree-sitter-javascript/bindings/go"
+	tree_sitter_typescript "github.com/tree-sitter/tree-sitter-typescript/bindings/go"
+)
+
+const storefrontSrc = "/project/src/Storefront/Resources/app/storefront/src"
+
+func parse(t *testing.T, filePath, code string) *tree_sitter.Tree {
+	language := tree_sitter.NewLanguage(tree_sitter_javascript.Language())
+	if filepath.Ext(filePath) == ".ts" {
+		language = tree_sitter.NewLanguage(tree_sitter_typescript.LanguageTypescript())
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(language))
+	return parser.Parse([]byte(code), nil)
+}
+
+func indexFiles(t *testing.T, indexer *StorefrontPluginIndexer, files map[string]string) {
+	for filePath, code := range files {
+		tree := parse(t, filePath, code)
+		require.NoError(t, indexer.Index(filePath, tree.RootNode(), []byte(code)))
+		tree.Close()
+	}
+}
+
+func TestStorefrontPluginIndexer(t *testing.T) {
+	indexer, err := NewStorefrontPluginIndexer(t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = indexer.Close() }()
+
+	mainPath := storefrontSrc + "/main.js"
+	indexFiles(t, indexer, map[string]string{
+		mainPath: `
+import OffCanvasCartPlugin from './plugin/offcanvas-cart/offcanvas-cart.plugin';
+
+const PluginManager = window.PluginManager;
+PluginManager.register('OffCanvasCart', OffCanvasCartPlugin, '[data-off-canvas-cart]');
+window.PluginManager.register('AddToCart', () => import('src/plugin/add-to-cart/add-to-cart.plugin'), '[data-add-to-cart], .buy-widget');
+`,
+		storefrontSrc + "/plugin/offcanvas-cart/offcanvas-cart.plugin.js": `
+import Plugin from 'src/plugin-system/plugin.class';
+
+export default class OffCanvasCartPlugin extends Plugin {
+    static options = {
+        /**
+         * selector of the remove button
+         */
+        removeProductTriggerSelector: '.js-offcanvas-cart-remove-product',
+        'cartItemSelector': '.line-item',
+    };
+}
+`,
+		storefrontSrc + "/plugin/add-to-cart/add-to-cart.plugin.ts": `
+export default class AddToCartPlugin extends Plugin {
+    static options: AddToCartOptions = deepmerge(Plugin.options, {
+        redirectTo: 'frontend.cart.offcanvas',
+    });
+}
+`,
+		"/project/custom/plugins/MyTheme/src/Resources/app/storefront/src/main.js": `
+import MyCartPlugin from './my-cart.plugin';
+PluginManager.override('OffCanvasCart', MyCartPlugin, '[data-off-canvas-cart]');
+`,
+		"/project/custom/plugins/MyTheme/src/Resources/app/storefront/src/my-cart.plugin.js": `
+export default class MyCartPlugin extends OffCanvasCartPlugin {
+    static options = {
+        removeProductTriggerSelector: '.my-remove',
+        showConfirm: true,
+    };
+}
+`,
+	})
+
+	plugins, err := indexer.GetPlugin("OffCanvasCart")
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+
+	options, err := indexer.GetPluginOptions("OffCanvasCart")
+	require.NoError(t, err)
+	var names []string
+	for _, option := range options {
+		names = append(names, option.Name)
+	}
+	assert.Equal(t, []string{"removeProductTriggerSelector", "showConfirm", "cartItemSelector"}, names)
+	assert.Equal(t, "'.my-remove'", options[0].Default)
+
+	registrations, err := indexer.GetPlugin("AddToCart")
+	require.NoError(t, err)
+	require.Len(t, registrations, 1)
+	assert.Equal(t, []string{"data-add-to-cart", "data-add-to-cart-options"}, registrations[0].Attributes())
+
+	class := indexer.GetPluginClass(registrations[0])
+	require.NotNil(t, class)
+	assert.Equal(t, "AddToCartPlugin", class.Name)
+	require.Len(t, class.Options, 1)
+	assert.Equal(t, "redirectTo", class.Options[0].Name)
+
+	byAttribute, err := indexer.GetPluginsByAttribute("data-off-canvas-cart-options")
+	require.NoError(t, err)
+	assert.Len(t, byAttribute, 2)
+
+	original := indexer.GetPluginClass(plugins[0])
+	if plugins[0].Override {
+		original = indexer.GetPluginClass(plugins[1])
+	}
+	require.NotNil(t, original)
+	assert.Equal(t, "selector of the remove button", original.Options[0].Description)
+	assert.Equal(t, 4, original.Line)
+
+	// Removing the registration from the file removes it from the index
+	indexFiles(t, indexer, map[string]string{mainPath: `console.log('no plugins');`})
+	registrations, err = indexer.GetPlugin("AddToCart")
+	require.NoError(t, err)
+	assert.Empty(t, registrations)
+}
+
+func TestPluginNameAt(t *testing.T) {
+	code := `
+PluginManager.getPluginInstances('OffCanvasCart');
+window.PluginManager.getPluginInstanceFromElement(el, 'AddToCart');
+someObject.register('NotAPlugin');
+`
+	tree := parse(t, "main.js", code)
+	defer tree.Close()
+
+	nameAt := func(text string) string {
+		offset := uint(strings.Index(code, text))
+		node := PluginNameAt(tree.RootNode().NamedDescendantForByteRange(offset, offset), []byte(code))
+		if node == nil {
+			return ""
+		}
+		return string(node.Utf8Text([]byte(code)))
+	}
+
+	assert.Equal(t, "'OffCanvasCart'", nameAt("OffCanvasCart"))
+	assert.Equal(t, "'AddToCart'", nameAt("AddToCart"))
+	assert.Empty(t, nameAt("NotAPlugin"))
+}
+
+func TestTemplateAttributeAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected TemplateAttribute
+		ok       bool
+	}{
+		{"attribute name", `<div class="cart" data-off`, "\n<p>cart</p>", TemplateAttribute{Name: "data-off"}, true},
+		{"attribute name in closed tag", `<div class="cart" data-off`, "></div>", TemplateAttribute{Name: "data-off"}, true},
+		{"after whitespace", `<div class="cart" `, "", TemplateAttribute{}, true},
+		{"attribute value", `<div data-off-canvas-cart-options='{"remove`, "\n<p>cart</p>", TemplateAttribute{Name: "data-off-canvas-cart-options", Value: `{"remove`, InValue: true}, true},
+		{"closed attribute value", `<div data-off-canvas-cart-options='{"remove`, `"}'></div>`, TemplateAttribute{Name: "data-off-canvas-cart-options", Value: `{"remove`, InValue: true}, true},
+		{"closed tag", `<div data-foo="bar">data-`, "", TemplateAttribute{}, false},
+		{"tag name", `<div`, "", TemplateAttribute{}, false},
+		{"closing bracket in value", `<div title="a > b" data-`, "", TemplateAttribute{Name: "data-"}, true},
+		{"inside block", "{% block page_checkout_cart %}\n    <div class=\"cart\" data-off", ">\n    </div>\n{% endblock %}", TemplateAttribute{Name: "data-off"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte(tt.before + tt.after)
+			tree := parseTwig(content)
+			defer tree.Close()
+
+			attribute, ok := TemplateAttributeAt(tree.RootNode(), content, len(tt.before))
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, attribute)
+		})
+	}
+
+	assert.True(t, OptionKeyAt(`{"remove`))
+	assert.True(t, OptionKeyAt(`{{ { showConfirm: true, `))
+	assert.False(t, OptionKeyAt(`{{ `))
+	assert.False(t, OptionKeyAt(`{"remove": "`))
+	assert.True(t, OptionKeyAt(`{"offcanvas": {"posi`))
+	assert.False(t, OptionKeyAt(`{"remove": 1`))
+
+	pluginName, ok := OptionsPluginName("data-off-canvas-cart-options")
+	assert.True(t, ok)
+	assert.Equal(t, "off-canvas-cart", pluginName)
+	_, ok = OptionsPluginName("data-options")
+	assert.False(t, ok)
+	assert.Equal(t, "off-canvas-cart", DashCase("OffCanvasCart"))
+}
@@ -0,0 +1,391 @@
+package storefront
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// selectorDataAttributeRegex matches the data attributes in a plugin selector like [data-off-canvas-cart] or [data-toggle="modal"]
+var selectorDataAttributeRegex = regexp.MustCompile(`\[\s*(data-[\w-]+)`)
+
+// StorefrontPlugin is a JavaScript plugin registered with PluginManager.register or PluginManager.override
+type StorefrontPlugin struct {
+	// Name is the plugin name (e.g. "OffCanvasCart")
+	Name string
+
+	// Selector is the DOM selector the plugin is initialized on (e.g. "[data-off-canvas-cart]")
+	Selector string
+
+	// Override indicates a PluginManager.override call instead of a registration
+	Override bool
+
+	// ClassName is the name of the plugin class argument, if it is not a dynamic import
+	ClassName string
+
+	// ClassPath is the normalized path of the file defining the plugin class (without extension)
+	ClassPath string
+
+	// FilePath is the absolute path to the file containing the register call
+	FilePath string
+
+	// Line is the line number of the register call (1-based)
+	Line int
+}
+
+// PluginClass is a plugin class with its default options
+type PluginClass struct {
+	// Name is the class name (e.g. "OffCanvasCartPlugin")
+	Name string
+
+	// FilePath is the absolute path to the file defining the class
+	FilePath string
+
+	// Line is the line number of the class declaration (1-based)
+	Line int
+
+	// Options are the keys of `static options`
+	Options []PluginOption
+}
+
+// PluginOption is a key of the `static options` of a plugin class
+type PluginOption struct {
+	// Name is the option key
+	Name string
+
+	// Default is the source of the default value
+	Default string
+
+	// Description is the doc comment in front of the option
+	Description string
+
+	// Line is the line number of the option (1-based)
+	Line int
+}
+
+// DashCase converts a plugin name to the dashed form the plugin system uses for data attributes,
+// like StringHelper.toDashCase (OffCanvasCart -> off-canvas-cart)
+func DashCase(name string) string {
+	var result strings.Builder
+	for i, c := range name {
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 {
+				result.WriteByte('-')
+			}
+			result.WriteRune(c + 'a' - 'A')
+		} else {
+			result.WriteRune(c)
+		}
+	}
+	return result.String()
+}
+
+// OptionsAttribute returns the attribute the plugin reads its options from (e.g. data-off-canvas-cart-options)
+func (p StorefrontPlugin) OptionsAttribute() string {
+	return "data-" + DashCase(p.Name) + "-options"
+}
+
+// SelectorAttributes returns the data attributes used in the selector of the plugin
+func (p StorefrontPlugin) SelectorAttributes() []string {
+	var attributes []string
+	for _, match := range selectorDataAttributeRegex.FindAllStringSubmatch(p.Selector, -1) {
+		attributes = append(attributes, match[1])
+	}
+	return attributes
+}
+
+// Attributes returns all data attributes of the plugin, the selector attributes first
+func (p StorefrontPlugin) Attributes() []string {
+	return append(p.SelectorAttributes(), p.OptionsAttribute())
+}
+
+// parsePluginRegistrations parses all PluginManager.register and PluginManager.override calls of a file
+func parsePluginRegistrations(root *tree_sitter.Node, content []byte, filePath string) []StorefrontPlugin {
+	imports := parseImports(root, content)
+
+	registerCall := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, content []byte) bool {
+		method := pluginManagerMethod(node, content)
+		return method == "register" || method == "override"
+	})
+
+	var plugins []StorefrontPlugin
+	for _, node := range treesitterhelper.FindAll(root, registerCall, content) {
+		method := pluginManagerMethod(node, content)
+
+		args := namedArguments(node.ChildByFieldName("arguments"))
+		if len(args) < 2 || args[0].Kind() != "string" {
+			continue
+		}
+
+		plugin := StorefrontPlugin{
+			Name:     stringContent(args[0], content),
+			Override: method == "override",
+			FilePath: filePath,
+			Line:     int(node.StartPosition().Row) + 1,
+		}
+		if plugin.Name == "" {
+			continue
+		}
+
+		if len(args) > 2 && args[2].Kind() == "string" {
+			plugin.Selector = stringContent(args[2], content)
+		}
+
+		switch args[1].Kind() {
+		case "identifier":
+			plugin.ClassName = string(args[1].Utf8Text(content))
+			if source, ok := imports[plugin.ClassName]; ok {
+				plugin.ClassPath = resolveImportPath(filePath, source)
+			} else {
+				plugin.ClassPath = normalizePath(filePath)
+			}
+		case "arrow_function":
+			if body := args[1].ChildByFieldName("body"); body != nil {
+				plugin.ClassPath = resolveImportPath(filePath, dynamicImportSource(body, content))
+			}
+		}
+
+		plugins = append(plugins, plugin)
+	}
+
+	return plugins
+}
+
+// parsePluginClasses parses the classes of a file declaring `static options`
+func parsePluginClasses(root *tree_sitter.Node, content []byte, filePath string) []PluginClass {
+	classPattern := treesitterhelper.FuncPattern(func(node *tree_sitter.Node, _ []byte) bool {
+		return (node.Kind() == "class_declaration" || node.Kind() == "class") && node.ChildByFieldName("body") != nil
+	})
+
+	var classes []PluginClass
+	for _, node := range treesitterhelper.FindAll(root, classPattern, content) {
+		body := node.ChildByFieldName("body")
+
+		class := PluginClass{FilePath: filePath, Line: int(node.StartPosition().Row) + 1}
+		if name := node.ChildByFieldName("name"); name != nil {
+			class.Name = string(name.Utf8Text(content))
+		}
+
+		found := false
+		for i := uint(0); i < body.NamedChildCount(); i++ {
+			// TypeScript names class fields public_field_definition with a name instead of a property field
+			field := body.NamedChild(i)
+			if (field.Kind() != "field_definition" && field.Kind() != "public_field_definition") || field.Child(0) == nil || field.Child(0).Kind() != "static" {
+				continue
+			}
+
+			property := field.ChildByFieldName("property")
+			if property == nil {
+				property = field.ChildByFieldName("name")
+			}
+			value := field.ChildByFieldName("value")
+			if property == nil || value == nil || string(property.Utf8Text(content)) != "options" {
+				continue
+			}
+
+			found = true
+			class.Options = parseOptions(value, content)
+		}
+
+		if found {
+			classes = append(classes, class)
+		}
+	}
+
+	return classes
+}
+
+// parseOptions returns the keys of an options object. For deepmerge(Parent.options, { ... }) and similar
+// calls the keys of all object arguments are returned.
+func parseOptions(value *tree_sitter.Node, content []byte) []PluginOption {
+	var objects []*tree_sitter.Node
+	switch value.Kind() {
+	case "object":
+		objects = append(objects, value)
+	case "call_expression":
+		for _, arg := range namedArguments(value.ChildByFieldName("arguments")) {
+			if arg.Kind() == "object" {
+				objects = append(objects, arg)
+			}
+		}
+	}
+
+	var options []PluginOption
+	for _, object := range objects {
+		description := ""
+		for i := uint(0); i < object.NamedChildCount(); i++ {
+			child := object.NamedChild(i)
+			if child.Kind() == "comment" {
+				description = cleanComment(string(child.Utf8Text(content)))
+				continue
+			}
+
+			if child.Kind() == "pair" {
+				key := child.ChildByFieldName("key")
+				option := PluginOption{
+					Description: description,
+					Line:        int(child.StartPosition().Row) + 1,
+				}
+				if key != nil {
+					if key.Kind() == "string" {
+						option.Name = stringContent(key, content)
+					} else {
+						option.Name = string(key.Utf8Text(content))
+					}
+				}
+				if value := child.ChildByFieldName("value"); value != nil {
+					option.Default = string(value.Utf8Text(content))
+				}
+				if option.Name != "" {
+					options = append(options, option)
+				}
+			}
+
+			description = ""
+		}
+	}
+
+	return options
+}
+
+// cleanComment strips the comment markers of a line or block comment
+func cleanComment(comment string) string {
+	comment = strings.TrimPrefix(comment, "//")
+	comment = strings.TrimPrefix(comment, "/*")
+	comment = strings.TrimSuffix(comment, "*/")
+
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*"))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, " ")
+}
+
+// pluginManagerMethod returns the called method if the node is a call of a PluginManager method
+// (PluginManager.register, window.PluginManager.override, ...)
+func pluginManagerMethod(node *tree_sitter.Node, content []byte) string {
+	if node.Kind() != "call_expression" {
+		return ""
+	}
+
+	function := node.ChildByFieldName("function")
+	if function == nil || function.Kind() != "member_expression" {
+		return ""
+	}
+
+	object := function.ChildByFieldName("object")
+	property := function.ChildByFieldName("property")
+	if object == nil || property == nil {
+		return ""
+	}
+
+	objectName := string(object.Utf8Text(content))
+	if objectName != "PluginManager" && !strings.HasSuffix(objectName, ".PluginManager") {
+		return ""
+	}
+
+	return string(property.Utf8Text(content))
+}
+
+// parseImports maps the default imports of a file to their source
+func parseImports(root *tree_sitter.Node, content []byte) map[string]string {
+	imports := make(map[string]string)
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		statement := root.NamedChild(i)
+		if statement.Kind() != "import_statement" {
+			continue
+		}
+
+		source := statement.ChildByFieldName("source")
+		if source == nil {
+			continue
+		}
+
+		for j := uint(0); j < statement.NamedChildCount(); j++ {
+			clause := statement.NamedChild(j)
+			if clause.Kind() != "import_clause" {
+				continue
+			}
+			for k := uint(0); k < clause.NamedChildCount(); k++ {
+				if identifier := clause.NamedChild(k); identifier.Kind() == "identifier" {
+					imports[string(identifier.Utf8Text(content))] = stringContent(source, content)
+				}
+			}
+		}
+	}
+
+	return imports
+}
+
+// dynamicImportSource returns the source of an import('...') call
+func dynamicImportSource(node *tree_sitter.Node, content []byte) string {
+	if node.Kind() != "call_expression" {
+		return ""
+	}
+
+	function := node.ChildByFieldName("function")
+	if function == nil || function.Kind() != "import" {
+		return ""
+	}
+
+	args := namedArguments(node.ChildByFieldName("arguments"))
+	if len(args) == 0 || args[0].Kind() != "string" {
+		return ""
+	}
+
+	return stringContent(args[0], content)
+}
+
+// resolveImportPath resolves an import relative to the importing file. Imports starting with src/ are
+// resolved against the storefront app directory. The result is normalized with normalizePath.
+func resolveImportPath(importingFile, importPath string) string {
+	switch {
+	case strings.HasPrefix(importPath, "./") || strings.HasPrefix(importPath, "../"):
+		return normalizePath(filepath.Join(filepath.Dir(importingFile), importPath))
+	case strings.HasPrefix(importPath, "src/"):
+		if index := strings.Index(importingFile, "Resources/app/storefront/"); index != -1 {
+			return normalizePath(filepath.Join(importingFile[:index+len("Resources/app/storefront/")], importPath))
+		}
+	}
+
+	return ""
+}
+
+// normalizePath removes the script extension and a trailing /index of a path, so an import path and
+// the imported file map to the same key
+func normalizePath(filePath string) string {
+	normalized := filePath
+	if ext := filepath.Ext(normalized); ext == ".js" || ext == ".ts" || ext == ".mjs" || ext == ".mts" {
+		normalized = strings.TrimSuffix(normalized, ext)
+	}
+
+	return strings.TrimSuffix(normalized, "/index")
+}
+
+// namedArguments returns the named children of an arguments node
+func namedArguments(args *tree_sitter.Node) []*tree_sitter.Node {
+	if args == nil {
+		return nil
+	}
+
+	var result []*tree_sitter.Node
+	for i := uint(0); i < args.NamedChildCount(); i++ {
+		if arg := args.NamedChild(i); arg.Kind() != "comment" {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
+
+// stringContent returns the content of a string node without quotes
+func stringContent(node *tree_sitter.Node, content []byte) string {
+	return strings.Trim(string(node.Utf8Text(content)), "'\"`")
+}
@@ -0,0 +1,251 @@
+package storefront
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+var (
+	// pluginNameArguments maps the PluginManager methods taking a plugin name to the index of that argument
+	pluginNameArguments = map[string]uint{
+		"register":                     0,
+		"override":                     0,
+		"deregister":                   0,
+		"getPlugin":                    0,
+		"getPluginInstances":           0,
+		"initializePlugin":             0,
+		"getPluginInstanceFromElement": 1,
+	}
+
+	// startTagPattern matches the HTML start tags of a template
+	startTagPattern = treesitterhelper.NodeKind("html_start_tag")
+)
+
+// PluginNameAt returns the string node holding the plugin name if the node is the plugin name argument of a
+// PluginManager method like register, override or getPluginInstances
+func PluginNameAt(node *tree_sitter.Node, content []byte) *tree_sitter.Node {
+	stringNode := node
+	for i := 0; i < 2 && stringNode != nil && stringNode.Kind() != "string"; i++ {
+		stringNode = stringNode.Parent()
+	}
+	if stringNode == nil || stringNode.Kind() != "string" {
+		return nil
+	}
+
+	args := stringNode.Parent()
+	if args == nil || args.Kind() != "arguments" || args.Parent() == nil {
+		return nil
+	}
+
+	index, ok := pluginNameArguments[pluginManagerMethod(args.Parent(), content)]
+	if !ok {
+		return nil
+	}
+
+	arguments := namedArguments(args)
+	if int(index) >= len(arguments) || arguments[index].Id() != stringNode.Id() {
+		return nil
+	}
+
+	return stringNode
+}
+
+// TemplateAttribute is the HTML attribute at the cursor in a template
+type TemplateAttribute struct {
+	// Name is the attribute name, or the part of it typed so far
+	Name string
+
+	// Value is the attribute value before the cursor, if the cursor is inside the value
+	Value string
+
+	// InValue indicates the cursor is inside the quoted attribute value
+	InValue bool
+}
+
+// TemplateAttributeAt returns the attribute at the given offset of a template, or false if the offset is not
+// inside an HTML start tag. The attribute name or value is cut at the offset.
+func TemplateAttributeAt(root *tree_sitter.Node, content []byte, offset int) (TemplateAttribute, bool) {
+	if tag := startTagAt(root, content, offset); tag != nil {
+		return attributeAt(tag, content, offset)
+	}
+
+	if !insideError(root, offset) {
+		return TemplateAttribute{}, false
+	}
+
+	// A value still being typed has no closing quote, so its start tag only parses once the quote is added
+	for _, quote := range []byte{'"', '\''} {
+		completed := slices.Concat(content[:offset], []byte{quote}, content[offset:])
+
+		tree := parseTwig(completed)
+		attribute, ok := TemplateAttribute{}, false
+		if tag := startTagAt(tree.RootNode(), completed, offset); tag != nil {
+			attribute, ok = attributeAt(tag, completed, offset)
+		}
+		tree.Close()
+
+		if ok && attribute.InValue {
+			return attribute, true
+		}
+	}
+
+	return TemplateAttribute{}, false
+}
+
+// startTagAt returns the start tag containing the offset. A start tag without > ends at its last attribute,
+// so the whitespace typed after it still belongs to the tag.
+func startTagAt(root *tree_sitter.Node, content []byte, offset int) *tree_sitter.Node {
+	for _, tag := range treesitterhelper.FindAll(root, startTagPattern, content) {
+		if int(tag.StartByte()) >= offset {
+			break
+		}
+
+		end := int(tag.EndByte())
+		if closing := tag.Child(tag.ChildCount() - 1); closing != nil && !closing.IsMissing() && (closing.Kind() == ">" || closing.Kind() == "/>") {
+			if offset <= int(closing.StartByte()) {
+				return tag
+			}
+			continue
+		}
+
+		if offset <= end || len(bytes.TrimSpace(content[end:offset])) == 0 {
+			return tag
+		}
+	}
+
+	return nil
+}
+
+// attributeAt returns the attribute of the start tag at the offset, which is empty when the offset is between attributes
+func attributeAt(tag *tree_sitter.Node, content []byte, offset int) (TemplateAttribute, bool) {
+	if name := tag.ChildByFieldName("name"); name == nil || offset <= int(name.EndByte()) {
+		return TemplateAttribute{}, false
+	}
+
+	for i := uint(0); i < tag.NamedChildCount(); i++ {
+		child := tag.NamedChild(i)
+		if child.Kind() != "html_attribute" {
+			if int(child.StartByte()) < offset && offset < int(child.EndByte()) {
+				return TemplateAttribute{}, false
+			}
+			continue
+		}
+
+		name := child.ChildByFieldName("name")
+		if name == nil {
+			continue
+		}
+
+		if int(name.StartByte()) <= offset && offset <= int(name.EndByte()) {
+			return TemplateAttribute{Name: string(content[name.StartByte():offset])}, true
+		}
+
+		value := child.ChildByFieldName("value")
+		if value == nil || offset <= int(value.StartByte()) || offset >= int(value.EndByte()) {
+			if int(child.StartByte()) < offset && offset < int(child.EndByte()) {
+				return TemplateAttribute{}, false
+			}
+			continue
+		}
+
+		if quote := content[value.StartByte()]; quote != '"' && quote != '\'' {
+			return TemplateAttribute{}, false
+		}
+
+		return TemplateAttribute{
+			Name:    name.Utf8Text(content),
+			Value:   string(content[value.StartByte()+1 : offset]),
+			InValue: true,
+		}, true
+	}
+
+	return TemplateAttribute{}, true
+}
+
+// insideError checks if the text typed before the offset is part of the template the parser could not read
+func insideError(root *tree_sitter.Node, offset int) bool {
+	if offset == 0 {
+		return false
+	}
+
+	for node := root.DescendantForByteRange(uint(offset-1), uint(offset)); node != nil; node = node.Parent() {
+		if node.IsError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OptionsPluginName returns the dashed plugin name of an options attribute (data-off-canvas-cart-options -> off-canvas-cart)
+func OptionsPluginName(attribute string) (string, bool) {
+	name, ok := strings.CutPrefix(attribute, "data-")
+	if !ok {
+		return "", false
+	}
+
+	name, ok = strings.CutSuffix(name, "-options")
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+// OptionKeyAt checks if an options attribute value ends at the position of an object key, used for completion.
+// The value is either JSON or a Twig hash printed with {{ }}. It is completed with a key and a closing bracket,
+// and the completed key must start a new pair of an object.
+func OptionKeyAt(value string) bool {
+	language, completions := tree_sitter_json.Language(), []string{`": 0}`, `"": 0}`}
+	if strings.HasPrefix(strings.TrimSpace(value), "{{") {
+		language, completions = tree_sitter_twig.Language(), []string{"key: 0} }}", "': 0} }}", `": 0} }}`}
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(language))
+
+	offset := uint(len(value))
+	for _, completion := range completions {
+		tree := parser.Parse([]byte(value+completion), nil)
+		ok := isPairKey(tree.RootNode().NamedDescendantForByteRange(offset, offset))
+		tree.Close()
+
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPairKey checks if the node is, or is inside, the key of an object pair following the { or a comma
+func isPairKey(node *tree_sitter.Node) bool {
+	for ; node != nil && node.Parent() != nil; node = node.Parent() {
+		pair := node.Parent()
+		if pair.Kind() != "pair" {
+			continue
+		}
+
+		key := pair.ChildByFieldName("key")
+		previous := pair.PrevSibling()
+		return key != nil && key.Id() == node.Id() && previous != nil && (previous.Kind() == "{" || previous.Kind() == ",")
+	}
+
+	return false
+}
+
+// parseTwig parses a template
+func parseTwig(content []byte) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language()))
+
+	return parser.Parse(content, nil)
+}
@@ -44,11 +44,17 @@ func parsePHPRoutes(filePath string, node *tree_sitter.Node, content []byte) []R
 			basePath = classRoutes[0].Path
 		}
 
+		// The route scope is usually declared once on the class
+		classScopes := extractClassScopes(classNode, content)
+
 		// Find all method route attributes within the class
 		methodRoutes := extractMethodRoutes(classNode, content, basePath)
 		// Set the file path for method routes
 		for i := range methodRoutes {
 			methodRoutes[i].FilePath = filePath
+			if len(methodRoutes[i].Scopes) == 0 {
+				methodRoutes[i].Scopes = classScopes
+			}
 		}
 
 		routes = append(routes, methodRoutes...)
@@ -131,6 +137,23 @@ func extractClassRoutes(classNode *tree_sitter.Node, content []byte, namespace,
 	return routes
 }
 
+// extractClassScopes extracts the _routeScope default of a class-level Route attribute
+func extractClassScopes(classNode *tree_sitter.Node, content []byte) []string {
+	attrListNode := treesitterhelper.GetFirstNodeOfKind(classNode, "attribute_list")
+	if attrListNode == nil {
+		return nil
+	}
+
+	for _, attrNode := range treesitterhelper.FindAll(attrListNode, treesitterhelper.PHPRouteAttributePattern(), content) {
+		route := extractRouteFromAttribute(attrNode, content)
+		if len(route.Scopes) > 0 {
+			return route.Scopes
+		}
+	}
+
+	return nil
+}
+
 // extractMethodRoutes extracts routes from methods within a class
 func extractMethodRoutes(classNode *tree_sitter.Node, content []byte, basePath string) []Route {
 	var routes []Route
@@ -332,6 +355,15 @@ func extractRouteFromAttribute(node *tree_sitter.Node, content []byte) Route {
 			if child.Kind() == "name" {
 				namedArg = true
 				paramName = string(child.Utf8Text(content))
+			} else if child.Kind() == "array_creation_expression" {
+				if namedArg {
+					switch paramName {
+					case "methods":
+						route.Methods = extractStringArray(child, content)
+					case "defaults":
+						route.Scopes = extractDefaultsValue(child, content, "_routeScope")
+					}
+				}
 			} else if child.Kind() == "string_value" || child.Kind() == "encapsed_string" || child.Kind() == "string" {
 				// Get the value, either directly or from string_content
 				value := ""
@@ -370,3 +402,52 @@ func extractRouteFromAttribute(node *tree_sitter.Node, content []byte) Route {
 
 	return route
 }
+
+// extractStringArray extracts all string values of a PHP array literal like ['GET', 'POST']
+func extractStringArray(node *tree_sitter.Node, content []byte) []string {
+	var values []string
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		element := node.NamedChild(uint(i))
+		if element == nil || element.Kind() != "array_element_initializer" {
+			continue
+		}
+
+		stringNode := treesitterhelper.GetFirstNodeOfKind(element, "string")
+		if stringNode == nil {
+			continue
+		}
+
+		stringContentNode := treesitterhelper.GetFirstNodeOfKind(stringNode, "string_content")
+		if stringContentNode != nil {
+			values = append(values, string(stringContentNode.Utf8Text(content)))
+		}
+	}
+
+	return values
+}
+
+// extractDefaultsValue extracts the values of a key of the defaults array like ['_routeScope' => ['store-api']]
+func extractDefaultsValue(node *tree_sitter.Node, content []byte, key string) []string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		element := node.NamedChild(uint(i))
+		if element == nil || element.Kind() != "array_element_initializer" || element.NamedChildCount() < 2 {
+			continue
+		}
+
+		keyNode := element.NamedChild(0)
+		if keyNode.Kind() != "string" || strings.Trim(string(keyNode.Utf8Text(content)), "\"'") != key {
+			continue
+		}
+
+		valueNode := element.NamedChild(1)
+		switch valueNode.Kind() {
+		case "array_creation_expression":
+			return extractStringArray(valueNode, content)
+		case "string":
+			return []string{strings.Trim(string(valueNode.Utf8Text(content)), "\"'")}
+		}
+	}
+
+	return nil
+}
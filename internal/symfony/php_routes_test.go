@@ -83,6 +83,8 @@ func TestExtractRoutesStorefrontController(t *testing.T) {
 		FilePath:   filePath,
 		Line:       55, // Line number of the Route attribute in the wishlist.php file
 		Controller: "Shopware\\Storefront\\Controller\\WishlistController::index",
+		Methods:    []string{"GET"},
+		Scopes:     []string{"storefront"},
 	}
 
 	assert.Equal(t, expectedRouteMethod, *wishlistPageRoute)
@@ -104,3 +106,24 @@ func parsePHPFile(filePath string) (*tree_sitter.Node, []byte) {
 	tree := parser.Parse(content, nil)
 	return tree.RootNode(), content
 }
+
+func TestExtractRoutesWithClassScope(t *testing.T) {
+	filePath := "testdata/store_api_route.php"
+	node, content := parsePHPFile(filePath)
+
+	routes := parsePHPRoutes(filePath, node, content)
+
+	assert.Len(t, routes, 1)
+
+	expectedRouteMethod := Route{
+		Name:       "store-api.account.customer",
+		Path:       "/store-api/account/customer",
+		FilePath:   filePath,
+		Line:       11,
+		Controller: "Shopware\\Core\\Checkout\\Customer\\SalesChannel\\CustomerRoute::load",
+		Methods:    []string{"GET", "POST"},
+		Scopes:     []string{"store-api"},
+	}
+
+	assert.Equal(t, expectedRouteMethod, routes[0])
+}
@@ -13,12 +13,25 @@ type Route struct {
 	Name       string
 	Path       string
 	Controller string
+	Methods    []string
+	Scopes     []string
 	FilePath   string
 	Line       int
 }
 
 type RouteList []Route
 
+// GetByFilePath returns all routes defined in the given file
+func (rl RouteList) GetByFilePath(filePath string) RouteList {
+	var routes RouteList
+	for _, r := range rl {
+		if r.FilePath == filePath {
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
+
 func (rl RouteList) GetByController(name string) *Route {
 	for _, r := range rl {
 		if r.Controller == name {
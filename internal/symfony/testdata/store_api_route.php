@@ -0,0 +1,15 @@
+<?php declare(strict_types=1);
+
+namespace Shopware\Core\Checkout\Customer\SalesChannel;
+
+use Symfony\Component\HttpFoundation\Request;
+use Symfony\Component\Routing\Attribute\Route;
+
+#[Route(defaults: ['_routeScope' => ['store-api']])]
+class CustomerRoute extends AbstractCustomerRoute
+{
+    #[Route(path: '/store-api/account/customer', name: 'store-api.account.customer', defaults: ['_loginRequired' => true], methods: ['GET', 'POST'])]
+    public function load(Request $request): CustomerResponse
+    {
+    }
+}
@@ -14,13 +14,17 @@ import (
 
 // SystemConfigEntry represents a system config entry with namespace
 type SystemConfigEntry struct {
-	Namespace string
-	Name      string
-	Label     string
-	Type      string
-	Component string
-	FilePath  string
-	Line      int
+	Namespace    string
+	Name         string
+	Label        string
+	Labels       map[string]string
+	HelpTexts    map[string]string
+	Type         string
+	Component    string
+	DefaultValue string
+	Options      []SystemConfigOption
+	FilePath     string
+	Line         int
 }
 
 // GetNamespaceFromPath extracts the namespace from the file path by looking for composer.json or manifest.xml
@@ -171,13 +175,17 @@ func IndexSystemConfigFile(filePath string, node *tree_sitter.Node, data []byte)
 	entries := make([]SystemConfigEntry, 0, len(fields))
 	for _, field := range fields {
 		entries = append(entries, SystemConfigEntry{
-			Namespace: namespace,
-			Name:      fmt.Sprintf("%s.%s", namespace, field.Name),
-			Label:     field.Label,
-			Type:      field.Type,
-			Component: field.Component,
-			FilePath:  filePath,
-			Line:      int(field.Line),
+			Namespace:    namespace,
+			Name:         fmt.Sprintf("%s.%s", namespace, field.Name),
+			Label:        field.Label,
+			Labels:       field.Labels,
+			HelpTexts:    field.HelpTexts,
+			Type:         field.Type,
+			Component:    field.Component,
+			DefaultValue: field.DefaultValue,
+			Options:      field.Options,
+			FilePath:     filePath,
+			Line:         int(field.Line),
 		})
 	}
 
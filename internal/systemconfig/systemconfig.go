@@ -1,20 +1,118 @@
 package systemconfig
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 
 	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
 )
 
+// DefaultLocale is the locale of labels and help texts without lang attribute
+const DefaultLocale = "en-GB"
+
 // SystemConfigField represents a field in the system config XML
 type SystemConfigField struct {
-	Name      string
-	Label     string
-	Type      string
-	Component string
-	FilePath  string
-	Line      uint32
+	Name         string
+	Label        string
+	Labels       map[string]string
+	HelpTexts    map[string]string
+	Type         string
+	Component    string
+	DefaultValue string
+	Options      []SystemConfigOption
+	FilePath     string
+	Line         uint32
+}
+
+// SystemConfigOption is an allowed value of a single-select or multi-select field
+type SystemConfigOption struct {
+	ID    string
+	Names map[string]string
+}
+
+// Value types stored for system config fields
+const (
+	ValueTypeString = "string"
+	ValueTypeInt    = "int"
+	ValueTypeFloat  = "float"
+	ValueTypeBool   = "bool"
+	ValueTypeArray  = "array"
+)
+
+// fieldValueTypes maps the input-field types of config.xsd to the type of the stored value
+var fieldValueTypes = map[string]string{
+	"text":          ValueTypeString,
+	"textarea":      ValueTypeString,
+	"text-editor":   ValueTypeString,
+	"url":           ValueTypeString,
+	"password":      ValueTypeString,
+	"email":         ValueTypeString,
+	"colorpicker":   ValueTypeString,
+	"date":          ValueTypeString,
+	"datetime":      ValueTypeString,
+	"time":          ValueTypeString,
+	"single-select": ValueTypeString,
+	"int":           ValueTypeInt,
+	"float":         ValueTypeFloat,
+	"bool":          ValueTypeBool,
+	"checkbox":      ValueTypeBool,
+	"switch":        ValueTypeBool,
+	"multi-select":  ValueTypeArray,
+}
+
+// FieldValueType returns the type of the value stored for an input-field type, or an empty string for
+// unknown types
+func FieldValueType(fieldType string) string {
+	return fieldValueTypes[fieldType]
+}
+
+// FieldTypes returns the input-field types of config.xsd sorted by name
+func FieldTypes() []string {
+	types := make([]string, 0, len(fieldValueTypes))
+	for fieldType := range fieldValueTypes {
+		types = append(types, fieldType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// FieldTypeSupportsOptions checks if an input-field type has a list of options
+func FieldTypeSupportsOptions(fieldType string) bool {
+	return fieldType == "single-select" || fieldType == "multi-select"
+}
+
+// IsValidDefaultValue checks if a defaultValue can be stored in a field of the given input-field type. The
+// default of a single-select has to be one of its options.
+func IsValidDefaultValue(fieldType, value string, options []SystemConfigOption) bool {
+	value = strings.TrimSpace(value)
+
+	switch FieldValueType(fieldType) {
+	case ValueTypeInt:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case ValueTypeFloat:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case ValueTypeBool:
+		switch strings.ToLower(value) {
+		case "true", "false", "1", "0":
+			return true
+		}
+		return false
+	}
+
+	if fieldType == "single-select" && len(options) > 0 {
+		for _, option := range options {
+			if option.ID == value {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
 }
 
 // IsSystemConfigXML checks if the XML file is a system config XML file
@@ -267,6 +365,17 @@ func ParseSystemConfigField(node *tree_sitter.Node, content []byte, filePath str
 		// Get field label
 		field.Label = GetSystemConfigFieldLabel(node, content)
 
+		element := node
+		if element.Kind() == "document" {
+			element = treesitterhelper.GetFirstNodeOfKind(node, "element")
+		}
+		if element != nil {
+			field.Labels = GetLocalizedChildTexts(element, content, "label")
+			field.HelpTexts = GetLocalizedChildTexts(element, content, "helpText")
+			field.DefaultValue = GetChildText(element, content, "defaultValue")
+			field.Options = GetSystemConfigOptions(element, content)
+		}
+
 		if inputFieldNode != nil {
 			field.Type = GetSystemConfigFieldType(node, content)
 		} else if componentNode != nil {
@@ -317,3 +426,131 @@ func FindAllSystemConfigFields(root *tree_sitter.Node, content []byte, filePath
 
 	return fields
 }
+
+// childElements returns the elements directly inside an element with the given tag name
+func childElements(node *tree_sitter.Node, name string, content []byte) []*tree_sitter.Node {
+	var elements []*tree_sitter.Node
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		contentNode := node.NamedChild(i)
+		if contentNode.Kind() != "content" {
+			continue
+		}
+
+		for j := uint(0); j < contentNode.NamedChildCount(); j++ {
+			child := contentNode.NamedChild(j)
+			if child.Kind() == "element" && elementName(child, content) == name {
+				elements = append(elements, child)
+			}
+		}
+	}
+
+	return elements
+}
+
+// elementName returns the tag name of an element
+func elementName(element *tree_sitter.Node, content []byte) string {
+	for i := uint(0); i < element.NamedChildCount(); i++ {
+		tag := element.NamedChild(i)
+		if tag.Kind() != "STag" && tag.Kind() != "EmptyElemTag" {
+			continue
+		}
+
+		if name := treesitterhelper.GetFirstNodeOfKind(tag, "Name"); name != nil {
+			return string(name.Utf8Text(content))
+		}
+	}
+
+	return ""
+}
+
+// elementText returns the text of an element with whitespace collapsed
+func elementText(element *tree_sitter.Node, content []byte) string {
+	for i := uint(0); i < element.NamedChildCount(); i++ {
+		if child := element.NamedChild(i); child.Kind() == "content" {
+			return strings.Join(strings.Fields(string(child.Utf8Text(content))), " ")
+		}
+	}
+
+	return ""
+}
+
+// elementLang returns the lang attribute of an element, or the default locale
+func elementLang(element *tree_sitter.Node, content []byte) string {
+	tag := treesitterhelper.GetFirstNodeOfKind(element, "STag")
+	if tag == nil {
+		return DefaultLocale
+	}
+
+	for i := uint(0); i < tag.NamedChildCount(); i++ {
+		attribute := tag.NamedChild(i)
+		if attribute.Kind() != "Attribute" {
+			continue
+		}
+
+		name := treesitterhelper.GetFirstNodeOfKind(attribute, "Name")
+		value := treesitterhelper.GetFirstNodeOfKind(attribute, "AttValue")
+		if name != nil && value != nil && string(name.Utf8Text(content)) == "lang" {
+			return strings.Trim(string(value.Utf8Text(content)), "\"'")
+		}
+	}
+
+	return DefaultLocale
+}
+
+// GetLocalizedChildTexts returns the texts of the child elements with the given name by locale, like the
+// labels or help texts of a field, or nil if there are none
+func GetLocalizedChildTexts(node *tree_sitter.Node, content []byte, name string) map[string]string {
+	var texts map[string]string
+	for _, element := range childElements(node, name, content) {
+		if texts == nil {
+			texts = make(map[string]string)
+		}
+		texts[elementLang(element, content)] = elementText(element, content)
+	}
+	return texts
+}
+
+// GetChildElement returns the first child element with the given name, or nil
+func GetChildElement(node *tree_sitter.Node, content []byte, name string) *tree_sitter.Node {
+	elements := childElements(node, name, content)
+	if len(elements) == 0 {
+		return nil
+	}
+	return elements[0]
+}
+
+// GetChildElements returns the child elements with the given name
+func GetChildElements(node *tree_sitter.Node, content []byte, name string) []*tree_sitter.Node {
+	return childElements(node, name, content)
+}
+
+// GetElementName returns the tag name of an element
+func GetElementName(element *tree_sitter.Node, content []byte) string {
+	return elementName(element, content)
+}
+
+// GetChildText returns the text of the first child element with the given name
+func GetChildText(node *tree_sitter.Node, content []byte, name string) string {
+	elements := childElements(node, name, content)
+	if len(elements) == 0 {
+		return ""
+	}
+	return elementText(elements[0], content)
+}
+
+// GetSystemConfigOptions returns the options of a single-select or multi-select field
+func GetSystemConfigOptions(node *tree_sitter.Node, content []byte) []SystemConfigOption {
+	var options []SystemConfigOption
+
+	for _, optionsElement := range childElements(node, "options", content) {
+		for _, option := range childElements(optionsElement, "option", content) {
+			options = append(options, SystemConfigOption{
+				ID:    GetChildText(option, content, "id"),
+				Names: GetLocalizedChildTexts(option, content, "name"),
+			})
+		}
+	}
+
+	return options
+}
@@ -208,6 +208,7 @@ func TestParseSystemConfigField(t *testing.T) {
 			expected: SystemConfigField{
 				Name:     "fieldName",
 				Label:    "Field Label",
+				Labels:   map[string]string{"en-GB": "Field Label"},
 				Type:     "text",
 				FilePath: "test-file.xml",
 				Line:     1, // Line number starts from 1
@@ -222,6 +223,7 @@ func TestParseSystemConfigField(t *testing.T) {
 			expected: SystemConfigField{
 				Name:      "componentName",
 				Label:     "Component Label",
+				Labels:    map[string]string{"en-GB": "Component Label"},
 				Component: "custom-component",
 				FilePath:  "test-file.xml",
 				Line:      1, // Line number starts from 1
@@ -343,3 +345,66 @@ func TestRealSystemConfigFile(t *testing.T) {
 	assert.Equal(t, "First name", senderAddressFirstNameField.Label)
 	assert.Equal(t, "text", senderAddressFirstNameField.Type)
 }
+
+func TestParseSystemConfigFieldDetails(t *testing.T) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_xml.LanguageXML())))
+
+	content := []byte(`<input-field type="single-select">
+    <name>mode</name>
+    <label>Mode</label>
+    <label lang="de-DE">Modus</label>
+    <helpText>Select how
+        the widget is shown.</helpText>
+    <defaultValue>inline</defaultValue>
+    <options>
+        <option>
+            <id>inline</id>
+            <name>Inline</name>
+            <name lang="de-DE">Eingebettet</name>
+        </option>
+        <option>
+            <id>modal</id>
+            <name>Modal</name>
+        </option>
+    </options>
+</input-field>`)
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	field := ParseSystemConfigField(tree.RootNode(), content, "config.xml")
+	assert.Equal(t, "mode", field.Name)
+	assert.Equal(t, map[string]string{"en-GB": "Mode", "de-DE": "Modus"}, field.Labels)
+	assert.Equal(t, map[string]string{"en-GB": "Select how the widget is shown."}, field.HelpTexts)
+	assert.Equal(t, "inline", field.DefaultValue)
+	assert.Equal(t, []SystemConfigOption{
+		{ID: "inline", Names: map[string]string{"en-GB": "Inline", "de-DE": "Eingebettet"}},
+		{ID: "modal", Names: map[string]string{"en-GB": "Modal"}},
+	}, field.Options)
+}
+
+func TestFieldValueType(t *testing.T) {
+	assert.Equal(t, ValueTypeString, FieldValueType("text"))
+	assert.Equal(t, ValueTypeString, FieldValueType("single-select"))
+	assert.Equal(t, ValueTypeInt, FieldValueType("int"))
+	assert.Equal(t, ValueTypeBool, FieldValueType("checkbox"))
+	assert.Equal(t, ValueTypeArray, FieldValueType("multi-select"))
+	assert.Equal(t, "", FieldValueType("sw-entity-single-select"))
+}
+
+func TestIsValidDefaultValue(t *testing.T) {
+	options := []SystemConfigOption{{ID: "inline"}, {ID: "modal"}}
+
+	assert.True(t, IsValidDefaultValue("int", "42", nil))
+	assert.False(t, IsValidDefaultValue("int", "4.2", nil))
+	assert.True(t, IsValidDefaultValue("float", "4.2", nil))
+	assert.False(t, IsValidDefaultValue("float", "abc", nil))
+	assert.True(t, IsValidDefaultValue("bool", "true", nil))
+	assert.True(t, IsValidDefaultValue("switch", "0", nil))
+	assert.False(t, IsValidDefaultValue("checkbox", "yes please", nil))
+	assert.True(t, IsValidDefaultValue("single-select", "modal", options))
+	assert.False(t, IsValidDefaultValue("single-select", "popup", options))
+	assert.True(t, IsValidDefaultValue("text", "anything", nil))
+}
@@ -0,0 +1,263 @@
+package theme
+
+import (
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// StorefrontTheme is the name of the base theme all themes inherit from
+const StorefrontTheme = "Storefront"
+
+// pluginsReference expands to all bundles without theme in the views of a theme
+const pluginsReference = "@Plugins"
+
+// ThemeDefinition holds the inheritance information of a theme.json
+type ThemeDefinition struct {
+	// Path is the path of the theme.json file
+	Path string
+
+	// ConfigInheritance lists the themes the config is inherited from (@Name)
+	ConfigInheritance []string
+
+	// Views lists the template namespaces from the lowest to the highest priority (@Name, @Plugins)
+	Views []string
+
+	// Groups are the names of the declared blocks, sections and tabs keyed by the field property referencing them
+	Groups map[string][]string
+}
+
+// ParseThemeDefinition reads configInheritance, views and the config groups of a theme.json
+func ParseThemeDefinition(root *tree_sitter.Node, document []byte, filePath string) ThemeDefinition {
+	object := documentObject(root)
+
+	definition := ThemeDefinition{
+		Path:              filePath,
+		ConfigInheritance: stringArray(ObjectMember(object, "configInheritance", document), document),
+		Views:             stringArray(ObjectMember(object, "views", document), document),
+		Groups:            make(map[string][]string),
+	}
+
+	for property, groups := range ParseConfigGroups(root, document) {
+		for _, group := range groups {
+			definition.Groups[property] = append(definition.Groups[property], group.Name)
+		}
+	}
+
+	return definition
+}
+
+// ThemeName returns the technical name of the theme declared by a theme.json, which is the name of the
+// bundle or app shipping it
+func ThemeName(path string, extensions []extension.ShopwareExtension) string {
+	resources := filepath.Dir(path)
+
+	for _, bundle := range storefrontBundles {
+		if strings.HasSuffix(resources, filepath.Join(bundle, "Resources")) {
+			return StorefrontTheme
+		}
+	}
+
+	for _, ext := range extensions {
+		if ext.GetResourcesPath() == resources {
+			return ext.Name
+		}
+	}
+
+	bundle := filepath.Dir(resources)
+	if filepath.Base(bundle) == "src" {
+		bundle = filepath.Dir(bundle)
+	}
+
+	return filepath.Base(bundle)
+}
+
+// EffectiveValue is the value of a config field in a theme
+type EffectiveValue struct {
+	// Theme is the theme using the value
+	Theme string
+
+	// Field is the declaration providing the value
+	Field ThemeConfigField
+
+	// Source is the theme declaring the field
+	Source string
+
+	// Overrides is the theme whose declaration is overridden by Source, or empty
+	Overrides string
+}
+
+// Graph is the inheritance graph between the themes of a project
+type Graph struct {
+	definitions map[string]ThemeDefinition
+	names       map[string]string
+	plugins     []string
+}
+
+// NewGraph creates the inheritance graph of the indexed theme.json files. Extensions without a theme are
+// included by @Plugins.
+func NewGraph(definitions []ThemeDefinition, extensions []extension.ShopwareExtension) *Graph {
+	graph := &Graph{
+		definitions: make(map[string]ThemeDefinition),
+		names:       make(map[string]string),
+	}
+
+	for _, definition := range definitions {
+		name := ThemeName(definition.Path, extensions)
+		graph.definitions[name] = definition
+		graph.names[definition.Path] = name
+	}
+
+	for _, ext := range extensions {
+		if _, isTheme := graph.definitions[ext.Name]; !isTheme {
+			graph.plugins = append(graph.plugins, ext.Name)
+		}
+	}
+	sort.Strings(graph.plugins)
+
+	return graph
+}
+
+// Themes returns the names of all themes, the Storefront first
+func (g *Graph) Themes() []string {
+	var names []string
+	for name := range g.definitions {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == StorefrontTheme) != (names[j] == StorefrontTheme) {
+			return names[i] == StorefrontTheme
+		}
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// ThemeOf returns the name of the theme declared by a theme.json
+func (g *Graph) ThemeOf(path string) string {
+	return g.names[path]
+}
+
+// ConfigChain returns the themes contributing config to a theme from the lowest to the highest priority. The
+// Storefront is the base of every theme, followed by the configInheritance entries and the theme itself.
+func (g *Graph) ConfigChain(name string) []string {
+	var chain []string
+	visited := make(map[string]bool)
+
+	var visit func(theme string)
+	visit = func(theme string) {
+		if visited[theme] {
+			return
+		}
+		visited[theme] = true
+
+		if theme != StorefrontTheme {
+			visit(StorefrontTheme)
+		}
+
+		for _, parent := range g.definitions[theme].ConfigInheritance {
+			visit(strings.TrimPrefix(parent, "@"))
+		}
+
+		chain = append(chain, theme)
+	}
+
+	visit(name)
+
+	return chain
+}
+
+// EffectiveValues returns for every theme the declaration of a config field which wins in its config chain
+func (g *Graph) EffectiveValues(fields []ThemeConfigField) []EffectiveValue {
+	declarations := make(map[string]ThemeConfigField)
+	for _, field := range fields {
+		if theme := g.ThemeOf(field.Path); theme != "" {
+			declarations[theme] = field
+		}
+	}
+
+	var values []EffectiveValue
+	for _, theme := range g.Themes() {
+		chain := g.ConfigChain(theme)
+		var value *EffectiveValue
+		for i := len(chain) - 1; i >= 0; i-- {
+			field, ok := declarations[chain[i]]
+			if !ok {
+				continue
+			}
+			if value != nil {
+				value.Overrides = chain[i]
+				break
+			}
+			value = &EffectiveValue{Theme: theme, Field: field, Source: chain[i]}
+		}
+
+		if value != nil {
+			values = append(values, *value)
+		}
+	}
+
+	return values
+}
+
+// TemplateHierarchy returns the bundles providing templates to a theme from the highest to the lowest priority,
+// following its views. Themes without views use @Storefront, @Plugins and the theme itself.
+func (g *Graph) TemplateHierarchy(name string) []string {
+	views := g.definitions[name].Views
+	if len(views) == 0 {
+		views = []string{"@" + StorefrontTheme, pluginsReference}
+		if name != StorefrontTheme {
+			views = append(views, "@"+name)
+		}
+	}
+
+	var hierarchy []string
+	for i := len(views) - 1; i >= 0; i-- {
+		bundles := []string{strings.TrimPrefix(views[i], "@")}
+		if views[i] == pluginsReference {
+			bundles = g.plugins
+		}
+
+		for _, bundle := range bundles {
+			if !slices.Contains(hierarchy, bundle) {
+				hierarchy = append(hierarchy, bundle)
+			}
+		}
+	}
+
+	return hierarchy
+}
+
+// TemplateHierarchyFor returns the template hierarchy a bundle's templates are rendered with. Themes use their
+// own views and all other bundles the hierarchy of the Storefront theme.
+func (g *Graph) TemplateHierarchyFor(bundle string) []string {
+	for name := range g.definitions {
+		if strings.EqualFold(name, bundle) {
+			return g.TemplateHierarchy(name)
+		}
+	}
+
+	return g.TemplateHierarchy(StorefrontTheme)
+}
+
+// stringArray returns the strings of a JSON array node
+func stringArray(array *tree_sitter.Node, document []byte) []string {
+	if array == nil || array.Kind() != "array" {
+		return nil
+	}
+
+	var values []string
+	for i := uint(0); i < array.NamedChildCount(); i++ {
+		if item := array.NamedChild(i); item.Kind() == "string" {
+			values = append(values, extractStringContent(item, document))
+		}
+	}
+
+	return values
+}
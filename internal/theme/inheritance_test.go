@@ -0,0 +1,67 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseThemeDefinition(t *testing.T) {
+	code := `{"configInheritance": ["@Storefront", "@BaseTheme"], "views": ["@Storefront", "@Plugins", "@ChildTheme"], "config": {"blocks": {"colors": {}, "logos": {}}, "tabs": {"extended": {}}}}`
+	tree := parseJSON(t, code)
+	defer tree.Close()
+
+	definition := ParseThemeDefinition(tree.RootNode(), []byte(code), "/project/custom/plugins/ChildTheme/src/Resources/theme.json")
+	assert.Equal(t, []string{"@Storefront", "@BaseTheme"}, definition.ConfigInheritance)
+	assert.Equal(t, []string{"@Storefront", "@Plugins", "@ChildTheme"}, definition.Views)
+	assert.Equal(t, map[string][]string{"block": {"colors", "logos"}, "tab": {"extended"}}, definition.Groups)
+}
+
+func TestGraph(t *testing.T) {
+	extensions := []extension.ShopwareExtension{
+		{Name: "BaseTheme", Type: extension.ShopwareExtensionTypeBundle, Path: "/project/custom/plugins/BaseTheme/src/BaseTheme.php"},
+		{Name: "ChildTheme", Type: extension.ShopwareExtensionTypeBundle, Path: "/project/custom/plugins/ChildTheme/src/ChildTheme.php"},
+		{Name: "PayPal", Type: extension.ShopwareExtensionTypeBundle, Path: "/project/custom/plugins/PayPal/src/PayPal.php"},
+	}
+
+	storefront := "/project/vendor/shopware/storefront/Resources/theme.json"
+	base := "/project/custom/plugins/BaseTheme/src/Resources/theme.json"
+	child := "/project/custom/plugins/ChildTheme/src/Resources/theme.json"
+
+	graph := NewGraph([]ThemeDefinition{
+		{Path: storefront, Views: []string{"@Storefront", "@Plugins"}},
+		{Path: base},
+		{Path: child, ConfigInheritance: []string{"@Storefront", "@BaseTheme"}, Views: []string{"@Storefront", "@Plugins", "@BaseTheme", "@ChildTheme"}},
+	}, extensions)
+
+	assert.Equal(t, []string{"Storefront", "BaseTheme", "ChildTheme"}, graph.Themes())
+	assert.Equal(t, "ChildTheme", graph.ThemeOf(child))
+	assert.Equal(t, []string{"Storefront", "BaseTheme", "ChildTheme"}, graph.ConfigChain("ChildTheme"))
+	assert.Equal(t, []string{"Storefront", "BaseTheme"}, graph.ConfigChain("BaseTheme"))
+
+	assert.Equal(t, []string{"PayPal", "Storefront"}, graph.TemplateHierarchy("Storefront"))
+	assert.Equal(t, []string{"BaseTheme", "PayPal", "Storefront"}, graph.TemplateHierarchy("BaseTheme"))
+	assert.Equal(t, []string{"ChildTheme", "BaseTheme", "PayPal", "Storefront"}, graph.TemplateHierarchyFor("childtheme"))
+	assert.Equal(t, []string{"PayPal", "Storefront"}, graph.TemplateHierarchyFor("PayPal"))
+
+	values := graph.EffectiveValues([]ThemeConfigField{
+		{Key: "sw-color-brand-primary", Value: "#0042a0", Path: storefront},
+		{Key: "sw-color-brand-primary", Value: "#ff0000", Path: base},
+	})
+	assert.Equal(t, []EffectiveValue{
+		{Theme: "Storefront", Field: ThemeConfigField{Key: "sw-color-brand-primary", Value: "#0042a0", Path: storefront}, Source: "Storefront"},
+		{Theme: "BaseTheme", Field: ThemeConfigField{Key: "sw-color-brand-primary", Value: "#ff0000", Path: base}, Source: "BaseTheme", Overrides: "Storefront"},
+		{Theme: "ChildTheme", Field: ThemeConfigField{Key: "sw-color-brand-primary", Value: "#ff0000", Path: base}, Source: "BaseTheme", Overrides: "Storefront"},
+	}, values)
+}
+
+func TestThemeName(t *testing.T) {
+	extensions := []extension.ShopwareExtension{
+		{Name: "SwagTheme", Type: extension.ShopwareExtensionTypeBundle, Path: "/project/custom/plugins/swag-theme/src/SwagTheme.php"},
+	}
+
+	assert.Equal(t, "Storefront", ThemeName("/project/src/Storefront/Resources/theme.json", extensions))
+	assert.Equal(t, "SwagTheme", ThemeName("/project/custom/plugins/swag-theme/src/Resources/theme.json", extensions))
+	assert.Equal(t, "OtherTheme", ThemeName("/project/custom/plugins/OtherTheme/src/Resources/theme.json", extensions))
+}
@@ -57,3 +57,14 @@ func TestParseThemeConfig(t *testing.T) {
 	expectedFieldCount := 20 // Based on the theme.json file
 	assert.Len(t, fields, expectedFieldCount)
 }
+
+func TestReadStyleEntries(t *testing.T) {
+	entries, err := ReadStyleEntries("testdata/theme.json")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []StyleEntry{
+		{Path: "app/storefront/src/scss/base.scss", Resolve: map[string]string{"vendor": "app/storefront/vendor"}},
+		{Path: "app/storefront/src/scss/skin/shopware/_base.scss", Resolve: map[string]string{"vendor": "app/storefront/vendor"}},
+		{Path: "@Plugins"},
+	}, entries)
+}
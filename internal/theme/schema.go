@@ -0,0 +1,215 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// FieldTypes are the config field types the theme manager of the Administration can render
+var FieldTypes = []string{
+	"checkbox",
+	"color",
+	"fontFamily",
+	"media",
+	"number",
+	"select",
+	"switch",
+	"text",
+	"textarea",
+	"url",
+}
+
+// FieldProperties are the properties of a config field
+var FieldProperties = []string{
+	"block",
+	"custom",
+	"editable",
+	"fullWidth",
+	"helpText",
+	"label",
+	"order",
+	"scss",
+	"section",
+	"tab",
+	"type",
+	"value",
+}
+
+// GroupProperties maps the field properties referencing a group to the config key declaring the groups
+var GroupProperties = map[string]string{
+	"block":   "blocks",
+	"section": "sections",
+	"tab":     "tabs",
+}
+
+// storefrontBundles are the locations of the Storefront bundle relative to the project root
+var storefrontBundles = []string{
+	filepath.Join("src", "Storefront"),
+	filepath.Join("vendor", "shopware", "storefront"),
+	filepath.Join("vendor", "shopware", "platform", "src", "Storefront"),
+}
+
+// StorefrontResources returns the Resources directory of the Storefront bundle in the project, or an
+// empty string if it is not installed
+func StorefrontResources(projectRoot string) string {
+	for _, bundle := range storefrontBundles {
+		resources := filepath.Join(projectRoot, bundle, "Resources")
+		if info, err := os.Stat(resources); err == nil && info.IsDir() {
+			return resources
+		}
+	}
+	return ""
+}
+
+// ThemeNames returns the names of the Storefront and all bundles and apps shipping a theme.json
+func ThemeNames(projectRoot string, extensions []extension.ShopwareExtension) []string {
+	var names []string
+	if StorefrontResources(projectRoot) != "" {
+		names = append(names, "Storefront")
+	}
+
+	for _, ext := range extensions {
+		if _, err := os.Stat(filepath.Join(ext.GetResourcesPath(), "theme.json")); err == nil {
+			names = append(names, ext.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ConfigGroup is a block, section or tab declared in the config of a theme.json
+type ConfigGroup struct {
+	// Name is the key of the group
+	Name string
+
+	// Label holds the labels by locale
+	Label map[string]string
+
+	// Node is the key node of the group
+	Node *tree_sitter.Node
+}
+
+// ParseConfigGroups returns the groups declared in config.blocks, config.sections and config.tabs keyed by the
+// field property referencing them (block, section, tab)
+func ParseConfigGroups(root *tree_sitter.Node, document []byte) map[string][]ConfigGroup {
+	groups := make(map[string][]ConfigGroup)
+
+	config := ObjectMember(documentObject(root), "config", document)
+	if config == nil {
+		return groups
+	}
+
+	for property, key := range GroupProperties {
+		declarations := ObjectMember(config, key, document)
+		if declarations == nil {
+			continue
+		}
+
+		for _, pair := range objectPairs(declarations) {
+			group := ConfigGroup{
+				Name:  extractStringContent(pair.NamedChild(0), document),
+				Label: make(map[string]string),
+				Node:  pair.NamedChild(0),
+			}
+			if label := ObjectMember(pair.NamedChild(1), "label", document); label != nil {
+				extractLabels(label, document, group.Label)
+			}
+			groups[property] = append(groups[property], group)
+		}
+	}
+
+	return groups
+}
+
+// JSONPath returns the keys from the document root to a string node. Array items are represented by [].
+// The second result is true if the node is the key of a pair instead of a value.
+func JSONPath(node *tree_sitter.Node, document []byte) ([]string, bool) {
+	for node != nil && node.Kind() != "string" {
+		node = node.Parent()
+	}
+	if node == nil {
+		return nil, false
+	}
+
+	isKey := false
+	if parent := node.Parent(); parent != nil && parent.Kind() == "pair" && parent.NamedChild(0) != nil && parent.NamedChild(0).Id() == node.Id() {
+		isKey = true
+	}
+
+	var path []string
+	child := node
+	for parent := node.Parent(); parent != nil; child, parent = parent, parent.Parent() {
+		switch parent.Kind() {
+		case "pair":
+			if key := parent.NamedChild(0); key != nil && key.Id() != child.Id() {
+				path = append(path, extractStringContent(key, document))
+			}
+		case "array":
+			path = append(path, "[]")
+		}
+	}
+
+	// The keys were collected from the node up to the root
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, isKey
+}
+
+// ObjectMember returns the value of the pair with the given key in an object node, or nil
+func ObjectMember(object *tree_sitter.Node, key string, document []byte) *tree_sitter.Node {
+	for _, pair := range objectPairs(object) {
+		if extractStringContent(pair.NamedChild(0), document) == key {
+			return pair.NamedChild(1)
+		}
+	}
+	return nil
+}
+
+// ObjectPairs returns the key and value nodes of an object node
+func ObjectPairs(object *tree_sitter.Node) [][2]*tree_sitter.Node {
+	var result [][2]*tree_sitter.Node
+	for _, pair := range objectPairs(object) {
+		result = append(result, [2]*tree_sitter.Node{pair.NamedChild(0), pair.NamedChild(1)})
+	}
+	return result
+}
+
+// StringContent returns the content of a JSON string node without quotes, or an empty string for nil
+func StringContent(node *tree_sitter.Node, document []byte) string {
+	if node == nil {
+		return ""
+	}
+	return extractStringContent(node, document)
+}
+
+// objectPairs returns the complete pairs of an object node
+func objectPairs(object *tree_sitter.Node) []*tree_sitter.Node {
+	if object == nil || object.Kind() != "object" {
+		return nil
+	}
+
+	var pairs []*tree_sitter.Node
+	for i := uint(0); i < object.NamedChildCount(); i++ {
+		pair := object.NamedChild(i)
+		if pair.Kind() == "pair" && pair.NamedChild(0) != nil && pair.NamedChild(0).Kind() == "string" && pair.NamedChild(1) != nil {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+// documentObject returns the root object of a JSON document
+func documentObject(root *tree_sitter.Node) *tree_sitter.Node {
+	if root != nil && root.Kind() == "document" && root.NamedChildCount() > 0 {
+		return root.NamedChild(0)
+	}
+	return root
+}
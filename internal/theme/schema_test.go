@@ -0,0 +1,80 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/extension"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+func parseJSON(t *testing.T, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language())))
+	return parser.Parse([]byte(code), nil)
+}
+
+func TestParseConfigGroups(t *testing.T) {
+	code := `{
+  "config": {
+    "blocks": {"themeColors": {"label": {"en-GB": "Theme colours", "de-DE": "Themefarben"}}},
+    "tabs": {"colors": {"label": {"de-DE": "Farben"}}},
+    "fields": {}
+  }
+}`
+	tree := parseJSON(t, code)
+	defer tree.Close()
+
+	groups := ParseConfigGroups(tree.RootNode(), []byte(code))
+	require.Len(t, groups["block"], 1)
+	assert.Equal(t, "themeColors", groups["block"][0].Name)
+	assert.Equal(t, "Theme colours", groups["block"][0].Label["en-GB"])
+	require.Len(t, groups["tab"], 1)
+	assert.Equal(t, map[string]string{"de-DE": "Farben"}, groups["tab"][0].Label)
+	assert.Empty(t, groups["section"])
+}
+
+func TestJSONPath(t *testing.T) {
+	code := `{"config": {"fields": {"sw-color": {"type": "color"}}}, "views": ["@Storefront"]}`
+	tree := parseJSON(t, code)
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	at := func(substring string) *tree_sitter.Node {
+		offset := uint(strings.Index(code, substring)) + 1
+		return root.NamedDescendantForByteRange(offset, offset)
+	}
+
+	path, isKey := JSONPath(at(`"color"`), []byte(code))
+	assert.Equal(t, []string{"config", "fields", "sw-color", "type"}, path)
+	assert.False(t, isKey)
+
+	path, isKey = JSONPath(at(`"type"`), []byte(code))
+	assert.Equal(t, []string{"config", "fields", "sw-color"}, path)
+	assert.True(t, isKey)
+
+	path, _ = JSONPath(at(`"@Storefront"`), []byte(code))
+	assert.Equal(t, []string{"views", "[]"}, path)
+}
+
+func TestThemeNames(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "vendor/shopware/storefront/Resources"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "custom/plugins/MyTheme/src/Resources"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "custom/plugins/MyTheme/src/Resources/theme.json"), []byte("{}"), 0o644))
+
+	assert.Equal(t, filepath.Join(root, "vendor/shopware/storefront/Resources"), StorefrontResources(root))
+
+	names := ThemeNames(root, []extension.ShopwareExtension{
+		{Name: "MyTheme", Type: extension.ShopwareExtensionTypeBundle, Path: filepath.Join(root, "custom/plugins/MyTheme/src/MyTheme.php")},
+		{Name: "MyPlugin", Type: extension.ShopwareExtensionTypeBundle, Path: filepath.Join(root, "custom/plugins/MyPlugin/src/MyPlugin.php")},
+	})
+	assert.Equal(t, []string{"MyTheme", "Storefront"}, names)
+}
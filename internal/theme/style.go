@@ -0,0 +1,107 @@
+package theme
+
+import (
+	"os"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_json "github.com/tree-sitter/tree-sitter-json/bindings/go"
+)
+
+// StyleEntry is an entry of the style array of a theme.json
+type StyleEntry struct {
+	// Path is the stylesheet path relative to the Resources directory, or a bundle reference like @Storefront or @Plugins
+	Path string
+
+	// Resolve maps import aliases (vendor for ~vendor/...) to directories relative to the Resources directory
+	Resolve map[string]string
+}
+
+// ParseStyleEntries parses the style array of a theme.json. Entries are either paths or objects with the path
+// as key and its resolve mappings as value.
+func ParseStyleEntries(root *tree_sitter.Node, document []byte) []StyleEntry {
+	if root.Kind() == "document" && root.NamedChildCount() > 0 {
+		root = root.NamedChild(0)
+	}
+
+	if root.Kind() != "object" {
+		return nil
+	}
+
+	var entries []StyleEntry
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		pair := root.NamedChild(uint(i))
+		if pair.Kind() != "pair" {
+			continue
+		}
+
+		key := pair.NamedChild(0)
+		value := pair.NamedChild(1)
+		if key == nil || value == nil || extractStringContent(key, document) != "style" || value.Kind() != "array" {
+			continue
+		}
+
+		for j := 0; j < int(value.NamedChildCount()); j++ {
+			item := value.NamedChild(uint(j))
+
+			switch item.Kind() {
+			case "string":
+				entries = append(entries, StyleEntry{Path: extractStringContent(item, document)})
+			case "object":
+				for k := 0; k < int(item.NamedChildCount()); k++ {
+					entryPair := item.NamedChild(uint(k))
+					if entryPair.Kind() != "pair" || entryPair.NamedChild(0) == nil {
+						continue
+					}
+
+					entry := StyleEntry{
+						Path:    extractStringContent(entryPair.NamedChild(0), document),
+						Resolve: make(map[string]string),
+					}
+					if options := entryPair.NamedChild(1); options != nil && options.Kind() == "object" {
+						parseResolveMappings(options, document, entry.Resolve)
+					}
+
+					entries = append(entries, entry)
+				}
+			}
+		}
+	}
+
+	return entries
+}
+
+// ReadStyleEntries reads and parses the style array of a theme.json file
+func ReadStyleEntries(path string) ([]StyleEntry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_json.Language())); err != nil {
+		return nil, err
+	}
+
+	tree := parser.Parse(content, nil)
+	if tree == nil {
+		return nil, nil
+	}
+	defer tree.Close()
+
+	return ParseStyleEntries(tree.RootNode(), content), nil
+}
+
+// parseResolveMappings extracts the resolve object of a style entry
+func parseResolveMappings(options *tree_sitter.Node, document []byte, result map[string]string) {
+	for i := 0; i < int(options.NamedChildCount()); i++ {
+		pair := options.NamedChild(uint(i))
+		if pair.Kind() != "pair" || pair.NamedChild(0) == nil || extractStringContent(pair.NamedChild(0), document) != "resolve" {
+			continue
+		}
+
+		if resolve := pair.NamedChild(1); resolve != nil && resolve.Kind() == "object" {
+			extractLabels(resolve, document, result)
+		}
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 // ThemeConfigIndexer is responsible for indexing theme.json files
 type ThemeConfigIndexer struct {
-	configIndex *indexer.DataIndexer[ThemeConfigField]
+	configIndex     *indexer.DataIndexer[ThemeConfigField]
+	definitionIndex *indexer.DataIndexer[ThemeDefinition]
 }
 
 // NewThemeConfigIndexer creates a new theme config indexer
@@ -21,8 +22,15 @@ func NewThemeConfigIndexer(configDir string) (*ThemeConfigIndexer, error) {
 		return nil, err
 	}
 
+	definitionIndexer, err := indexer.NewDataIndexer[ThemeDefinition](filepath.Join(configDir, "theme_definition.db"))
+	if err != nil {
+		_ = configIndexer.Close()
+		return nil, err
+	}
+
 	return &ThemeConfigIndexer{
-		configIndex: configIndexer,
+		configIndex:     configIndexer,
+		definitionIndex: definitionIndexer,
 	}, nil
 }
 
@@ -66,22 +74,42 @@ func (t *ThemeConfigIndexer) Index(path string, node *tree_sitter.Node, fileCont
 		batchSave[field.Path][field.Key] = field
 	}
 
-	return t.configIndex.BatchSaveItems(batchSave)
+	if err := t.configIndex.BatchSaveItems(batchSave); err != nil {
+		return err
+	}
+
+	definition := ParseThemeDefinition(tree.RootNode(), fileContent, path)
+
+	return t.definitionIndex.BatchSaveItems(map[string]map[string]ThemeDefinition{
+		path: {path: definition},
+	})
 }
 
 // RemovedFiles handles cleanup when files are removed
 func (t *ThemeConfigIndexer) RemovedFiles(paths []string) error {
-	return t.configIndex.BatchDeleteByFilePaths(paths)
+	if err := t.configIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+
+	return t.definitionIndex.BatchDeleteByFilePaths(paths)
 }
 
 // Close closes the indexer
 func (t *ThemeConfigIndexer) Close() error {
-	return t.configIndex.Close()
+	if err := t.configIndex.Close(); err != nil {
+		return err
+	}
+
+	return t.definitionIndex.Close()
 }
 
 // Clear clears all indexed data
 func (t *ThemeConfigIndexer) Clear() error {
-	return t.configIndex.Clear()
+	if err := t.configIndex.Clear(); err != nil {
+		return err
+	}
+
+	return t.definitionIndex.Clear()
 }
 
 // GetThemeConfigFields returns all theme config field keys
@@ -99,6 +127,27 @@ func (t *ThemeConfigIndexer) GetAllThemeConfigFields() ([]ThemeConfigField, erro
 	return t.configIndex.GetAllValues()
 }
 
+// GetThemeDefinitions returns the inheritance information of all indexed theme.json files
+func (t *ThemeConfigIndexer) GetThemeDefinitions() ([]ThemeDefinition, error) {
+	return t.definitionIndex.GetAllValues()
+}
+
+// GetStorefrontGroups returns the names of the blocks, sections and tabs declared by the Storefront theme, and
+// false if the Storefront is not installed or its theme.json is not indexed yet
+func (t *ThemeConfigIndexer) GetStorefrontGroups(projectRoot string) (map[string][]string, bool) {
+	resources := StorefrontResources(projectRoot)
+	if resources == "" {
+		return nil, false
+	}
+
+	definitions, err := t.definitionIndex.GetValues(filepath.Join(resources, "theme.json"))
+	if err != nil || len(definitions) == 0 {
+		return nil, false
+	}
+
+	return definitions[0].Groups, true
+}
+
 // IsThemeFile checks if a file is a theme.json file
 func IsThemeFile(path string) bool {
 	return strings.HasSuffix(path, "theme.json")
@@ -53,6 +53,12 @@ func TestThemeConfigIndexer(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, allFields)
 
+	// Test GetThemeDefinitions
+	definitions, err := indexer.GetThemeDefinitions()
+	require.NoError(t, err)
+	require.Len(t, definitions, 1)
+	assert.Equal(t, filePath, definitions[0].Path)
+
 	// Test removing a file
 	err = indexer.RemovedFiles([]string{filePath})
 	require.NoError(t, err)
@@ -61,4 +67,8 @@ func TestThemeConfigIndexer(t *testing.T) {
 	emptyKeys, err := indexer.GetThemeConfigFields()
 	require.NoError(t, err)
 	assert.Empty(t, emptyKeys)
+
+	definitions, err = indexer.GetThemeDefinitions()
+	require.NoError(t, err)
+	assert.Empty(t, definitions)
 }
@@ -166,3 +166,31 @@ func GetClassName(node *tree_sitter.Node, content []byte) string {
 
 	return fmt.Sprintf("%s\\%s", ns, className)
 }
+
+// PHPRouteAttributePattern matches a Symfony #[Route(...)] attribute
+func PHPRouteAttributePattern() Pattern {
+	return And(
+		NodeKind("attribute"),
+		HasChild(And(
+			NodeKind("name"),
+			NodeText("Route"),
+		)),
+	)
+}
+
+// GetPHPRouteAttribute returns the #[Route(...)] attribute the node is part of
+func GetPHPRouteAttribute(node *tree_sitter.Node, content []byte) *tree_sitter.Node {
+	pattern := PHPRouteAttributePattern()
+
+	for current := node; current != nil; current = current.Parent() {
+		if pattern.Matches(current, content) {
+			return current
+		}
+
+		if current.Kind() == "method_declaration" || current.Kind() == "class_declaration" {
+			return nil
+		}
+	}
+
+	return nil
+}
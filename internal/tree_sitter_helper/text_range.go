@@ -49,3 +49,25 @@ func GetTextForRange(content []byte, rng protocol.Range) string {
 
 	return strings.Join(result, "\n")
 }
+
+// OffsetAt converts a line and character position to a byte offset of the content
+func OffsetAt(content []byte, line, character int) int {
+	offset := 0
+	for i := 0; i < line; i++ {
+		next := bytes.IndexByte(content[offset:], '\n')
+		if next == -1 {
+			return len(content)
+		}
+		offset += next + 1
+	}
+
+	return min(offset+character, len(content))
+}
+
+// PositionAt converts a byte offset of the content to a line and character position
+func PositionAt(content []byte, offset int) protocol.Position {
+	offset = min(offset, len(content))
+	lineStart := bytes.LastIndexByte(content[:offset], '\n') + 1
+
+	return protocol.Position{Line: bytes.Count(content[:lineStart], []byte("\n")), Character: offset - lineStart}
+}
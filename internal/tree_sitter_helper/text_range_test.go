@@ -0,0 +1,34 @@
+package treesitterhelper
+
+import (
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/lsp/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetAt(t *testing.T) {
+	content := []byte("first\nsecond\n\nlast")
+
+	assert.Equal(t, 0, OffsetAt(content, 0, 0))
+	assert.Equal(t, 3, OffsetAt(content, 0, 3))
+	assert.Equal(t, 9, OffsetAt(content, 1, 3))
+	assert.Equal(t, 13, OffsetAt(content, 2, 0))
+	assert.Equal(t, 18, OffsetAt(content, 3, 4))
+	assert.Equal(t, len(content), OffsetAt(content, 3, 10))
+	assert.Equal(t, len(content), OffsetAt(content, 5, 0))
+}
+
+func TestPositionAt(t *testing.T) {
+	content := []byte("first\nsecond\n\nlast")
+
+	assert.Equal(t, protocol.Position{Line: 0, Character: 0}, PositionAt(content, 0))
+	assert.Equal(t, protocol.Position{Line: 1, Character: 3}, PositionAt(content, 9))
+	assert.Equal(t, protocol.Position{Line: 2, Character: 0}, PositionAt(content, 13))
+	assert.Equal(t, protocol.Position{Line: 3, Character: 4}, PositionAt(content, 100))
+
+	for offset := 0; offset <= len(content); offset++ {
+		position := PositionAt(content, offset)
+		assert.Equal(t, offset, OffsetAt(content, position.Line, position.Character))
+	}
+}
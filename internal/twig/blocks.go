@@ -0,0 +1,122 @@
+package twig
+
+import (
+	"sort"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// GetParentBlocks returns all blocks of the parent chain of a template extending extendsFile.
+// The current file is skipped, as a sw_extends of the own template resolves to the previous bundle.
+// found is false when no parent template is indexed, so callers should not report anything.
+func (idx *TwigIndexer) GetParentBlocks(extendsFile, currentPath string) (blocks map[string]TwigBlock, found bool) {
+	blocks = make(map[string]TwigBlock)
+	visited := map[string]bool{currentPath: true}
+
+	var collect func(relPath string)
+	collect = func(relPath string) {
+		files, err := idx.GetTwigFilesByRelPath(NormalizeTemplatePath(relPath))
+		if err != nil {
+			return
+		}
+
+		for _, file := range files {
+			if visited[file.Path] {
+				continue
+			}
+			visited[file.Path] = true
+			found = true
+
+			for name, block := range file.Blocks {
+				if _, ok := blocks[name]; !ok {
+					blocks[name] = block
+				}
+			}
+
+			if file.ExtendsFile != "" {
+				collect(file.ExtendsFile)
+			}
+		}
+	}
+
+	collect(extendsFile)
+
+	return blocks, found
+}
+
+// SuggestBlockNames returns up to three candidates which look like a typo of name, closest first
+func SuggestBlockNames(name string, candidates []string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	maxDistance := max(2, len(name)/4)
+
+	var suggestions []suggestion
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(name, candidate)
+		if distance <= maxDistance || (len(name) > 3 && strings.Contains(candidate, name)) {
+			suggestions = append(suggestions, suggestion{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(suggestions) && i < 3; i++ {
+		names = append(names, suggestions[i].name)
+	}
+
+	return names
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// TopLevelBlockNames returns the name nodes of all blocks which are not nested inside another block.
+// Only these blocks override blocks of the parent template, nested blocks are new definitions.
+func TopLevelBlockNames(node *tree_sitter.Node) []*tree_sitter.Node {
+	var names []*tree_sitter.Node
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+
+		if child.Kind() != "block" {
+			names = append(names, TopLevelBlockNames(child)...)
+			continue
+		}
+
+		if nameNode := child.ChildByFieldName("name"); nameNode != nil {
+			names = append(names, nameNode)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,15 @@
+package twig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestBlockNames(t *testing.T) {
+	candidates := []string{"base_header", "base_body", "base_footer", "page_product_detail_buy"}
+
+	assert.Equal(t, []string{"base_header"}, SuggestBlockNames("base_haeder", candidates))
+	assert.Equal(t, []string{"page_product_detail_buy"}, SuggestBlockNames("product_detail", candidates))
+	assert.Empty(t, SuggestBlockNames("completely_different", candidates))
+}
@@ -0,0 +1,245 @@
+package twig
+
+import (
+	"slices"
+	"strings"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// cursorPlaceholder is inserted at the cursor to complete an expression which is not valid yet, like page. or input(
+const cursorPlaceholder = "_"
+
+// cursorClosers close an open call or an open array or hash argument of a call after the placeholder
+var cursorClosers = []string{"", ")", "])", "})"}
+
+// cursorTagEnds close the output or statement tag after the placeholder when it is not typed yet
+var cursorTagEnds = []string{"", " }}", " %}"}
+
+// AttributeChainAt returns the expression whose attributes are completed at the offset, like page.product
+// for {{ page.product.na }}. Only chains of plain attributes are returned.
+func AttributeChainAt(root *tree_sitter.Node, content []byte, offset int) (string, bool) {
+	var chain string
+
+	found := withCursorNode(root, content, offset, func(node *tree_sitter.Node, content []byte, offset int) bool {
+		parent := node.Parent()
+		if node.Kind() != "property" || parent == nil || parent.Kind() != "member_expression" {
+			return false
+		}
+
+		chain = attributeChain(parent.NamedChild(0), content)
+		return chain != ""
+	})
+
+	return chain, found
+}
+
+// MacroImportAt returns the import whose macros are completed at the offset: the {% from %} import when completing
+// the imported macro names, or the {% import %} of the alias for forms.inp
+func MacroImportAt(root *tree_sitter.Node, content []byte, offset int, currentTemplate string) (TwigMacroImport, bool) {
+	var macroImport TwigMacroImport
+
+	imports := ParseTwigMacroImports(root, content, currentTemplate)
+
+	found := withCursorNode(root, content, offset, func(node *tree_sitter.Node, content []byte, offset int) bool {
+		parent := node.Parent()
+		if parent == nil {
+			return false
+		}
+
+		switch {
+		case node.Kind() == "variable" && parent.Kind() == "as_operator":
+			// {% from 'file' import inp as input %}, only the macro name is completed
+			if left := parent.ChildByFieldName("left"); left == nil || left.Id() != node.Id() {
+				return false
+			}
+			parent = parent.Parent()
+			if parent == nil || parent.Kind() != "from" {
+				return false
+			}
+			fallthrough
+		case node.Kind() == "variable" && parent.Kind() == "from":
+			if path := parent.ChildByFieldName("expr"); path == nil || path.Id() == node.Id() {
+				return false
+			}
+
+			imported := parseMacroImport(parent, content, currentTemplate)
+			if imported == nil {
+				return false
+			}
+			macroImport = *imported
+			return true
+		case node.Kind() == "property" && parent.Kind() == "member_expression":
+			alias := parent.NamedChild(0)
+			if alias == nil || alias.Kind() != "variable" {
+				return false
+			}
+
+			for _, imported := range imports {
+				if imported.Alias != "" && imported.Alias == alias.Utf8Text(content) {
+					macroImport = imported
+					return true
+				}
+			}
+		}
+
+		return false
+	})
+
+	return macroImport, found
+}
+
+// MacroCallAt returns the callee of the call whose arguments contain the offset, like forms.input or input,
+// and the index of the argument at the offset
+func MacroCallAt(root *tree_sitter.Node, content []byte, offset int) (string, int, bool) {
+	var callee string
+	var argument int
+
+	found := withCursorNode(root, content, offset, func(node *tree_sitter.Node, content []byte, offset int) bool {
+		for ; node != nil; node = node.Parent() {
+			if node.Kind() != "call_expression" {
+				continue
+			}
+
+			arguments := node.ChildByFieldName("arguments")
+			if arguments == nil || offset <= int(arguments.StartByte()) || offset >= int(arguments.EndByte()) {
+				continue
+			}
+
+			callee = calleeName(node.NamedChild(0), content)
+			if callee == "" {
+				return false
+			}
+
+			argument = 0
+			for i := uint(0); i < arguments.ChildCount(); i++ {
+				if child := arguments.Child(i); child.Kind() == "," && int(child.StartByte()) < offset {
+					argument++
+				}
+			}
+			return true
+		}
+
+		return false
+	})
+
+	return callee, argument, found
+}
+
+// withCursorNode calls accept with the named node in front of the offset until it accepts one. Twig inside of HTML
+// attribute values is not parsed by the grammar, so a value is parsed as a template on its own. When the offset is
+// inside of an invalid template, the template up to the offset is parsed again with a placeholder and closers.
+func withCursorNode(root *tree_sitter.Node, content []byte, offset int, accept func(node *tree_sitter.Node, content []byte, offset int) bool) bool {
+	if root == nil || offset < 0 || offset > len(content) {
+		return false
+	}
+
+	node := root.NamedDescendantForByteRange(uint(max(offset-1, 0)), uint(offset))
+	if node == nil {
+		return false
+	}
+
+	if node.Kind() == "html_attribute_value" {
+		start := int(node.StartByte())
+		value := content[start:node.EndByte()]
+
+		tree := parseTemplate(value)
+		defer tree.Close()
+
+		return withCursorNode(tree.RootNode(), value, offset-start, accept)
+	}
+
+	if !insideError(node) && accept(node, content, offset) {
+		return true
+	}
+
+	if !root.HasError() {
+		return false
+	}
+
+	for _, tagEnd := range cursorTagEnds {
+		for _, closer := range cursorClosers {
+			repaired := slices.Concat(content[:offset], []byte(cursorPlaceholder+closer+tagEnd))
+			if acceptRepaired(repaired, offset, accept) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// acceptRepaired parses a template with the placeholder at the offset and calls accept with the placeholder node.
+// Unclosed tags like {% if %} stay an ERROR, so the expression around the placeholder is checked by accept only.
+func acceptRepaired(content []byte, offset int, accept func(node *tree_sitter.Node, content []byte, offset int) bool) bool {
+	tree := parseTemplate(content)
+	defer tree.Close()
+
+	node := tree.RootNode().NamedDescendantForByteRange(uint(offset), uint(offset+len(cursorPlaceholder)))
+	if node == nil {
+		return false
+	}
+
+	return accept(node, content, offset)
+}
+
+// attributeChain returns a chain of plain attributes like page.product, or an empty string for other expressions
+func attributeChain(node *tree_sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+
+	switch node.Kind() {
+	case "variable":
+		return node.Utf8Text(content)
+	case "member_expression":
+		object := attributeChain(node.NamedChild(0), content)
+		property := node.NamedChild(1)
+		if object == "" || property == nil || property.Kind() != "property" {
+			return ""
+		}
+		return object + "." + property.Utf8Text(content)
+	}
+
+	return ""
+}
+
+// calleeName returns the name of a called function like input, or of a macro of an alias like forms.input
+func calleeName(node *tree_sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+
+	switch node.Kind() {
+	case "function":
+		return node.Utf8Text(content)
+	case "member_expression":
+		chain := attributeChain(node, content)
+		if strings.Count(chain, ".") == 1 {
+			return chain
+		}
+	}
+
+	return ""
+}
+
+// insideError checks if the node is part of an ERROR node
+func insideError(node *tree_sitter.Node) bool {
+	for ; node != nil; node = node.Parent() {
+		if node.IsError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTemplate parses a Twig template
+func parseTemplate(content []byte) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	_ = parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language()))
+
+	return parser.Parse(content, nil)
+}
@@ -0,0 +1,117 @@
+package twig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cursorTemplate removes the $ marking the cursor and returns the content and the offset of the cursor
+func cursorTemplate(code string) ([]byte, int) {
+	offset := strings.Index(code, "$")
+
+	return []byte(code[:offset] + code[offset+1:]), offset
+}
+
+func TestAttributeChainAt(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+		found    bool
+	}{
+		{code: "{{ page.$ }}", expected: "page", found: true},
+		{code: "{{ page.product.na$ }}", expected: "page.product", found: true},
+		{code: "{{ page.product.$", expected: "page.product", found: true},
+		{code: "{% if page.product.$ %}{% endif %}", expected: "page.product", found: true},
+		{code: "{% if page.product.$", expected: "page.product", found: true},
+		{code: `<div class="{{ page.$ }}"></div>`, expected: "page", found: true},
+		{code: "{{ page.product }} page.$"},
+		{code: "{{ 'page.$' }}"},
+		{code: "{{ page.getProduct().$ }}"},
+		{code: "{{ page|$ }}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			content, offset := cursorTemplate(test.code)
+
+			tree := parseMacroTemplate(t, string(content))
+			defer tree.Close()
+
+			chain, found := AttributeChainAt(tree.RootNode(), content, offset)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, chain)
+		})
+	}
+}
+
+func TestMacroImportAt(t *testing.T) {
+	const imports = "{% import '@Storefront/storefront/macros/forms.html.twig' as forms %}"
+
+	tests := []struct {
+		code     string
+		expected string
+		alias    string
+		found    bool
+	}{
+		{code: imports + "{{ forms.$ }}", expected: "@Storefront/storefront/macros/forms.html.twig", alias: "forms", found: true},
+		{code: imports + "{{ forms.inp$", expected: "@Storefront/storefront/macros/forms.html.twig", alias: "forms", found: true},
+		{code: imports + `<input value="{{ forms.$ }}">`, expected: "@Storefront/storefront/macros/forms.html.twig", alias: "forms", found: true},
+		{code: "{% from 'storefront/macros/forms.html.twig' import $", expected: "@Storefront/storefront/macros/forms.html.twig", found: true},
+		{code: "{% from 'storefront/macros/forms.html.twig' import input, la$", expected: "@Storefront/storefront/macros/forms.html.twig", found: true},
+		{code: "{% from 'storefront/macros/forms.html.twig' import input as $"},
+		{code: "{% from _self import $ %}", expected: "storefront/page/index.html.twig", found: true},
+		{code: imports + "{{ page.forms.$ }}"},
+		{code: "{{ forms.$ }}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			content, offset := cursorTemplate(test.code)
+
+			tree := parseMacroTemplate(t, string(content))
+			defer tree.Close()
+
+			macroImport, found := MacroImportAt(tree.RootNode(), content, offset, "storefront/page/index.html.twig")
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, macroImport.TemplatePath)
+			assert.Equal(t, test.alias, macroImport.Alias)
+		})
+	}
+}
+
+func TestMacroCallAt(t *testing.T) {
+	tests := []struct {
+		code     string
+		callee   string
+		argument int
+		found    bool
+	}{
+		{code: "{{ forms.input($) }}", callee: "forms.input", found: true},
+		{code: "{{ forms.input('name', $) }}", callee: "forms.input", argument: 1, found: true},
+		{code: "{{ forms.input('name', $", callee: "forms.input", argument: 1, found: true},
+		{code: "{{ input('name', 'value', $", callee: "input", argument: 2, found: true},
+		{code: "{{ forms.input('name', { a: 1, $", callee: "forms.input", argument: 1, found: true},
+		{code: "{{ forms.input('name', [1, 2, $", callee: "forms.input", argument: 1, found: true},
+		{code: "{{ forms.input(fn('a', $), 'b') }}", callee: "fn", argument: 1, found: true},
+		{code: "{{ forms.input('a, b', $) }}", callee: "forms.input", argument: 1, found: true},
+		{code: `<input value="{{ forms.input('a', $) }}">`, callee: "forms.input", argument: 1, found: true},
+		{code: "{{ forms.input('a') }} $"},
+		{code: "{{ page.forms.input($) }}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			content, offset := cursorTemplate(test.code)
+
+			tree := parseMacroTemplate(t, string(content))
+			defer tree.Close()
+
+			callee, argument, found := MacroCallAt(tree.RootNode(), content, offset)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.callee, callee)
+			assert.Equal(t, test.argument, argument)
+		})
+	}
+}
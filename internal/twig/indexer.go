@@ -15,6 +15,7 @@ type TwigIndexer struct {
 	twigBlockHashIndex *indexer.DataIndexer[TwigBlockHash]
 	twigFunctionIndex  *indexer.DataIndexer[TwigFunction]
 	twigFilterIndex    *indexer.DataIndexer[TwigFilter]
+	twigVariableIndex  *indexer.DataIndexer[TwigTemplateVariables]
 }
 
 func NewTwigIndexer(configDir string) (*TwigIndexer, error) {
@@ -43,12 +44,18 @@ func NewTwigIndexer(configDir string) (*TwigIndexer, error) {
 		return nil, err
 	}
 
+	twigVariableIndex, err := indexer.NewDataIndexer[TwigTemplateVariables](path.Join(configDir, "twig_variable.index"))
+	if err != nil {
+		return nil, err
+	}
+
 	return &TwigIndexer{
 		twigFileIndex:      twigFileIndex,
 		twigBlockIndex:     twigBlockIndex,
 		twigBlockHashIndex: twigBlockHashIndex,
 		twigFunctionIndex:  twigFunctionIndex,
 		twigFilterIndex:    twigFilterIndex,
+		twigVariableIndex:  twigVariableIndex,
 	}, nil
 }
 
@@ -61,6 +68,10 @@ func (idx *TwigIndexer) Index(path string, node *tree_sitter.Node, fileContent [
 	case ".twig":
 		return idx.indexTwig(path, node, fileContent)
 	case ".php":
+		if err := idx.indexVariables(path, ParseRenderVariables(path, node, fileContent)); err != nil {
+			return err
+		}
+
 		return idx.indexExtension(path, node, fileContent)
 	default:
 		return nil
@@ -122,7 +133,13 @@ func (idx *TwigIndexer) indexTwig(path string, node *tree_sitter.Node, fileConte
 		}
 	}
 
-	return nil
+	return idx.indexVariables(path, ParseIncludeVariables(path, node, fileContent))
+}
+
+func (idx *TwigIndexer) indexVariables(path string, variables []TwigTemplateVariable) error {
+	return idx.twigVariableIndex.BatchSaveItems(map[string]map[string]TwigTemplateVariables{
+		path: groupTemplateVariables(variables),
+	})
 }
 
 func (idx *TwigIndexer) indexExtension(path string, node *tree_sitter.Node, fileContent []byte) error {
@@ -184,6 +201,10 @@ func (idx *TwigIndexer) RemovedFiles(paths []string) error {
 		return err
 	}
 
+	if err := idx.twigVariableIndex.BatchDeleteByFilePaths(paths); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -208,6 +229,10 @@ func (idx *TwigIndexer) Close() error {
 		return err
 	}
 
+	if err := idx.twigVariableIndex.Close(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,6 +257,10 @@ func (idx *TwigIndexer) Clear() error {
 		return err
 	}
 
+	if err := idx.twigVariableIndex.Clear(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -266,6 +295,21 @@ func (idx *TwigIndexer) GetTwigFilesByRelPath(relPath string) ([]TwigFile, error
 	return idx.twigFileIndex.GetValues(relPath)
 }
 
+// GetTemplateVariables returns all variables passed into the template by controllers and includes
+func (idx *TwigIndexer) GetTemplateVariables(relPath string) ([]TwigTemplateVariable, error) {
+	groups, err := idx.twigVariableIndex.GetValues(relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var variables []TwigTemplateVariable
+	for _, group := range groups {
+		variables = append(variables, group.Variables...)
+	}
+
+	return variables, nil
+}
+
 func (idx *TwigIndexer) GetTwigBlockHashes(blockName string) ([]TwigBlockHash, error) {
 	return idx.twigBlockHashIndex.GetValues(blockName)
 }
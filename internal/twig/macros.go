@@ -0,0 +1,287 @@
+package twig
+
+import (
+	"fmt"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+type TwigMacro struct {
+	Name      string
+	Arguments []TwigMacroArgument
+	Line      int
+}
+
+type TwigMacroArgument struct {
+	Name string
+	// Default value as written in the template, empty when the argument has no default
+	Default string
+}
+
+// Signature returns the macro like it is declared, e.g. input(name, type = 'text')
+func (m TwigMacro) Signature() string {
+	arguments := make([]string, 0, len(m.Arguments))
+	for _, argument := range m.Arguments {
+		arguments = append(arguments, argument.String())
+	}
+
+	return fmt.Sprintf("%s(%s)", m.Name, strings.Join(arguments, ", "))
+}
+
+func (a TwigMacroArgument) String() string {
+	if a.Default == "" {
+		return a.Name
+	}
+
+	return a.Name + " = " + a.Default
+}
+
+// TwigMacroImport is an {% import %} or {% from ... import %} statement of a template
+type TwigMacroImport struct {
+	// Imported template, normalized like TwigFile.RelPath
+	TemplatePath string
+	// Alias of {% import 'file' as alias %}, empty for from imports
+	Alias string
+	// Imported macros of {% from 'file' import macro as name %}, keyed by the local name
+	Macros map[string]string
+	// Name nodes of the from import, keyed by the local name
+	MacroNodes map[string]*tree_sitter.Node
+	// Template path node
+	PathNode *tree_sitter.Node
+}
+
+// findMacros collects all {% macro %} definitions of the template
+func findMacros(node *tree_sitter.Node, content []byte, file *TwigFile) {
+	if node.Kind() == "macro" {
+		if macro := parseMacro(node, content); macro != nil {
+			if file.Macros == nil {
+				file.Macros = make(map[string]TwigMacro)
+			}
+			file.Macros[macro.Name] = *macro
+		}
+		return
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		findMacros(node.NamedChild(i), content, file)
+	}
+}
+
+func parseMacro(node *tree_sitter.Node, content []byte) *TwigMacro {
+	nameNode := node.ChildByFieldName("name")
+	if nameNode == nil {
+		return nil
+	}
+
+	macro := &TwigMacro{
+		Name:      string(nameNode.Utf8Text(content)),
+		Arguments: []TwigMacroArgument{},
+		Line:      int(nameNode.StartPosition().Row) + 1,
+	}
+
+	argumentsNode := node.ChildByFieldName("arguments")
+	if argumentsNode == nil {
+		return macro
+	}
+
+	for i := uint(0); i < argumentsNode.NamedChildCount(); i++ {
+		argument := argumentsNode.NamedChild(i)
+
+		switch argument.Kind() {
+		case "variable":
+			macro.Arguments = append(macro.Arguments, TwigMacroArgument{Name: string(argument.Utf8Text(content))})
+		case "named_argument":
+			keyNode := argument.ChildByFieldName("key")
+			valueNode := argument.ChildByFieldName("value")
+			if keyNode == nil {
+				continue
+			}
+
+			macroArgument := TwigMacroArgument{Name: string(keyNode.Utf8Text(content))}
+			if valueNode != nil {
+				macroArgument.Default = string(valueNode.Utf8Text(content))
+			}
+			macro.Arguments = append(macro.Arguments, macroArgument)
+		}
+	}
+
+	return macro
+}
+
+// ParseTwigMacroImports returns the macro imports of a template. currentTemplate is used for _self imports.
+func ParseTwigMacroImports(node *tree_sitter.Node, content []byte, currentTemplate string) []TwigMacroImport {
+	var imports []TwigMacroImport
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+
+		switch child.Kind() {
+		case "import", "from":
+			if macroImport := parseMacroImport(child, content, currentTemplate); macroImport != nil {
+				imports = append(imports, *macroImport)
+			}
+		default:
+			imports = append(imports, ParseTwigMacroImports(child, content, currentTemplate)...)
+		}
+	}
+
+	return imports
+}
+
+func parseMacroImport(node *tree_sitter.Node, content []byte, currentTemplate string) *TwigMacroImport {
+	pathNode := node.ChildByFieldName("expr")
+	if pathNode == nil {
+		return nil
+	}
+
+	macroImport := &TwigMacroImport{PathNode: pathNode}
+
+	switch pathNode.Kind() {
+	case "string":
+		macroImport.TemplatePath = NormalizeTemplatePath(trimQuotes(string(pathNode.Utf8Text(content))))
+	case "variable":
+		if string(pathNode.Utf8Text(content)) != "_self" {
+			return nil
+		}
+		macroImport.TemplatePath = currentTemplate
+	default:
+		return nil
+	}
+
+	if node.Kind() == "import" {
+		aliasNode := node.ChildByFieldName("variable")
+		if aliasNode == nil {
+			return nil
+		}
+
+		macroImport.Alias = string(aliasNode.Utf8Text(content))
+		return macroImport
+	}
+
+	macroImport.Macros = make(map[string]string)
+	macroImport.MacroNodes = make(map[string]*tree_sitter.Node)
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+
+		switch child.Kind() {
+		case "variable":
+			if child.Id() == pathNode.Id() {
+				continue
+			}
+
+			name := string(child.Utf8Text(content))
+			macroImport.Macros[name] = name
+			macroImport.MacroNodes[name] = child
+		case "as_operator":
+			leftNode := child.ChildByFieldName("left")
+			rightNode := child.ChildByFieldName("right")
+			if leftNode == nil || rightNode == nil {
+				continue
+			}
+
+			localName := string(rightNode.Utf8Text(content))
+			macroImport.Macros[localName] = string(leftNode.Utf8Text(content))
+			macroImport.MacroNodes[localName] = leftNode
+		}
+	}
+
+	return macroImport
+}
+
+// GetMacros returns all macros defined in a template, including overrides of the template in other bundles
+func (idx *TwigIndexer) GetMacros(templatePath string) (map[string]TwigMacroLocation, error) {
+	files, err := idx.GetTwigFilesByRelPath(NormalizeTemplatePath(templatePath))
+	if err != nil {
+		return nil, err
+	}
+
+	macros := make(map[string]TwigMacroLocation)
+	for _, file := range files {
+		for name, macro := range file.Macros {
+			if _, ok := macros[name]; !ok {
+				macros[name] = TwigMacroLocation{TwigMacro: macro, FilePath: file.Path}
+			}
+		}
+	}
+
+	return macros, nil
+}
+
+// HasTemplate reports whether a template is indexed
+func (idx *TwigIndexer) HasTemplate(templatePath string) bool {
+	files, err := idx.GetTwigFilesByRelPath(NormalizeTemplatePath(templatePath))
+	return err == nil && len(files) > 0
+}
+
+// TwigMacroLocation is a macro together with the file defining it
+type TwigMacroLocation struct {
+	TwigMacro
+	FilePath string
+}
+
+// ResolveMacroCallee returns the template and macro name for a call like forms.input(...) or input(...)
+// imported by {% from 'file' import input %}
+func ResolveMacroCallee(imports []TwigMacroImport, callee string) (string, string, bool) {
+	alias, macroName, isMember := strings.Cut(callee, ".")
+
+	for _, macroImport := range imports {
+		if isMember {
+			if macroImport.Alias == alias {
+				return macroImport.TemplatePath, macroName, true
+			}
+			continue
+		}
+
+		if name, ok := macroImport.Macros[callee]; ok {
+			return macroImport.TemplatePath, name, true
+		}
+	}
+
+	return "", "", false
+}
+
+// ResolveMacroAtNode returns the template and macro name referenced by the node, which is either
+// the name of a macro call or a macro name inside of a {% from %} import
+func ResolveMacroAtNode(node *tree_sitter.Node, content []byte, currentTemplate string) (string, string, bool) {
+	parent := node.Parent()
+	if parent == nil {
+		return "", "", false
+	}
+
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	switch {
+	case node.Kind() == "property" && parent.Kind() == "member_expression":
+		call := parent.Parent()
+		objectNode := parent.ChildByFieldName("object")
+		if call == nil || call.Kind() != "call_expression" || objectNode == nil || objectNode.Kind() != "variable" {
+			return "", "", false
+		}
+
+		callee := string(objectNode.Utf8Text(content)) + "." + string(node.Utf8Text(content))
+		return ResolveMacroCallee(ParseTwigMacroImports(root, content, currentTemplate), callee)
+	case node.Kind() == "function" && parent.Kind() == "call_expression":
+		return ResolveMacroCallee(ParseTwigMacroImports(root, content, currentTemplate), string(node.Utf8Text(content)))
+	case node.Kind() == "variable" && parent.Kind() == "as_operator" && parent.Parent() != nil && parent.Parent().Kind() == "from":
+		parent = parent.Parent()
+		fallthrough
+	case node.Kind() == "variable" && parent.Kind() == "from":
+		macroImport := parseMacroImport(parent, content, currentTemplate)
+		if macroImport == nil {
+			return "", "", false
+		}
+
+		for localName, nameNode := range macroImport.MacroNodes {
+			if nameNode.Id() == node.Id() {
+				return macroImport.TemplatePath, macroImport.Macros[localName], true
+			}
+		}
+	}
+
+	return "", "", false
+}
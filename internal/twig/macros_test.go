@@ -0,0 +1,87 @@
+package twig
+
+import (
+	"testing"
+
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parseMacroTemplate(t *testing.T, code string) *tree_sitter.Tree {
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language())))
+	defer parser.Close()
+
+	return parser.Parse([]byte(code), nil)
+}
+
+func TestParseTwigMacros(t *testing.T) {
+	code := `{% macro input(name, value = '', type = 'text') %}
+    <input type="{{ type }}" name="{{ name }}" value="{{ value }}">
+{% endmacro %}
+
+{% macro label(text) %}<label>{{ text }}</label>{% endmacro %}`
+
+	tree := parseMacroTemplate(t, code)
+	defer tree.Close()
+
+	file, err := ParseTwig("/project/Resources/views/storefront/macros/forms.html.twig", tree.RootNode(), []byte(code))
+	require.NoError(t, err)
+	require.Len(t, file.Macros, 2)
+
+	input := file.Macros["input"]
+	assert.Equal(t, 1, input.Line)
+	assert.Equal(t, []TwigMacroArgument{{Name: "name"}, {Name: "value", Default: "''"}, {Name: "type", Default: "'text'"}}, input.Arguments)
+	assert.Equal(t, "input(name, value = '', type = 'text')", input.Signature())
+
+	assert.Equal(t, "label(text)", file.Macros["label"].Signature())
+	assert.Equal(t, 5, file.Macros["label"].Line)
+}
+
+func TestParseTwigMacroImports(t *testing.T) {
+	code := `{% import '@MyPlugin/storefront/macros/forms.html.twig' as forms %}
+{% from _self import input as field, label %}
+{{ forms.input('email') }}{{ field('name') }}`
+
+	tree := parseMacroTemplate(t, code)
+	defer tree.Close()
+
+	currentTemplate := "@Storefront/storefront/page/index.html.twig"
+	imports := ParseTwigMacroImports(tree.RootNode(), []byte(code), currentTemplate)
+	require.Len(t, imports, 2)
+
+	assert.Equal(t, "@Storefront/storefront/macros/forms.html.twig", imports[0].TemplatePath)
+	assert.Equal(t, "forms", imports[0].Alias)
+
+	assert.Equal(t, currentTemplate, imports[1].TemplatePath)
+	assert.Equal(t, map[string]string{"field": "input", "label": "label"}, imports[1].Macros)
+
+	templatePath, macroName, ok := ResolveMacroCallee(imports, "forms.input")
+	assert.True(t, ok)
+	assert.Equal(t, "@Storefront/storefront/macros/forms.html.twig", templatePath)
+	assert.Equal(t, "input", macroName)
+
+	templatePath, macroName, ok = ResolveMacroCallee(imports, "field")
+	assert.True(t, ok)
+	assert.Equal(t, currentTemplate, templatePath)
+	assert.Equal(t, "input", macroName)
+
+	_, _, ok = ResolveMacroCallee(imports, "page.input")
+	assert.False(t, ok)
+
+	// Cursor on "input" of forms.input('email')
+	node := tree.RootNode().NamedDescendantForPointRange(tree_sitter.Point{Row: 2, Column: 10}, tree_sitter.Point{Row: 2, Column: 10})
+	templatePath, macroName, ok = ResolveMacroAtNode(node, []byte(code), currentTemplate)
+	assert.True(t, ok)
+	assert.Equal(t, "@Storefront/storefront/macros/forms.html.twig", templatePath)
+	assert.Equal(t, "input", macroName)
+
+	// Cursor on "input" of the from import
+	node = tree.RootNode().NamedDescendantForPointRange(tree_sitter.Point{Row: 1, Column: 22}, tree_sitter.Point{Row: 1, Column: 22})
+	templatePath, macroName, ok = ResolveMacroAtNode(node, []byte(code), currentTemplate)
+	assert.True(t, ok)
+	assert.Equal(t, currentTemplate, templatePath)
+	assert.Equal(t, "input", macroName)
+}
@@ -39,6 +39,7 @@ type TwigFile struct {
 	Blocks         map[string]TwigBlock
 	ExtendsFile    string
 	ExtendsTagLine int
+	Macros         map[string]TwigMacro
 }
 
 type TwigVersionComment struct {
@@ -129,7 +130,7 @@ func findPreviousComment(blockNode *tree_sitter.Node, content []byte) *tree_sitt
 func ParseTwig(filePath string, node *tree_sitter.Node, content []byte) (*TwigFile, error) {
 	file := &TwigFile{
 		Path:       filePath,
-		BundleName: getBundleNameByPath(filePath),
+		BundleName: BundleNameByPath(filePath),
 		RelPath:    ConvertToRelativePath(filePath),
 		Blocks:     make(map[string]TwigBlock),
 	}
@@ -143,6 +144,10 @@ func ParseTwig(filePath string, node *tree_sitter.Node, content []byte) (*TwigFi
 		findBlocks(node, content, file)
 	}
 
+	if bytes.Contains(content, []byte("macro")) {
+		findMacros(node, content, file)
+	}
+
 	// Find extends tag
 	if !bytes.Contains(content, []byte("extends")) && !bytes.Contains(content, []byte("sw_extends")) {
 		return file, nil
@@ -3,6 +3,7 @@ package twig
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
@@ -27,7 +28,8 @@ func ConvertToRelativePath(twigPath string) string {
 	return fmt.Sprintf("@Storefront/%s", path)
 }
 
-func getBundleNameByPath(twigPath string) string {
+// BundleNameByPath returns the name of the bundle a template belongs to, based on its path
+func BundleNameByPath(twigPath string) string {
 	index := strings.Index(twigPath, "Resources/views")
 	if index != -1 {
 		possiblePath := strings.Trim(twigPath[:index], "/")
@@ -41,3 +43,39 @@ func getBundleNameByPath(twigPath string) string {
 
 	return "unknown"
 }
+
+// SortByTemplateHierarchy orders templates with the same relative path by the priority of their bundle in the
+// hierarchy (highest first), like the template finder resolves them. Bundles are compared case-insensitively,
+// as vendor paths are lower case. With parentFirst the templates of bundles below the bundle of currentPath come
+// first, as sw_extends of a template resolves to the next bundle in the hierarchy.
+func SortByTemplateHierarchy(files []TwigFile, hierarchy []string, currentPath string, parentFirst bool) []TwigFile {
+	rank := func(bundle string) int {
+		for i, name := range hierarchy {
+			if strings.EqualFold(name, bundle) {
+				return i
+			}
+		}
+		return len(hierarchy)
+	}
+
+	sorted := slices.Clone(files)
+	slices.SortStableFunc(sorted, func(a, b TwigFile) int {
+		return rank(a.BundleName) - rank(b.BundleName)
+	})
+
+	current := rank(BundleNameByPath(currentPath))
+	if !parentFirst || current == len(hierarchy) {
+		return sorted
+	}
+
+	var parents, others []TwigFile
+	for _, file := range sorted {
+		if rank(file.BundleName) > current {
+			parents = append(parents, file)
+		} else {
+			others = append(others, file)
+		}
+	}
+
+	return append(parents, others...)
+}
@@ -14,8 +14,30 @@ func TestConvertToRelativePath(t *testing.T) {
 	assert.Equal(t, "@Storefront/storefront/base.html.twig", ConvertToRelativePath("/Resources/views/storefront/base.html.twig"))
 }
 
-func TestGetBundleNameByPath(t *testing.T) {
-	assert.Equal(t, "foo", getBundleNameByPath("foo/Resources/views/storefront/base.html.twig"))
-	assert.Equal(t, "storefront", getBundleNameByPath("vendor/shopware/storefront/Resources/views/storefront/base.html.twig"))
-	assert.Equal(t, "MyFoo", getBundleNameByPath("vendor/store.shopware.com/MyFoo/src/Resources/views/storefront/base.html.twig"))
+func TestBundleNameByPath(t *testing.T) {
+	assert.Equal(t, "foo", BundleNameByPath("foo/Resources/views/storefront/base.html.twig"))
+	assert.Equal(t, "storefront", BundleNameByPath("vendor/shopware/storefront/Resources/views/storefront/base.html.twig"))
+	assert.Equal(t, "MyFoo", BundleNameByPath("vendor/store.shopware.com/MyFoo/src/Resources/views/storefront/base.html.twig"))
+}
+
+func TestSortByTemplateHierarchy(t *testing.T) {
+	files := []TwigFile{
+		{BundleName: "storefront", Path: "vendor/shopware/storefront/Resources/views/storefront/base.html.twig"},
+		{BundleName: "ChildTheme", Path: "custom/plugins/ChildTheme/src/Resources/views/storefront/base.html.twig"},
+		{BundleName: "PayPal", Path: "custom/plugins/PayPal/src/Resources/views/storefront/base.html.twig"},
+		{BundleName: "BaseTheme", Path: "custom/plugins/BaseTheme/src/Resources/views/storefront/base.html.twig"},
+	}
+	hierarchy := []string{"ChildTheme", "BaseTheme", "PayPal", "Storefront"}
+
+	bundles := func(files []TwigFile) []string {
+		var names []string
+		for _, file := range files {
+			names = append(names, file.BundleName)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"ChildTheme", "BaseTheme", "PayPal", "storefront"}, bundles(SortByTemplateHierarchy(files, hierarchy, files[1].Path, false)))
+	assert.Equal(t, []string{"PayPal", "storefront", "ChildTheme", "BaseTheme"}, bundles(SortByTemplateHierarchy(files, hierarchy, files[3].Path, true)))
+	assert.Equal(t, []string{"ChildTheme", "BaseTheme", "PayPal", "storefront"}, bundles(SortByTemplateHierarchy(files, hierarchy, "custom/plugins/Unknown/src/Resources/views/storefront/base.html.twig", true)))
 }
@@ -0,0 +1,23 @@
+<?php
+
+namespace Acme\Storefront\Controller;
+
+use Acme\Storefront\Page\ProductPageLoader;
+use Shopware\Storefront\Controller\StorefrontController;
+
+class ProductController extends StorefrontController
+{
+    public function __construct(private readonly ProductPageLoader $productPageLoader)
+    {
+    }
+
+    public function index(Request $request): Response
+    {
+        $page = $this->productPageLoader->load($request);
+
+        return $this->renderStorefront('@AcmeTheme/storefront/page/product-detail/index.html.twig', [
+            'page' => $page,
+            'title' => 'Product',
+        ]);
+    }
+}
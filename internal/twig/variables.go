@@ -0,0 +1,230 @@
+package twig
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/shopware/shopware-lsp/internal/php"
+	treesitterhelper "github.com/shopware/shopware-lsp/internal/tree_sitter_helper"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// TwigTemplateVariable is a variable passed into a template by a controller render call
+// or by an include with parameters
+type TwigTemplateVariable struct {
+	Name string
+	// Template receiving the variable, normalized like TwigFile.RelPath
+	TemplatePath string
+	// File passing the variable, a PHP controller or a Twig template
+	FilePath string
+	Line     int
+	// Type of the value expression inside a PHP controller, resolved against the PHP index on use
+	Type php.TypeChain
+	// Twig expression of the value, only set for includes
+	Expression string
+	// Template doing the include, only set for includes
+	SourceTemplate string
+	// The include passes the whole context of the source template (no "only" keyword)
+	InheritsContext bool
+}
+
+// TwigTemplateVariables groups all variables passed into one template from one file
+type TwigTemplateVariables struct {
+	TemplatePath string
+	Variables    []TwigTemplateVariable
+}
+
+var renderMethods = []string{"renderStorefront", "render", "renderView"}
+
+var templateBundlePrefix = regexp.MustCompile(`^@[^/]+/`)
+
+// NormalizeTemplatePath converts a template name like @MyPlugin/storefront/page/index.html.twig
+// to the form used by TwigFile.RelPath
+func NormalizeTemplatePath(templatePath string) string {
+	templatePath = strings.TrimPrefix(templatePath, "/")
+	if !strings.HasPrefix(templatePath, "@") {
+		return "@Storefront/" + templatePath
+	}
+
+	return templateBundlePrefix.ReplaceAllString(templatePath, "@Storefront/")
+}
+
+// ParseRenderVariables finds $this->renderStorefront('template', ['name' => $value]) calls in a PHP file
+func ParseRenderVariables(path string, node *tree_sitter.Node, content []byte) []TwigTemplateVariable {
+	if !bytes.Contains(content, []byte("render")) {
+		return nil
+	}
+
+	var variables []TwigTemplateVariable
+	collectRenderVariables(path, node, content, &variables)
+
+	return variables
+}
+
+func collectRenderVariables(path string, node *tree_sitter.Node, content []byte, variables *[]TwigTemplateVariable) {
+	if node.Kind() == "member_call_expression" {
+		*variables = append(*variables, parseRenderCall(path, node, content)...)
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		collectRenderVariables(path, node.NamedChild(i), content, variables)
+	}
+}
+
+func parseRenderCall(path string, node *tree_sitter.Node, content []byte) []TwigTemplateVariable {
+	nameNode := node.ChildByFieldName("name")
+	if nameNode == nil {
+		return nil
+	}
+
+	isRenderMethod := false
+	for _, method := range renderMethods {
+		if string(nameNode.Utf8Text(content)) == method {
+			isRenderMethod = true
+			break
+		}
+	}
+
+	argumentsNode := node.ChildByFieldName("arguments")
+	if !isRenderMethod || argumentsNode == nil || argumentsNode.NamedChildCount() < 2 {
+		return nil
+	}
+
+	templateNode := argumentsNode.NamedChild(0).NamedChild(0)
+	parametersNode := argumentsNode.NamedChild(1).NamedChild(0)
+	if templateNode == nil || templateNode.Kind() != "string" || parametersNode == nil || parametersNode.Kind() != "array_creation_expression" {
+		return nil
+	}
+
+	templatePath := NormalizeTemplatePath(trimQuotes(string(templateNode.Utf8Text(content))))
+	className := treesitterhelper.GetClassName(node, content)
+
+	var variables []TwigTemplateVariable
+	for i := uint(0); i < parametersNode.NamedChildCount(); i++ {
+		element := parametersNode.NamedChild(i)
+		if element.Kind() != "array_element_initializer" || element.NamedChildCount() != 2 {
+			continue
+		}
+
+		keyNode := element.NamedChild(0)
+		valueNode := element.NamedChild(1)
+		if keyNode.Kind() != "string" {
+			continue
+		}
+
+		variables = append(variables, TwigTemplateVariable{
+			Name:         trimQuotes(string(keyNode.Utf8Text(content))),
+			TemplatePath: templatePath,
+			FilePath:     path,
+			Line:         int(valueNode.StartPosition().Row) + 1,
+			Type:         php.ExpressionTypeChain(valueNode, content, className),
+		})
+	}
+
+	return variables
+}
+
+// ParseIncludeVariables finds {% sw_include 'template' with { name: value } %} tags in a Twig file
+func ParseIncludeVariables(path string, node *tree_sitter.Node, content []byte) []TwigTemplateVariable {
+	if !bytes.Contains(content, []byte("include")) {
+		return nil
+	}
+
+	var variables []TwigTemplateVariable
+	collectIncludeVariables(path, ConvertToRelativePath(path), node, content, &variables)
+
+	return variables
+}
+
+func collectIncludeVariables(path, sourceTemplate string, node *tree_sitter.Node, content []byte, variables *[]TwigTemplateVariable) {
+	if node.Kind() == "tag" || node.Kind() == "include" {
+		*variables = append(*variables, parseIncludeTag(path, sourceTemplate, node, content)...)
+		return
+	}
+
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		collectIncludeVariables(path, sourceTemplate, node.NamedChild(i), content, variables)
+	}
+}
+
+func parseIncludeTag(path, sourceTemplate string, node *tree_sitter.Node, content []byte) []TwigTemplateVariable {
+	var templatePath string
+	var parametersNode *tree_sitter.Node
+	isInclude := false
+	only := false
+
+	// keywords are anonymous nodes, so all children are inspected
+	for i := uint(0); i < node.ChildCount(); i++ {
+		child := node.Child(i)
+		text := string(child.Utf8Text(content))
+
+		switch {
+		case child.Kind() == "keyword" && (text == "sw_include" || text == "include"):
+			isInclude = true
+		case child.Kind() == "string" && templatePath == "":
+			templatePath = NormalizeTemplatePath(trimQuotes(text))
+		case child.Kind() == "object":
+			parametersNode = child
+		case (child.Kind() == "variable" || child.Kind() == "keyword") && text == "only":
+			only = true
+		}
+	}
+
+	if !isInclude || templatePath == "" {
+		return nil
+	}
+
+	line := int(node.StartPosition().Row) + 1
+
+	var variables []TwigTemplateVariable
+	if !only {
+		variables = append(variables, TwigTemplateVariable{
+			TemplatePath:    templatePath,
+			FilePath:        path,
+			Line:            line,
+			SourceTemplate:  sourceTemplate,
+			InheritsContext: true,
+		})
+	}
+
+	if parametersNode == nil {
+		return variables
+	}
+
+	for i := uint(0); i < parametersNode.NamedChildCount(); i++ {
+		pair := parametersNode.NamedChild(i)
+		if pair.Kind() != "pair" || pair.NamedChildCount() != 2 {
+			continue
+		}
+
+		variables = append(variables, TwigTemplateVariable{
+			Name:           trimQuotes(string(pair.NamedChild(0).Utf8Text(content))),
+			TemplatePath:   templatePath,
+			FilePath:       path,
+			Line:           int(pair.StartPosition().Row) + 1,
+			Expression:     string(pair.NamedChild(1).Utf8Text(content)),
+			SourceTemplate: sourceTemplate,
+		})
+	}
+
+	return variables
+}
+
+// groupTemplateVariables groups the variables of one file by the receiving template
+func groupTemplateVariables(variables []TwigTemplateVariable) map[string]TwigTemplateVariables {
+	grouped := make(map[string]TwigTemplateVariables)
+
+	for _, variable := range variables {
+		group := grouped[variable.TemplatePath]
+		group.TemplatePath = variable.TemplatePath
+		group.Variables = append(group.Variables, variable)
+		grouped[variable.TemplatePath] = group
+	}
+
+	return grouped
+}
+
+func trimQuotes(text string) string {
+	return strings.Trim(text, "\"'")
+}
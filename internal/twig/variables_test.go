@@ -0,0 +1,74 @@
+package twig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopware/shopware-lsp/internal/php"
+	tree_sitter_twig "github.com/shopware/shopware-lsp/internal/tree_sitter_grammars/twig/bindings/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_php "github.com/tree-sitter/tree-sitter-php/bindings/go"
+)
+
+func TestNormalizeTemplatePath(t *testing.T) {
+	assert.Equal(t, "@Storefront/storefront/base.html.twig", NormalizeTemplatePath("@Storefront/storefront/base.html.twig"))
+	assert.Equal(t, "@Storefront/storefront/base.html.twig", NormalizeTemplatePath("@MyPlugin/storefront/base.html.twig"))
+}
+
+func TestParseRenderVariables(t *testing.T) {
+	filePath := filepath.Join("testdata", "controller.php")
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_php.LanguagePHP())))
+	defer parser.Close()
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	variables := ParseRenderVariables(filePath, tree.RootNode(), content)
+	require.Len(t, variables, 2)
+
+	assert.Equal(t, "page", variables[0].Name)
+	assert.Equal(t, "@Storefront/storefront/page/product-detail/index.html.twig", variables[0].TemplatePath)
+	assert.Equal(t, filePath, variables[0].FilePath)
+	assert.Equal(t, 19, variables[0].Line)
+	assert.Equal(t, php.TypeChain{Type: "Acme\\Storefront\\Controller\\ProductController", Members: []string{"productPageLoader", "load()"}}, variables[0].Type)
+
+	assert.Equal(t, "title", variables[1].Name)
+	assert.Empty(t, variables[1].Type.Type)
+}
+
+func TestParseIncludeVariables(t *testing.T) {
+	parser := tree_sitter.NewParser()
+	require.NoError(t, parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_twig.Language())))
+	defer parser.Close()
+
+	content := []byte(`{% block page_product_detail %}
+    {% sw_include '@Storefront/storefront/page/product-detail/buy-widget.html.twig' with { product: page.product } %}
+    {% include '@Storefront/storefront/component/price.html.twig' with { price: page.product.price } only %}
+{% endblock %}`)
+
+	tree := parser.Parse(content, nil)
+	defer tree.Close()
+
+	filePath := "/project/src/Resources/views/storefront/page/product-detail/index.html.twig"
+	variables := ParseIncludeVariables(filePath, tree.RootNode(), content)
+	require.Len(t, variables, 3)
+
+	assert.True(t, variables[0].InheritsContext)
+	assert.Equal(t, "@Storefront/storefront/page/product-detail/buy-widget.html.twig", variables[0].TemplatePath)
+	assert.Equal(t, "@Storefront/storefront/page/product-detail/index.html.twig", variables[0].SourceTemplate)
+
+	assert.Equal(t, "product", variables[1].Name)
+	assert.Equal(t, "page.product", variables[1].Expression)
+	assert.False(t, variables[1].InheritsContext)
+
+	assert.Equal(t, "price", variables[2].Name)
+	assert.Equal(t, "@Storefront/storefront/component/price.html.twig", variables[2].TemplatePath)
+	assert.Equal(t, "page.product.price", variables[2].Expression)
+}
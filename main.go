@@ -5,7 +5,9 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/shopware/shopware-lsp/internal/acl"
 	"github.com/shopware/shopware-lsp/internal/admin"
+	"github.com/shopware/shopware-lsp/internal/api"
 	"github.com/shopware/shopware-lsp/internal/extension"
 	"github.com/shopware/shopware-lsp/internal/feature"
 	"github.com/shopware/shopware-lsp/internal/indexer"
@@ -15,10 +17,15 @@ import (
 	"github.com/shopware/shopware-lsp/internal/lsp/completion"
 	"github.com/shopware/shopware-lsp/internal/lsp/definition"
 	"github.com/shopware/shopware-lsp/internal/lsp/diagnostics"
+	"github.com/shopware/shopware-lsp/internal/lsp/documentcolor"
+	"github.com/shopware/shopware-lsp/internal/lsp/documentlink"
 	"github.com/shopware/shopware-lsp/internal/lsp/hover"
 	"github.com/shopware/shopware-lsp/internal/lsp/reference"
+	"github.com/shopware/shopware-lsp/internal/lsp/signaturehelp"
 	"github.com/shopware/shopware-lsp/internal/php"
+	"github.com/shopware/shopware-lsp/internal/scss"
 	"github.com/shopware/shopware-lsp/internal/snippet"
+	"github.com/shopware/shopware-lsp/internal/storefront"
 	"github.com/shopware/shopware-lsp/internal/symfony"
 	"github.com/shopware/shopware-lsp/internal/systemconfig"
 	"github.com/shopware/shopware-lsp/internal/theme"
@@ -71,16 +78,30 @@ func main() {
 	server.RegisterIndexer(systemconfig.NewSystemConfigIndexer(cacheDir))
 	server.RegisterIndexer(theme.NewThemeConfigIndexer(cacheDir))
 	server.RegisterIndexer(extension.NewExtensionIndexer(cacheDir))
-	server.RegisterIndexer(admin.NewAdminComponentIndexer(cacheDir))
+	adminIndexer, err := admin.NewAdminComponentIndexer(cacheDir)
+	server.RegisterIndexer(adminIndexer, err)
+	server.RegisterIndexer(admin.NewMeteorComponentIndexer(cacheDir, adminIndexer))
+	server.RegisterIndexer(api.NewApiSchemaIndexer(cacheDir))
+	server.RegisterIndexer(acl.NewAclIndexer(cacheDir))
+	server.RegisterIndexer(storefront.NewStorefrontPluginIndexer(cacheDir))
+	server.RegisterIndexer(scss.NewScssIndexer(cacheDir))
 
 	server.RegisterCompletionProvider(completion.NewServiceCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewTwigCompletionProvider(projectRoot, server))
+	server.RegisterCompletionProvider(completion.NewTwigVariableCompletionProvider(server))
+	server.RegisterCompletionProvider(completion.NewTwigMacroCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewRouteCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewSnippetCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewFeatureCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewSystemConfigCompletion(server))
 	server.RegisterCompletionProvider(completion.NewThemeCompletionProvider(server))
 	server.RegisterCompletionProvider(completion.NewAdminCompletionProvider(server))
+	server.RegisterCompletionProvider(completion.NewAclCompletionProvider(server))
+	server.RegisterCompletionProvider(completion.NewStorefrontPluginCompletionProvider(server))
+	server.RegisterCompletionProvider(completion.NewScssCompletionProvider(server))
+	server.RegisterCompletionProvider(completion.NewScssImportCompletionProvider(projectRoot, server))
+	server.RegisterCompletionProvider(completion.NewThemeJSONCompletionProvider(projectRoot, server))
+	server.RegisterCompletionProvider(completion.NewSystemConfigXMLCompletionProvider(server))
 
 	server.RegisterDefinitionProvider(definition.NewServiceXMLDefinitionProvider(server))
 	server.RegisterDefinitionProvider(definition.NewTwigDefinitionProvider(projectRoot, server))
@@ -90,22 +111,46 @@ func main() {
 	server.RegisterDefinitionProvider(definition.NewSystemConfigDefinitionProvider(server))
 	server.RegisterDefinitionProvider(definition.NewThemeDefinitionProvider(server))
 	server.RegisterDefinitionProvider(definition.NewAdminDefinitionProvider(server))
+	server.RegisterDefinitionProvider(definition.NewStorefrontPluginDefinitionProvider(server))
+	server.RegisterDefinitionProvider(definition.NewScssDefinitionProvider(server))
+	server.RegisterDefinitionProvider(definition.NewScssImportDefinitionProvider(projectRoot, server))
 
 	server.RegisterCodeLensProvider(codelens.NewPHPCodeLensProvider(server))
 	server.RegisterCodeLensProvider(codelens.NewTwigCodeLensProvider(server))
+	server.RegisterCodeLensProvider(codelens.NewPHPRouteCodeLensProvider(server))
 
 	server.RegisterReferencesProvider(reference.NewRouteReferenceProvider(server))
 
+	server.RegisterDocumentLinkProvider(documentlink.NewScssImportLinkProvider(projectRoot, server))
+	server.RegisterDocumentColorProvider(documentcolor.NewThemeJSONColorProvider())
+	server.RegisterDocumentColorProvider(documentcolor.NewScssColorProvider())
+
 	server.RegisterDiagnosticsProvider(diagnostics.NewSnippetDiagnosticsProvider(server))
 	server.RegisterDiagnosticsProvider(diagnostics.NewThemeDiagnosticsProvider(projectRoot, server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewThemeJSONDiagnosticsProvider(projectRoot, server))
 	server.RegisterDiagnosticsProvider(diagnostics.NewTwigVersioningDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewTwigBlockDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewTwigMacroDiagnosticsProvider(server))
 	server.RegisterDiagnosticsProvider(diagnostics.NewAdminDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewApiDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewAclDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewSystemConfigDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewSystemConfigXMLDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewScssDiagnosticsProvider(server))
+	server.RegisterDiagnosticsProvider(diagnostics.NewScssImportDiagnosticsProvider(projectRoot, server))
 
 	// Register hover providers
 	server.RegisterHoverProvider(hover.NewTwigHoverProvider(projectRoot, server))
 	server.RegisterHoverProvider(hover.NewSnippetHoverProvider(projectRoot, server))
 	server.RegisterHoverProvider(hover.NewTwigVersioningHoverProvider(server))
 	server.RegisterHoverProvider(hover.NewAdminHoverProvider(projectRoot, server))
+	server.RegisterHoverProvider(hover.NewApiHoverProvider(projectRoot, server))
+	server.RegisterHoverProvider(hover.NewScssHoverProvider(projectRoot, server))
+	server.RegisterHoverProvider(hover.NewThemeConfigHoverProvider(projectRoot, server))
+	server.RegisterHoverProvider(hover.NewSystemConfigHoverProvider(projectRoot, server))
+
+	// Register signature help providers
+	server.RegisterSignatureHelpProvider(signaturehelp.NewTwigMacroSignatureHelpProvider(server))
 
 	// Register code action providers
 	server.RegisterCodeActionProvider(codeaction.NewSnippetCodeActionProvider(server))
@@ -115,6 +160,7 @@ func main() {
 	server.RegisterCommandProvider(snippet.NewSnippetCommandProvider(server))
 	server.RegisterCommandProvider(extension.NewExtensionCommandProvider(server))
 	server.RegisterCommandProvider(twig.NewTwigCommandProvider(projectRoot, server))
+	server.RegisterCommandProvider(api.NewApiCommandProvider(server))
 
 	if err := server.Start(os.Stdin, os.Stdout); err != nil {
 		log.Fatalf("LSP server error: %v", err)
@@ -195,7 +195,8 @@ export async function activate(context: vscode.ExtensionContext): Promise<void>
         { scheme: 'file', language: 'json' },
         { scheme: 'file', language: 'scss' },
         { scheme: 'file', language: 'javascript' },
-        { scheme: 'file', language: 'typescript' }
+        { scheme: 'file', language: 'typescript' },
+        { scheme: 'file', language: 'typescriptreact' }
       ],
       // Add output configuration
       outputChannel: outputChannel,
@@ -467,6 +468,25 @@ export async function activate(context: vscode.ExtensionContext): Promise<void>
     }
   }));
 
+  context.subscriptions.push(vscode.commands.registerCommand('shopware.twig.chooseParentBlock', async (textUri: string, ranges: { start: { line: number; character: number }; end: { line: number; character: number } }[], blockNames: string[]) => {
+    const selected = await vscode.window.showQuickPick(blockNames, {
+      placeHolder: 'Select a block of the parent template',
+    });
+    if (!selected) {
+      return;
+    }
+
+    const edit = new vscode.WorkspaceEdit();
+    for (const range of ranges) {
+      edit.replace(
+        vscode.Uri.parse(textUri),
+        new vscode.Range(range.start.line, range.start.character, range.end.line, range.end.character),
+        selected,
+      );
+    }
+    await vscode.workspace.applyEdit(edit);
+  }));
+
   context.subscriptions.push(vscode.commands.registerCommand('shopware.insertSnippet', async () => {
     if (!client) {
       vscode.window.showErrorMessage('Shopware LSP is not running');